@@ -0,0 +1,220 @@
+package assets
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"time"
+
+	"github.com/tacusci/logging"
+)
+
+//Source is somewhere assets are read from, such as a directory on disk or the files built into the game
+type Source struct {
+	Name string
+	FS   fs.FS
+}
+
+//Dir is a source reading assets from a directory on disk
+func Dir(path string) Source {
+	return Source{Name: path, FS: os.DirFS(path)}
+}
+
+//decoder turns a file's contents into the value handed out for it, given the value it's replacing when reloaded
+type decoder func(name string, data []byte, old interface{}) (interface{}, error)
+
+//entry is an asset loaded by the manager, shared by every handle to it
+type entry struct {
+	key  string
+	name string
+	// how many handles to the asset haven't been released
+	refs int
+	// bumped every time the asset is reloaded
+	version int
+	value   interface{}
+	decode  decoder
+	release func(value interface{})
+	// which source the asset was read from and what its file looked like then, to notice when it changes
+	source  int
+	modTime time.Time
+	size    int64
+}
+
+//Manager loads assets by name from a chain of sources, the first holding a file winning, sharing each asset between
+//everything using it until the last releases it
+type Manager struct {
+	sources []Source
+	entries map[string]*entry
+	// updates between checks for changed files, 0 if not watching for them
+	interval  int
+	countdown int
+}
+
+//NewManager creates a manager reading from the sources, earlier sources overriding later ones
+func NewManager(sources ...Source) *Manager {
+	return &Manager{
+		sources: sources,
+		entries: map[string]*entry{},
+	}
+}
+
+//Watch checks for changed files every interval updates, reloading any assets they hold, or stops watching if interval is 0
+func (m *Manager) Watch(interval int) {
+	m.interval = interval
+	m.countdown = interval
+}
+
+//handle is what every typed handle shares, a reference to an entry
+type handle struct {
+	m        *Manager
+	e        *entry
+	released bool
+}
+
+//Name returns the name the asset was loaded by
+func (h *handle) Name() string {
+	return h.e.name
+}
+
+//Version returns how many times the asset has been reloaded, for noticing when it changes
+func (h *handle) Version() int {
+	return h.e.version
+}
+
+//Source returns the name of the source the asset was last read from
+func (h *handle) Source() string {
+	return h.m.sources[h.e.source].Name
+}
+
+//Release gives up the handle, the asset being unloaded once every handle to it has been released
+func (h *handle) Release() {
+	if h.released {
+		return
+	}
+	h.released = true
+	h.e.refs--
+	if h.e.refs > 0 {
+		return
+	}
+	delete(h.m.entries, h.e.key)
+	if h.e.release != nil {
+		h.e.release(h.e.value)
+	}
+}
+
+//acquire returns a handle to the named asset, loading it with decode if nothing holds it already
+func (m *Manager) acquire(kind, name string, decode decoder, release func(interface{})) (handle, error) {
+	key := kind + ":" + name
+	if e, ok := m.entries[key]; ok {
+		e.refs++
+		return handle{m: m, e: e}, nil
+	}
+
+	e := &entry{key: key, name: name, decode: decode, release: release}
+	if err := m.load(e); err != nil {
+		return handle{}, err
+	}
+	e.refs = 1
+	m.entries[key] = e
+	return handle{m: m, e: e}, nil
+}
+
+//find returns the first source holding the named file, along with the file's details
+func (m *Manager) find(name string) (int, fs.FileInfo, error) {
+	for i, s := range m.sources {
+		info, err := fs.Stat(s.FS, name)
+		if err == nil {
+			return i, info, nil
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return 0, nil, fmt.Errorf("unable to read asset %s from %s: %v", name, s.Name, err)
+		}
+	}
+	return 0, nil, fmt.Errorf("no asset called %s", name)
+}
+
+//Has returns whether any source holds the named file
+func (m *Manager) Has(name string) bool {
+	_, _, err := m.find(name)
+	return err == nil
+}
+
+//load reads and decodes the entry's file from the first source holding it
+func (m *Manager) load(e *entry) error {
+	source, info, err := m.find(e.name)
+	if err != nil {
+		return err
+	}
+
+	data, err := fs.ReadFile(m.sources[source].FS, e.name)
+	if err != nil {
+		return fmt.Errorf("unable to read asset %s from %s: %v", e.name, m.sources[source].Name, err)
+	}
+
+	value, err := e.decode(e.name, data, e.value)
+	if err != nil {
+		return fmt.Errorf("unable to load asset %s from %s: %v", e.name, m.sources[source].Name, err)
+	}
+
+	e.value = value
+	e.source = source
+	e.modTime, e.size = info.ModTime(), info.Size()
+	return nil
+}
+
+//Update reloads the assets whose files have changed if watching for them, call it once a tick
+func (m *Manager) Update() {
+	if m.interval <= 0 {
+		return
+	}
+	if m.countdown--; m.countdown > 0 {
+		return
+	}
+	m.countdown = m.interval
+	m.Reload()
+}
+
+//Reload reloads every asset whose file has changed, been overridden or had its override removed since it was loaded,
+//a file which fails to load being logged and the asset left as it was
+func (m *Manager) Reload() {
+	for _, e := range m.entries {
+		source, info, err := m.find(e.name)
+		if err != nil {
+			continue
+		}
+		if source == e.source && info.ModTime().Equal(e.modTime) && info.Size() == e.size {
+			continue
+		}
+
+		if err := m.load(e); err != nil {
+			logging.Error(err.Error())
+			// don't keep retrying until the file changes again
+			e.source, e.modTime, e.size = source, info.ModTime(), info.Size()
+			continue
+		}
+		e.version++
+		logging.Info(fmt.Sprintf("reloaded asset %s from %s", e.name, m.sources[e.source].Name))
+	}
+}
+
+//Bytes is a handle to a file's raw contents
+type Bytes struct {
+	handle
+}
+
+//Bytes returns a handle to the named file's contents
+func (m *Manager) Bytes(name string) (*Bytes, error) {
+	h, err := m.acquire("bytes", name, func(name string, data []byte, old interface{}) (interface{}, error) {
+		return data, nil
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Bytes{h}, nil
+}
+
+//Data returns the file's contents as they were last loaded
+func (b *Bytes) Data() []byte {
+	return b.e.value.([]byte)
+}
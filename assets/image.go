@@ -0,0 +1,57 @@
+package assets
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/draw"
+
+	"github.com/hajimehoshi/ebiten"
+)
+
+//Image is a handle to a decoded image, ready to be drawn
+type Image struct {
+	handle
+}
+
+//Image returns a handle to the named image file, which must be in a format registered with the image package
+func (m *Manager) Image(name string) (*Image, error) {
+	h, err := m.acquire("image", name, decodeImage, func(value interface{}) {
+		value.(*ebiten.Image).Dispose()
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &Image{h}, nil
+}
+
+//Image returns the image, which stays the same one as it's reloaded so it can be held on to
+func (i *Image) Image() *ebiten.Image {
+	return i.e.value.(*ebiten.Image)
+}
+
+//decodeImage decodes an image file, replacing the pixels of the image it's reloading in place
+func decodeImage(name string, data []byte, old interface{}) (interface{}, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	if old == nil {
+		return ebiten.NewImageFromImage(img, ebiten.FilterDefault)
+	}
+
+	// anything drawing the image holds on to it, so a reload can only change its pixels and not its size
+	current := old.(*ebiten.Image)
+	w, h := current.Size()
+	if img.Bounds().Dx() != w || img.Bounds().Dy() != h {
+		return nil, fmt.Errorf("image is %dx%d, restart to change it from %dx%d", img.Bounds().Dx(), img.Bounds().Dy(), w, h)
+	}
+
+	rgba := image.NewRGBA(image.Rect(0, 0, w, h))
+	draw.Draw(rgba, rgba.Bounds(), img, img.Bounds().Min, draw.Src)
+	if err := current.ReplacePixels(rgba.Pix); err != nil {
+		return nil, err
+	}
+	return current, nil
+}
@@ -0,0 +1,54 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/tacusci/logging"
+	"github.com/tauraamui/berrybun/atlas"
+)
+
+//runAtlas packs a directory of sprites into an atlas, for `berrybun atlas [options] dir`
+func runAtlas(args []string) error {
+	opts := atlas.DefaultOptions
+
+	flags := flag.NewFlagSet("atlas", flag.ContinueOnError)
+	out := flags.String("o", "res/atlas", "Path to write the atlas to, pages are written as <path>_<n>.png and the index as <path>.json")
+	flags.IntVar(&opts.MaxWidth, "width", opts.MaxWidth, "Largest width of a page")
+	flags.IntVar(&opts.MaxHeight, "height", opts.MaxHeight, "Largest height of a page")
+	flags.IntVar(&opts.Padding, "padding", opts.Padding, "Transparent pixels between sprites")
+	flags.IntVar(&opts.Extrude, "extrude", opts.Extrude, "Pixels to repeat each sprite's edges outwards by")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: berrybun atlas [options] <sprites dir>\n")
+		flags.PrintDefaults()
+	}
+
+	if err := flags.Parse(args); err == flag.ErrHelp {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		flags.Usage()
+		return fmt.Errorf("expected one directory of sprites, got %d arguments", flags.NArg())
+	}
+	if opts.MaxWidth <= 0 || opts.MaxHeight <= 0 || opts.Padding < 0 || opts.Extrude < 0 {
+		return fmt.Errorf("page size must be positive and padding and extrusion can't be negative")
+	}
+
+	inputs, err := atlas.ReadDir(flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	packed, err := atlas.Pack(inputs, opts, atlas.PageName(*out))
+	if err != nil {
+		return err
+	}
+	if err := packed.Write(*out); err != nil {
+		return err
+	}
+
+	logging.Info(fmt.Sprintf("packed %d sprites from %s into %d pages at %s", len(inputs), flags.Arg(0), len(packed.Pages), *out))
+	return nil
+}
@@ -0,0 +1,102 @@
+package atlas
+
+import (
+	"encoding/json"
+	"fmt"
+	"image"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/hajimehoshi/ebiten"
+)
+
+//Index is the JSON index written alongside an atlas's pages, saying where each sprite was packed
+type Index struct {
+	// image file of each page, by page number
+	Pages   []string          `json:"pages"`
+	Sprites map[string]Region `json:"sprites"`
+}
+
+//Region is where a sprite is on a page, in pixels
+type Region struct {
+	Page int `json:"page"`
+	X    int `json:"x"`
+	Y    int `json:"y"`
+	W    int `json:"w"`
+	H    int `json:"h"`
+}
+
+//Sprite is a sprite ready to draw, the page image it's on and where on it
+type Sprite struct {
+	Image *ebiten.Image
+	Rect  image.Rectangle
+}
+
+//Atlas looks sprites up by name from packed pages
+type Atlas struct {
+	pages   []*ebiten.Image
+	sprites map[string]Sprite
+}
+
+//Load reads an atlas's index, loading each of its pages by file name with page
+func Load(r io.Reader, page func(name string) (*ebiten.Image, error)) (*Atlas, error) {
+	var index Index
+	if err := json.NewDecoder(r).Decode(&index); err != nil {
+		return nil, fmt.Errorf("unable to read atlas index: %v", err)
+	}
+
+	a := &Atlas{sprites: map[string]Sprite{}}
+	for _, name := range index.Pages {
+		img, err := page(name)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load atlas page %s: %v", name, err)
+		}
+		a.pages = append(a.pages, img)
+	}
+
+	for name, reg := range index.Sprites {
+		if reg.Page < 0 || reg.Page >= len(a.pages) {
+			return nil, fmt.Errorf("sprite %s is on page %d, the atlas has %d", name, reg.Page, len(a.pages))
+		}
+		rect := image.Rect(reg.X, reg.Y, reg.X+reg.W, reg.Y+reg.H)
+		if w, h := a.pages[reg.Page].Size(); !rect.In(image.Rect(0, 0, w, h)) {
+			return nil, fmt.Errorf("sprite %s at %v is off its %dx%d page", name, rect, w, h)
+		}
+		a.sprites[name] = Sprite{Image: a.pages[reg.Page], Rect: rect}
+	}
+
+	return a, nil
+}
+
+//Sprite returns the named sprite, false if the atlas doesn't have it
+func (a *Atlas) Sprite(name string) (Sprite, bool) {
+	s, ok := a.sprites[name]
+	return s, ok
+}
+
+//Frames returns the frames of the named animation, its sprites name_0, name_1 and on for as long as they're numbered
+func (a *Atlas) Frames(name string) ([]Sprite, error) {
+	var frames []Sprite
+	for i := 0; ; i++ {
+		s, ok := a.sprites[name+"_"+strconv.Itoa(i)]
+		if !ok {
+			break
+		}
+		frames = append(frames, s)
+	}
+	if len(frames) == 0 {
+		return nil, fmt.Errorf("atlas has no frames of %s", name)
+	}
+	return frames, nil
+}
+
+//Names returns the name of every sprite in the atlas, sorted
+func (a *Atlas) Names() []string {
+	names := make([]string, 0, len(a.sprites))
+	for name := range a.sprites {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,86 @@
+package atlas
+
+import (
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/png"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+//ReadDir reads every PNG under a directory to pack, each named by its path within it without the extension
+func ReadDir(dir string) ([]Input, error) {
+	var inputs []Input
+	root := os.DirFS(dir)
+	err := fs.WalkDir(root, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || strings.ToLower(path.Ext(name)) != ".png" {
+			return nil
+		}
+
+		f, err := root.Open(name)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+
+		img, err := png.Decode(f)
+		if err != nil {
+			return fmt.Errorf("unable to decode %s: %v", name, err)
+		}
+		inputs = append(inputs, Input{Name: strings.TrimSuffix(name, path.Ext(name)), Image: img})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(inputs) == 0 {
+		return nil, fmt.Errorf("no PNGs in %s", dir)
+	}
+	return inputs, nil
+}
+
+//PageName returns the file name of an atlas's page, for an atlas written to out
+func PageName(out string) func(page int) string {
+	return func(page int) string {
+		return fmt.Sprintf("%s_%d.png", filepath.Base(out), page)
+	}
+}
+
+//Write saves the packed pages next to out, and the index to out.json
+func (p *Packed) Write(out string) error {
+	dir := filepath.Dir(out)
+	for i, page := range p.Pages {
+		if err := writePNG(filepath.Join(dir, p.Index.Pages[i]), page); err != nil {
+			return err
+		}
+	}
+
+	data, err := json.MarshalIndent(p.Index, "", "\t")
+	if err != nil {
+		return fmt.Errorf("unable to write atlas index: %v", err)
+	}
+	if err := os.WriteFile(out+".json", append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("unable to write atlas index: %v", err)
+	}
+	return nil
+}
+
+func writePNG(name string, img image.Image) error {
+	f, err := os.Create(name)
+	if err != nil {
+		return fmt.Errorf("unable to write atlas page: %v", err)
+	}
+	defer f.Close()
+
+	if err := png.Encode(f, img); err != nil {
+		return fmt.Errorf("unable to write atlas page %s: %v", name, err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,78 @@
+package atlas
+
+import "image"
+
+//maxRects packs rectangles into a bin by keeping every maximal free rectangle left, placing each rectangle in the free
+//one it fits most snugly by its shorter leftover side
+type maxRects struct {
+	bounds image.Rectangle
+	free   []image.Rectangle
+}
+
+func newMaxRects(width, height int) *maxRects {
+	bounds := image.Rect(0, 0, width, height)
+	return &maxRects{bounds: bounds, free: []image.Rectangle{bounds}}
+}
+
+//insert places a rectangle of the size in the bin, returning false if there's no room for it
+func (p *maxRects) insert(width, height int) (image.Rectangle, bool) {
+	best := image.Rectangle{}
+	bestShort, bestLong := -1, -1
+	for _, f := range p.free {
+		if f.Dx() < width || f.Dy() < height {
+			continue
+		}
+		short, long := f.Dx()-width, f.Dy()-height
+		if short > long {
+			short, long = long, short
+		}
+		if bestShort < 0 || short < bestShort || short == bestShort && long < bestLong {
+			best = image.Rect(f.Min.X, f.Min.Y, f.Min.X+width, f.Min.Y+height)
+			bestShort, bestLong = short, long
+		}
+	}
+	if bestShort < 0 {
+		return image.Rectangle{}, false
+	}
+
+	p.place(best)
+	return best, true
+}
+
+//place splits every free rectangle the placed one overlaps into the largest free rectangles around it
+func (p *maxRects) place(used image.Rectangle) {
+	free := make([]image.Rectangle, 0, len(p.free)+4)
+	for _, f := range p.free {
+		if !f.Overlaps(used) {
+			free = append(free, f)
+			continue
+		}
+		if used.Min.X > f.Min.X {
+			free = append(free, image.Rect(f.Min.X, f.Min.Y, used.Min.X, f.Max.Y))
+		}
+		if used.Max.X < f.Max.X {
+			free = append(free, image.Rect(used.Max.X, f.Min.Y, f.Max.X, f.Max.Y))
+		}
+		if used.Min.Y > f.Min.Y {
+			free = append(free, image.Rect(f.Min.X, f.Min.Y, f.Max.X, used.Min.Y))
+		}
+		if used.Max.Y < f.Max.Y {
+			free = append(free, image.Rect(f.Min.X, used.Max.Y, f.Max.X, f.Max.Y))
+		}
+	}
+
+	// a free rectangle inside another adds nothing
+	p.free = make([]image.Rectangle, 0, len(free))
+	for i, f := range free {
+		contained := false
+		for j, g := range free {
+			if i != j && f.In(g) && (f != g || i > j) {
+				contained = true
+				break
+			}
+		}
+		if !contained {
+			p.free = append(p.free, f)
+		}
+	}
+}
@@ -0,0 +1,170 @@
+package atlas
+
+import (
+	"fmt"
+	"image"
+	"image/draw"
+	"sort"
+)
+
+//Input is an image to pack into an atlas, looked up by its name once packed
+type Input struct {
+	Name  string
+	Image image.Image
+}
+
+//Options control how sprites are packed
+type Options struct {
+	// largest size of an atlas page, more pages are made once one is full
+	MaxWidth, MaxHeight int
+	// transparent pixels between sprites
+	Padding int
+	// pixels each sprite's edges are repeated outwards by, so filtering or rounding never samples a neighbour
+	Extrude int
+}
+
+//DefaultOptions are the options the game's atlas is packed with
+var DefaultOptions = Options{MaxWidth: 1024, MaxHeight: 1024, Padding: 1, Extrude: 1}
+
+//Packed is the result of packing, the page images and the index of where each sprite went
+type Packed struct {
+	Pages []*image.NRGBA
+	Index *Index
+}
+
+//Pack packs the images into as few pages as they fit on, largest first, naming the pages with pageName
+func Pack(inputs []Input, opts Options, pageName func(page int) string) (*Packed, error) {
+	border := opts.Padding + 2*opts.Extrude
+
+	sorted := make([]Input, len(inputs))
+	copy(sorted, inputs)
+	sort.Slice(sorted, func(i, j int) bool {
+		a, b := sorted[i].Image.Bounds(), sorted[j].Image.Bounds()
+		if sa, sb := longest(a), longest(b); sa != sb {
+			return sa > sb
+		}
+		if a.Dx()*a.Dy() != b.Dx()*b.Dy() {
+			return a.Dx()*a.Dy() > b.Dx()*b.Dy()
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	seen := map[string]bool{}
+	for _, in := range sorted {
+		if seen[in.Name] {
+			return nil, fmt.Errorf("more than one sprite called %s", in.Name)
+		}
+		seen[in.Name] = true
+
+		b := in.Image.Bounds()
+		if b.Dx()+border > opts.MaxWidth+opts.Padding || b.Dy()+border > opts.MaxHeight+opts.Padding {
+			return nil, fmt.Errorf("sprite %s is %dx%d, too big for a %dx%d page", in.Name, b.Dx(), b.Dy(), opts.MaxWidth, opts.MaxHeight)
+		}
+	}
+
+	packed := &Packed{Index: &Index{Sprites: map[string]Region{}}}
+	for len(sorted) > 0 {
+		page := len(packed.Pages)
+		placed, cells, left := packPage(sorted, opts, border)
+		used := image.Rectangle{}
+		for _, c := range cells {
+			used = used.Union(image.Rect(c.Min.X, c.Min.Y, c.Max.X-opts.Padding, c.Max.Y-opts.Padding))
+		}
+		img := image.NewNRGBA(image.Rect(0, 0, used.Max.X, used.Max.Y))
+		for i, in := range placed {
+			b := in.Image.Bounds()
+			at := cells[i].Min.Add(image.Pt(opts.Extrude, opts.Extrude))
+			r := image.Rectangle{Min: at, Max: at.Add(b.Size())}
+			draw.Draw(img, r, in.Image, b.Min, draw.Src)
+			extrude(img, r, opts.Extrude)
+			packed.Index.Sprites[in.Name] = Region{Page: page, X: r.Min.X, Y: r.Min.Y, W: r.Dx(), H: r.Dy()}
+		}
+
+		packed.Pages = append(packed.Pages, img)
+		packed.Index.Pages = append(packed.Index.Pages, pageName(page))
+		sorted = left
+	}
+
+	return packed, nil
+}
+
+//packPage packs as many of the sprites as fit on one page, trying pages from just big enough for the largest sprite
+//and doubling a side at a time, so a few small sprites don't sprawl over a page the largest size
+func packPage(sprites []Input, opts Options, border int) (placed []Input, cells []image.Rectangle, left []Input) {
+	w, h := 1, 1
+	for _, in := range sprites {
+		b := in.Image.Bounds()
+		for w < b.Dx()+border-opts.Padding {
+			w *= 2
+		}
+		for h < b.Dy()+border-opts.Padding {
+			h *= 2
+		}
+	}
+
+	for {
+		w, h = min(w, opts.MaxWidth), min(h, opts.MaxHeight)
+		placed, cells, left = nil, nil, nil
+		// the padding trailing the last sprite in a row or column can hang off the page
+		bin := newMaxRects(w+opts.Padding, h+opts.Padding)
+		for _, in := range sprites {
+			b := in.Image.Bounds()
+			cell, ok := bin.insert(b.Dx()+border, b.Dy()+border)
+			if !ok {
+				left = append(left, in)
+				continue
+			}
+			placed = append(placed, in)
+			cells = append(cells, cell)
+		}
+
+		if len(left) == 0 || w == opts.MaxWidth && h == opts.MaxHeight {
+			return placed, cells, left
+		}
+		if w <= h && w < opts.MaxWidth || h == opts.MaxHeight {
+			w *= 2
+		} else {
+			h *= 2
+		}
+	}
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func longest(r image.Rectangle) int {
+	if r.Dx() > r.Dy() {
+		return r.Dx()
+	}
+	return r.Dy()
+}
+
+//extrude repeats the pixels along the edges of r outwards by n pixels
+func extrude(img *image.NRGBA, r image.Rectangle, n int) {
+	if n <= 0 || r.Empty() {
+		return
+	}
+	outer := r.Inset(-n)
+	for y := outer.Min.Y; y < outer.Max.Y; y++ {
+		for x := outer.Min.X; x < outer.Max.X; x++ {
+			if (image.Point{X: x, Y: y}).In(r) {
+				continue
+			}
+			img.SetNRGBA(x, y, img.NRGBAAt(clamp(x, r.Min.X, r.Max.X-1), clamp(y, r.Min.Y, r.Max.Y-1)))
+		}
+	}
+}
+
+func clamp(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
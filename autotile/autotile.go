@@ -0,0 +1,181 @@
+package autotile
+
+//Grid is a map of terrain types, one per cell
+type Grid interface {
+	Size() (int, int)
+	Terrain(x, y int) int
+}
+
+//Scheme is the way a set of transition tiles is laid out
+type Scheme int
+
+const (
+	//Blob picks from 47 tiles by which of the 8 neighbouring cells share the terrain
+	Blob Scheme = iota
+	//WangCorner picks from 16 tiles by which of the 4 corners of the cell are surrounded by the terrain
+	WangCorner
+)
+
+// neighbour bits of a blob mask, clockwise from north
+const (
+	N uint8 = 1 << iota
+	NE
+	E
+	SE
+	S
+	SW
+	W
+	NW
+)
+
+// corner bits of a Wang corner mask, clockwise from top left
+const (
+	CornerTL uint8 = 1 << iota
+	CornerTR
+	CornerBR
+	CornerBL
+)
+
+var (
+	// every blob mask once corners without both their edges are dropped, there are 47 of them
+	blobMasks []uint8
+	// index into blobMasks for every possible raw mask
+	blobIndices [256]int
+)
+
+func init() {
+	seen := map[uint8]int{}
+	for raw := 0; raw < 256; raw++ {
+		mask := reduceBlob(uint8(raw))
+		index, ok := seen[mask]
+		if !ok {
+			index = len(blobMasks)
+			seen[mask] = index
+			blobMasks = append(blobMasks, mask)
+		}
+		blobIndices[raw] = index
+	}
+}
+
+//reduceBlob drops any corner neighbours which don't also have both neighbouring edges, as they make no visual difference
+func reduceBlob(mask uint8) uint8 {
+	corners := [4][3]uint8{{NE, N, E}, {SE, S, E}, {SW, S, W}, {NW, N, W}}
+	for _, c := range corners {
+		if mask&c[1] == 0 || mask&c[2] == 0 {
+			mask &^= c[0]
+		}
+	}
+	return mask
+}
+
+//BlobMasks returns the 47 distinct blob masks, in index order
+func BlobMasks() []uint8 {
+	masks := make([]uint8, len(blobMasks))
+	copy(masks, blobMasks)
+	return masks
+}
+
+//BlobIndex returns which of the 47 blob tiles a neighbour mask maps to
+func BlobIndex(mask uint8) int {
+	return blobIndices[mask]
+}
+
+//same returns whether the cell is of the terrain, cells off the edge of the grid count as matching so borders don't show
+func same(g Grid, x, y, terrain int) bool {
+	w, h := g.Size()
+	if x < 0 || y < 0 || x >= w || y >= h {
+		return true
+	}
+	return g.Terrain(x, y) == terrain
+}
+
+//BlobMask returns which of the 8 neighbours of the cell share the terrain
+func BlobMask(g Grid, x, y, terrain int) uint8 {
+	offsets := [8][2]int{{0, -1}, {1, -1}, {1, 0}, {1, 1}, {0, 1}, {-1, 1}, {-1, 0}, {-1, -1}}
+	var mask uint8
+	for i, o := range offsets {
+		if same(g, x+o[0], y+o[1], terrain) {
+			mask |= 1 << uint(i)
+		}
+	}
+	return mask
+}
+
+//WangCorners returns which corners of the cell touch only cells of the terrain
+func WangCorners(g Grid, x, y, terrain int) uint8 {
+	if !same(g, x, y, terrain) {
+		return 0
+	}
+
+	corner := func(dx, dy int) bool {
+		return same(g, x+dx, y, terrain) && same(g, x, y+dy, terrain) && same(g, x+dx, y+dy, terrain)
+	}
+
+	var mask uint8
+	if corner(-1, -1) {
+		mask |= CornerTL
+	}
+	if corner(1, -1) {
+		mask |= CornerTR
+	}
+	if corner(1, 1) {
+		mask |= CornerBR
+	}
+	if corner(-1, 1) {
+		mask |= CornerBL
+	}
+	return mask
+}
+
+//Set is the transition tiles for drawing one terrain against whatever surrounds it
+type Set struct {
+	Terrain int
+	Scheme  Scheme
+	// tiles keyed by blob index or Wang corner mask, with more than one tile variations are picked between
+	Tiles map[int][]int
+	// used for any key without tiles, such as the Wang diagonals most tilesets leave out
+	Fallback []int
+}
+
+//Key returns the blob index or Wang corner mask of the cell for this set's scheme
+func (s *Set) Key(g Grid, x, y int) int {
+	if s.Scheme == WangCorner {
+		return int(WangCorners(g, x, y, s.Terrain))
+	}
+	return BlobIndex(BlobMask(g, x, y, s.Terrain))
+}
+
+//Tile picks the tile for the cell, variant chooses between tiles for the same key
+func (s *Set) Tile(g Grid, x, y int, variant uint32) (int, bool) {
+	tiles := s.Tiles[s.Key(g, x, y)]
+	if len(tiles) == 0 {
+		tiles = s.Fallback
+	}
+	if len(tiles) == 0 {
+		return 0, false
+	}
+	return tiles[variant%uint32(len(tiles))], true
+}
+
+//Tiler autotiles a grid using a set per terrain
+type Tiler struct {
+	sets map[int]*Set
+}
+
+//NewTiler creates a tiler using the provided sets, the last set for a terrain wins
+func NewTiler(sets ...*Set) *Tiler {
+	t := &Tiler{sets: map[int]*Set{}}
+	for _, s := range sets {
+		t.sets[s.Terrain] = s
+	}
+	return t
+}
+
+//Tile picks the tile for the cell, returning false if there isn't a set for its terrain
+func (t *Tiler) Tile(g Grid, x, y int, variant uint32) (int, bool) {
+	s, ok := t.sets[g.Terrain(x, y)]
+	if !ok {
+		return 0, false
+	}
+	return s.Tile(g, x, y, variant)
+}
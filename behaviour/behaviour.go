@@ -0,0 +1,65 @@
+package behaviour
+
+//Status is the outcome of ticking a node
+type Status int
+
+const (
+	//Success means the node did what it set out to
+	Success Status = iota
+	//Failure means the node couldn't do what it set out to
+	Failure
+	//Running means the node needs more ticks to finish
+	Running
+)
+
+func (s Status) String() string {
+	switch s {
+	case Success:
+		return "success"
+	case Failure:
+		return "failure"
+	case Running:
+		return "running"
+	}
+	return "unknown"
+}
+
+//Context is passed to every node as a tree is ticked
+type Context struct {
+	// whatever the tree is controlling, leaves cast it to the type they expect
+	Agent      interface{}
+	Blackboard *Blackboard
+}
+
+//Node is a single node of a behaviour tree
+type Node interface {
+	Tick(ctx *Context) Status
+	// Reset returns the node to how it was before it was first ticked, when a running branch is abandoned
+	Reset()
+}
+
+//Tree is a behaviour tree with its own blackboard
+type Tree struct {
+	root       Node
+	blackboard *Blackboard
+}
+
+//NewTree creates a tree from its root node, with an empty blackboard
+func NewTree(root Node) *Tree {
+	return &Tree{root: root, blackboard: NewBlackboard()}
+}
+
+//Blackboard returns the memory shared between the tree's nodes
+func (t *Tree) Blackboard() *Blackboard {
+	return t.blackboard
+}
+
+//Tick runs the tree once for the agent
+func (t *Tree) Tick(agent interface{}) Status {
+	return t.root.Tick(&Context{Agent: agent, Blackboard: t.blackboard})
+}
+
+//Reset abandons whatever the tree was part way through
+func (t *Tree) Reset() {
+	t.root.Reset()
+}
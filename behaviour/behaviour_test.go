@@ -0,0 +1,386 @@
+package behaviour
+
+import (
+	"strings"
+	"testing"
+)
+
+//stub is a node which returns the statuses it's given in turn, the last forever, counting its ticks and resets
+type stub struct {
+	statuses []Status
+	ticks    int
+	resets   int
+}
+
+func returns(statuses ...Status) *stub {
+	return &stub{statuses: statuses}
+}
+
+func (s *stub) Tick(ctx *Context) Status {
+	i := s.ticks
+	if i >= len(s.statuses) {
+		i = len(s.statuses) - 1
+	}
+	s.ticks++
+	return s.statuses[i]
+}
+
+func (s *stub) Reset() {
+	s.resets++
+}
+
+func tick(n Node) Status {
+	return n.Tick(&Context{Blackboard: NewBlackboard()})
+}
+
+func TestSequence(t *testing.T) {
+	a, b, c := returns(Success), returns(Failure), returns(Success)
+	if status := tick(&Sequence{Children: []Node{a, b, c}}); status != Failure {
+		t.Errorf("sequence with a failing child is %v", status)
+	}
+	if a.ticks != 1 || b.ticks != 1 || c.ticks != 0 {
+		t.Errorf("children ticked %d, %d and %d times, expected to stop at the failure", a.ticks, b.ticks, c.ticks)
+	}
+
+	a, b = returns(Success), returns(Success)
+	if status := tick(&Sequence{Children: []Node{a, b}}); status != Success {
+		t.Errorf("sequence of successes is %v", status)
+	}
+}
+
+func TestSequenceResumesRunningChild(t *testing.T) {
+	first, running, last := returns(Success), returns(Running, Running, Success), returns(Success)
+	s := &Sequence{Children: []Node{first, running, last}}
+	ctx := &Context{Blackboard: NewBlackboard()}
+
+	for i := 0; i < 2; i++ {
+		if status := s.Tick(ctx); status != Running {
+			t.Fatalf("tick %d is %v, expected running", i, status)
+		}
+	}
+	if first.ticks != 1 {
+		t.Errorf("the child before the running one was ticked %d times, expected once", first.ticks)
+	}
+	if status := s.Tick(ctx); status != Success || last.ticks != 1 {
+		t.Errorf("sequence is %v with the last child ticked %d times once the running one succeeds", status, last.ticks)
+	}
+
+	// finishing starts it from the top again
+	s.Tick(ctx)
+	if first.ticks != 2 {
+		t.Errorf("sequence didn't start over after finishing")
+	}
+}
+
+func TestSelector(t *testing.T) {
+	a, b, c := returns(Failure), returns(Success), returns(Success)
+	if status := tick(&Selector{Children: []Node{a, b, c}, running: 3}); status != Success {
+		t.Errorf("selector with a succeeding child is %v", status)
+	}
+	if c.ticks != 0 {
+		t.Errorf("selector ticked past the first child to succeed")
+	}
+
+	if status := tick(&Selector{Children: []Node{returns(Failure), returns(Failure)}, running: 2}); status != Failure {
+		t.Errorf("selector of failures is %v", status)
+	}
+}
+
+func TestSelectorInterruptsLowerPriority(t *testing.T) {
+	threat := returns(Failure, Failure, Success)
+	wander := returns(Running)
+	s := &Selector{Children: []Node{threat, wander}, running: 2}
+	ctx := &Context{Blackboard: NewBlackboard()}
+
+	s.Tick(ctx)
+	s.Tick(ctx)
+	if wander.resets != 0 {
+		t.Fatalf("running child reset while nothing took over from it")
+	}
+	if status := s.Tick(ctx); status != Success {
+		t.Fatalf("selector is %v once the higher priority child succeeds", status)
+	}
+	if wander.resets != 1 {
+		t.Errorf("running lower priority child was reset %d times when interrupted, expected once", wander.resets)
+	}
+	if threat.ticks != 3 {
+		t.Errorf("higher priority child ticked %d times, expected to be checked every tick", threat.ticks)
+	}
+}
+
+func TestDecorators(t *testing.T) {
+	tests := []struct {
+		name     string
+		node     Node
+		expected Status
+	}{
+		{"invert success", &Invert{Child: returns(Success)}, Failure},
+		{"invert failure", &Invert{Child: returns(Failure)}, Success},
+		{"invert running", &Invert{Child: returns(Running)}, Running},
+		{"succeed failure", &Succeed{Child: returns(Failure)}, Success},
+		{"succeed running", &Succeed{Child: returns(Running)}, Running},
+		{"repeat failure", &Repeat{Child: returns(Failure)}, Failure},
+	}
+	for _, test := range tests {
+		if status := tick(test.node); status != test.expected {
+			t.Errorf("%s is %v, expected %v", test.name, status, test.expected)
+		}
+	}
+}
+
+func TestRepeat(t *testing.T) {
+	child := returns(Success)
+	r := &Repeat{Child: child, Times: 3}
+	ctx := &Context{Blackboard: NewBlackboard()}
+	for i := 0; i < 2; i++ {
+		if status := r.Tick(ctx); status != Running {
+			t.Fatalf("repeat %d of 3 is %v, expected running", i+1, status)
+		}
+	}
+	if status := r.Tick(ctx); status != Success {
+		t.Errorf("third repeat is %v, expected success", status)
+	}
+	if child.resets != 2 {
+		t.Errorf("child reset %d times between repeats, expected 2", child.resets)
+	}
+
+	forever := &Repeat{Child: returns(Success)}
+	for i := 0; i < 100; i++ {
+		if forever.Tick(ctx) != Running {
+			t.Fatalf("repeating forever stopped after %d", i)
+		}
+	}
+}
+
+func TestCooldown(t *testing.T) {
+	child := returns(Success)
+	c := &Cooldown{Child: child, Ticks: 2}
+	ctx := &Context{Blackboard: NewBlackboard()}
+
+	statuses := []Status{Success, Failure, Failure, Success}
+	for i, expected := range statuses {
+		if status := c.Tick(ctx); status != expected {
+			t.Errorf("tick %d is %v, expected %v", i, status, expected)
+		}
+	}
+	if child.ticks != 2 {
+		t.Errorf("child ticked %d times, expected not while cooling down", child.ticks)
+	}
+
+	// abandoning it doesn't skip the cooldown
+	c.Reset()
+	if c.Tick(ctx) != Failure {
+		t.Errorf("resetting a cooldown let its child run straight away")
+	}
+}
+
+func TestBlackboard(t *testing.T) {
+	b := NewBlackboard()
+	if b.Has("x") || b.Float("x") != 0 || b.Bool("x") || b.String("x") != "" {
+		t.Errorf("empty blackboard has values")
+	}
+
+	b.Set("count", 3)
+	b.Set("speed", 1.5)
+	b.Set("scared", true)
+	b.Set("name", "fox")
+	if b.Float("count") != 3 || b.Float("speed") != 1.5 || !b.Bool("scared") || b.String("name") != "fox" {
+		t.Errorf("blackboard values read back wrong")
+	}
+	if b.Bool("name") || b.String("count") != "" || b.Float("name") != 0 {
+		t.Errorf("values of the wrong type aren't read as zero")
+	}
+	if v, ok := b.Get("name"); !ok || v != "fox" {
+		t.Errorf("Get returned %v, %v", v, ok)
+	}
+
+	b.Delete("name")
+	if b.Has("name") {
+		t.Errorf("deleted value is still there")
+	}
+}
+
+func TestBlackboardNodes(t *testing.T) {
+	ctx := &Context{Blackboard: NewBlackboard()}
+	has := &HasKey{Key: "target"}
+	if has.Tick(ctx) != Failure {
+		t.Errorf("HasKey succeeded without the key")
+	}
+	(&SetKey{Key: "target", Value: "bunny"}).Tick(ctx)
+	if has.Tick(ctx) != Success || ctx.Blackboard.String("target") != "bunny" {
+		t.Errorf("SetKey didn't set the key")
+	}
+	(&SetKey{Key: "target"}).Tick(ctx)
+	if has.Tick(ctx) != Failure {
+		t.Errorf("SetKey without a value didn't remove the key")
+	}
+
+	// the tree's blackboard is shared between ticks
+	tree := NewTree(&Sequence{Children: []Node{&Invert{Child: &HasKey{Key: "seen"}}, &SetKey{Key: "seen", Value: true}}})
+	if tree.Tick(nil) != Success || tree.Tick(nil) != Failure {
+		t.Errorf("tree didn't remember what it set")
+	}
+	if !tree.Blackboard().Bool("seen") {
+		t.Errorf("tree's blackboard doesn't have what was set")
+	}
+}
+
+func TestLeafState(t *testing.T) {
+	var seen []interface{}
+	count := func(ctx *Context, params Params, state *interface{}) Status {
+		seen = append(seen, *state)
+		if *state == nil {
+			*state = params.Int("ticks", 0)
+		}
+		left := (*state).(int) - 1
+		if left <= 0 {
+			return Success
+		}
+		*state = left
+		return Running
+	}
+	leaf := &Leaf{Name: "count", Params: Params{"ticks": 3.0}, fn: count}
+	ctx := &Context{Blackboard: NewBlackboard()}
+
+	statuses := []Status{leaf.Tick(ctx), leaf.Tick(ctx), leaf.Tick(ctx), leaf.Tick(ctx)}
+	if statuses[0] != Running || statuses[1] != Running || statuses[2] != Success || statuses[3] != Running {
+		t.Errorf("statuses are %v, expected running, running, success then running again", statuses)
+	}
+	if seen[0] != nil || seen[1] != 2 || seen[2] != 1 || seen[3] != nil {
+		t.Errorf("leaf saw states %v, expected it kept while running and cleared once finished", seen)
+	}
+
+	leaf.Tick(ctx)
+	leaf.Reset()
+	leaf.Tick(ctx)
+	if seen[len(seen)-1] != nil {
+		t.Errorf("leaf kept its state after being reset")
+	}
+}
+
+//request is leaf state waiting on something, counting how many times it's abandoned
+type request struct {
+	abandoned int
+}
+
+func (r *request) Abandon() {
+	r.abandoned++
+}
+
+func TestLeafAbandoned(t *testing.T) {
+	r := &request{}
+	wait := func(ctx *Context, params Params, state *interface{}) Status {
+		if *state == nil {
+			*state = r
+		}
+		return Running
+	}
+	leaf := &Leaf{Name: "wait", fn: wait}
+	ctx := &Context{Blackboard: NewBlackboard()}
+
+	leaf.Reset()
+	if r.abandoned != 0 {
+		t.Errorf("resetting a leaf which hadn't started abandoned its state")
+	}
+	leaf.Tick(ctx)
+	leaf.Reset()
+	leaf.Reset()
+	if r.abandoned != 1 {
+		t.Errorf("state abandoned %d times by resetting the running leaf twice, expected once", r.abandoned)
+	}
+}
+
+func TestLoadTree(t *testing.T) {
+	d, err := Parse(strings.NewReader(`{
+		"type": "selector",
+		"children": [
+			{
+				"type": "sequence",
+				"children": [
+					{"type": "condition", "name": "hungry"},
+					{"type": "setKey", "params": {"key": "ate", "value": true}},
+					{"type": "action", "name": "eat", "params": {"food": "berry"}}
+				]
+			},
+			{"type": "cooldown", "params": {"ticks": 5}, "child": {"type": "action", "name": "sleep"}}
+		]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	hungry := true
+	var ate, slept []string
+	r := NewRegistry()
+	r.Register("hungry", func(ctx *Context, params Params, state *interface{}) Status {
+		if hungry {
+			return Success
+		}
+		return Failure
+	})
+	r.Register("eat", func(ctx *Context, params Params, state *interface{}) Status {
+		ate = append(ate, params.String("food", ""))
+		return Success
+	})
+	r.Register("sleep", func(ctx *Context, params Params, state *interface{}) Status {
+		slept = append(slept, ctx.Agent.(string))
+		return Success
+	})
+
+	tree, err := r.NewTree(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tree.Tick("bunny") != Success || len(ate) != 1 || ate[0] != "berry" || len(slept) != 0 {
+		t.Errorf("hungry tree ate %v and slept %v, expected it to eat a berry", ate, slept)
+	}
+	if !tree.Blackboard().Bool("ate") {
+		t.Errorf("setKey from the definition didn't set ate")
+	}
+
+	hungry = false
+	if tree.Tick("bunny") != Success || len(slept) != 1 || slept[0] != "bunny" {
+		t.Errorf("full tree slept %v, expected the agent to sleep", slept)
+	}
+	if tree.Tick("bunny") != Failure || len(slept) != 1 {
+		t.Errorf("sleeping again straight away ignored the cooldown")
+	}
+
+	// each tree built has its own state
+	other, _ := r.NewTree(d)
+	if other.Tick("fox") != Success || len(slept) != 2 {
+		t.Errorf("a second tree shared the first's cooldown")
+	}
+}
+
+func TestLoadTreeErrors(t *testing.T) {
+	r := NewRegistry()
+	r.Register("known", func(ctx *Context, params Params, state *interface{}) Status { return Success })
+	for _, src := range []string{
+		`{"type": "teleport"}`,
+		`{"type": "action", "name": "unknown"}`,
+		`{"type": "invert"}`,
+		`{"type": "sequence", "children": [{"type": "known"}]}`,
+	} {
+		d, err := Parse(strings.NewReader(src))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := r.NewTree(d); err == nil {
+			t.Errorf("built broken tree %s", src)
+		}
+	}
+	if _, err := Parse(strings.NewReader(`{"type": `)); err == nil {
+		t.Errorf("parsed broken JSON")
+	}
+}
+
+func TestParams(t *testing.T) {
+	p := Params{"n": 2.5, "s": "x", "b": true}
+	if p.Float("n", 0) != 2.5 || p.Int("n", 0) != 2 || p.String("s", "") != "x" || !p.Bool("b", false) {
+		t.Errorf("params read back wrong")
+	}
+	if p.Float("missing", 7) != 7 || p.Int("s", 3) != 3 || p.String("n", "d") != "d" || !p.Bool("missing", true) {
+		t.Errorf("missing or mistyped params don't fall back to the default")
+	}
+}
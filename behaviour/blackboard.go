@@ -0,0 +1,56 @@
+package behaviour
+
+//Blackboard is memory shared between the nodes of a tree, such as the target a creature is fleeing from
+type Blackboard struct {
+	values map[string]interface{}
+}
+
+//NewBlackboard creates an empty blackboard
+func NewBlackboard() *Blackboard {
+	return &Blackboard{values: map[string]interface{}{}}
+}
+
+//Set stores a value under the key, replacing any already there
+func (b *Blackboard) Set(key string, value interface{}) {
+	b.values[key] = value
+}
+
+//Get returns the value under the key, and whether there is one
+func (b *Blackboard) Get(key string) (interface{}, bool) {
+	v, ok := b.values[key]
+	return v, ok
+}
+
+//Has returns whether there's a value under the key
+func (b *Blackboard) Has(key string) bool {
+	_, ok := b.values[key]
+	return ok
+}
+
+//Delete removes the value under the key
+func (b *Blackboard) Delete(key string) {
+	delete(b.values, key)
+}
+
+//Float returns the number under the key, 0 if there isn't one
+func (b *Blackboard) Float(key string) float64 {
+	switch v := b.values[key].(type) {
+	case float64:
+		return v
+	case int:
+		return float64(v)
+	}
+	return 0
+}
+
+//Bool returns the flag under the key, false if there isn't one
+func (b *Blackboard) Bool(key string) bool {
+	v, _ := b.values[key].(bool)
+	return v
+}
+
+//String returns the text under the key, empty if there isn't any
+func (b *Blackboard) String(key string) string {
+	v, _ := b.values[key].(string)
+	return v
+}
@@ -0,0 +1,138 @@
+package behaviour
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+//Params are the settings given to a node in a tree's definition
+type Params map[string]interface{}
+
+//Float returns the number under the key, def if there isn't one
+func (p Params) Float(key string, def float64) float64 {
+	if v, ok := p[key].(float64); ok {
+		return v
+	}
+	return def
+}
+
+//Int returns the number under the key as a whole number, def if there isn't one
+func (p Params) Int(key string, def int) int {
+	if v, ok := p[key].(float64); ok {
+		return int(v)
+	}
+	return def
+}
+
+//String returns the text under the key, def if there isn't any
+func (p Params) String(key, def string) string {
+	if v, ok := p[key].(string); ok {
+		return v
+	}
+	return def
+}
+
+//Bool returns the flag under the key, def if there isn't one
+func (p Params) Bool(key string, def bool) bool {
+	if v, ok := p[key].(bool); ok {
+		return v
+	}
+	return def
+}
+
+//Definition is a node of a tree as written in a data file
+type Definition struct {
+	// sequence, selector, invert, succeed, repeat, cooldown, hasKey, setKey, condition or action
+	Type string `json:"type"`
+	// which registered condition or action a leaf runs
+	Name     string       `json:"name,omitempty"`
+	Params   Params       `json:"params,omitempty"`
+	Children []Definition `json:"children,omitempty"`
+	// the node a decorator wraps
+	Child *Definition `json:"child,omitempty"`
+}
+
+//Parse reads a tree definition from JSON
+func Parse(r io.Reader) (*Definition, error) {
+	var d Definition
+	if err := json.NewDecoder(r).Decode(&d); err != nil {
+		return nil, fmt.Errorf("unable to decode behaviour tree: %v", err)
+	}
+	return &d, nil
+}
+
+//Registry is the conditions and actions leaves of a tree definition can name
+type Registry struct {
+	leaves map[string]LeafFunc
+}
+
+//NewRegistry creates a registry without any leaves
+func NewRegistry() *Registry {
+	return &Registry{leaves: map[string]LeafFunc{}}
+}
+
+//Register makes a condition or action available to trees under the name
+func (r *Registry) Register(name string, fn LeafFunc) {
+	r.leaves[name] = fn
+}
+
+//NewTree builds a tree from a definition, each tree built has its own state so one is needed per agent
+func (r *Registry) NewTree(d *Definition) (*Tree, error) {
+	root, err := r.build(d)
+	if err != nil {
+		return nil, err
+	}
+	return NewTree(root), nil
+}
+
+func (r *Registry) build(d *Definition) (Node, error) {
+	switch d.Type {
+	case "sequence", "selector":
+		children := make([]Node, 0, len(d.Children))
+		for i := range d.Children {
+			c, err := r.build(&d.Children[i])
+			if err != nil {
+				return nil, err
+			}
+			children = append(children, c)
+		}
+		if d.Type == "sequence" {
+			return &Sequence{Children: children}, nil
+		}
+		return &Selector{Children: children, running: len(children)}, nil
+
+	case "invert", "succeed", "repeat", "cooldown":
+		if d.Child == nil {
+			return nil, fmt.Errorf("%s node needs a child", d.Type)
+		}
+		child, err := r.build(d.Child)
+		if err != nil {
+			return nil, err
+		}
+		switch d.Type {
+		case "invert":
+			return &Invert{Child: child}, nil
+		case "succeed":
+			return &Succeed{Child: child}, nil
+		case "repeat":
+			return &Repeat{Child: child, Times: d.Params.Int("times", 0)}, nil
+		}
+		return &Cooldown{Child: child, Ticks: d.Params.Int("ticks", 0)}, nil
+
+	case "hasKey":
+		return &HasKey{Key: d.Params.String("key", "")}, nil
+
+	case "setKey":
+		return &SetKey{Key: d.Params.String("key", ""), Value: d.Params["value"]}, nil
+
+	case "condition", "action":
+		fn, ok := r.leaves[d.Name]
+		if !ok {
+			return nil, fmt.Errorf("unknown %s %q", d.Type, d.Name)
+		}
+		return &Leaf{Name: d.Name, Params: d.Params, fn: fn}, nil
+	}
+
+	return nil, fmt.Errorf("unknown node type %q", d.Type)
+}
@@ -0,0 +1,215 @@
+package behaviour
+
+//Sequence ticks its children in order until one doesn't succeed, picking up from a running child next tick
+type Sequence struct {
+	Children []Node
+	current  int
+}
+
+func (s *Sequence) Tick(ctx *Context) Status {
+	for s.current < len(s.Children) {
+		switch status := s.Children[s.current].Tick(ctx); status {
+		case Running:
+			return Running
+		case Failure:
+			s.Reset()
+			return Failure
+		}
+		s.current++
+	}
+	s.Reset()
+	return Success
+}
+
+func (s *Sequence) Reset() {
+	for _, c := range s.Children {
+		c.Reset()
+	}
+	s.current = 0
+}
+
+//Selector ticks its children in order until one doesn't fail, earlier children taking over from a running later one
+type Selector struct {
+	Children []Node
+	running  int
+}
+
+func (s *Selector) Tick(ctx *Context) Status {
+	// higher priority children are checked again every tick, so a creature wandering about
+	// stops to flee as soon as it notices a threat
+	for i, c := range s.Children {
+		status := c.Tick(ctx)
+		if status == Failure {
+			continue
+		}
+		if s.running > i && s.running < len(s.Children) {
+			s.Children[s.running].Reset()
+		}
+		s.running = len(s.Children)
+		if status == Running {
+			s.running = i
+		}
+		return status
+	}
+	s.running = len(s.Children)
+	return Failure
+}
+
+func (s *Selector) Reset() {
+	for _, c := range s.Children {
+		c.Reset()
+	}
+	s.running = len(s.Children)
+}
+
+//Invert swaps its child's success for failure and failure for success
+type Invert struct {
+	Child Node
+}
+
+func (d *Invert) Tick(ctx *Context) Status {
+	switch d.Child.Tick(ctx) {
+	case Success:
+		return Failure
+	case Failure:
+		return Success
+	}
+	return Running
+}
+
+func (d *Invert) Reset() {
+	d.Child.Reset()
+}
+
+//Succeed succeeds whenever its child finishes, however it finished
+type Succeed struct {
+	Child Node
+}
+
+func (d *Succeed) Tick(ctx *Context) Status {
+	if d.Child.Tick(ctx) == Running {
+		return Running
+	}
+	return Success
+}
+
+func (d *Succeed) Reset() {
+	d.Child.Reset()
+}
+
+//Repeat runs its child again each time it succeeds, Times times or forever if Times is 0, failing if the child does
+type Repeat struct {
+	Child Node
+	Times int
+	count int
+}
+
+func (d *Repeat) Tick(ctx *Context) Status {
+	switch d.Child.Tick(ctx) {
+	case Running:
+		return Running
+	case Failure:
+		d.count = 0
+		return Failure
+	}
+	d.count++
+	if d.Times > 0 && d.count >= d.Times {
+		d.count = 0
+		return Success
+	}
+	d.Child.Reset()
+	return Running
+}
+
+func (d *Repeat) Reset() {
+	d.Child.Reset()
+	d.count = 0
+}
+
+//Cooldown fails for Ticks ticks after its child last finished, rather than ticking it
+type Cooldown struct {
+	Child Node
+	Ticks int
+	wait  int
+}
+
+func (d *Cooldown) Tick(ctx *Context) Status {
+	if d.wait > 0 {
+		d.wait--
+		return Failure
+	}
+	status := d.Child.Tick(ctx)
+	if status != Running {
+		d.wait = d.Ticks
+	}
+	return status
+}
+
+func (d *Cooldown) Reset() {
+	// the cooldown deliberately survives being abandoned, otherwise it could be dodged
+	d.Child.Reset()
+}
+
+//HasKey succeeds if the blackboard has a value under Key
+type HasKey struct {
+	Key string
+}
+
+func (n *HasKey) Tick(ctx *Context) Status {
+	if ctx.Blackboard.Has(n.Key) {
+		return Success
+	}
+	return Failure
+}
+
+func (n *HasKey) Reset() {}
+
+//SetKey stores Value on the blackboard under Key, or removes it if Value is nil
+type SetKey struct {
+	Key   string
+	Value interface{}
+}
+
+func (n *SetKey) Tick(ctx *Context) Status {
+	if n.Value == nil {
+		ctx.Blackboard.Delete(n.Key)
+	} else {
+		ctx.Blackboard.Set(n.Key, n.Value)
+	}
+	return Success
+}
+
+func (n *SetKey) Reset() {}
+
+//Leaf is a condition or action provided by the game, with the parameters it was given in the tree's definition
+type Leaf struct {
+	Name   string
+	Params Params
+	fn     LeafFunc
+	// state the leaf keeps between ticks while running, cleared when it finishes or is abandoned
+	state interface{}
+}
+
+//LeafFunc does the work of a leaf, state is kept from the previous tick while the leaf is running
+type LeafFunc func(ctx *Context, params Params, state *interface{}) Status
+
+//Abandoner is state a leaf keeps which must be tidied up if the leaf is abandoned while running, such as a request
+//it's waiting on
+type Abandoner interface {
+	Abandon()
+}
+
+func (n *Leaf) Tick(ctx *Context) Status {
+	status := n.fn(ctx, n.Params, &n.state)
+	if status != Running {
+		n.state = nil
+	}
+	return status
+}
+
+func (n *Leaf) Reset() {
+	if a, ok := n.state.(Abandoner); ok {
+		a.Abandon()
+	}
+	n.state = nil
+}
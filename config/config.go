@@ -0,0 +1,204 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/tauraamui/berrybun/netplay"
+)
+
+//Options are every option the game runs with. Each is named by its json tag in the config file, and by the same name
+// upper cased and prefixed with BERRYBUN_ in the environment. Those tagged json:"-" only make sense for a single run
+// and are only read from flags.
+type Options struct {
+	Debug         bool `json:"debug" flag:"dbg" usage:"Enable game's debug mode"`
+	Fullscreen    bool `json:"fullscreen" flag:"fs" usage:"Set game to be fullscreen"`
+	AllowKeyboard bool `json:"allow_keyboard" flag:"allowkbrd" usage:"Turns on accepting keyboard based controls"`
+
+	WindowWidth  int `json:"window_width" flag:"width" usage:"Width of the window in pixels"`
+	WindowHeight int `json:"window_height" flag:"height" usage:"Height of the window in pixels"`
+	CameraWidth  int `json:"camera_width" flag:"camwidth" usage:"Width of the world the camera shows in pixels, scaled to fit the window"`
+	CameraHeight int `json:"camera_height" flag:"camheight" usage:"Height of the world the camera shows in pixels, scaled to fit the window"`
+	MapWidth     int `json:"map_width" flag:"mapwidth" usage:"Width in tiles of maps generated from a seed"`
+	MapHeight    int `json:"map_height" flag:"mapheight" usage:"Height in tiles of maps generated from a seed"`
+
+	Mute           bool    `json:"mute" flag:"mute" usage:"Play without any sound"`
+	MasterVolume   float64 `json:"master_volume" flag:"volume" usage:"Volume of every sound, 0 to 1"`
+	MusicVolume    float64 `json:"music_volume" flag:"music" usage:"Volume of the music, 0 to 1"`
+	AmbienceVolume float64 `json:"ambience_volume" flag:"ambience" usage:"Volume of background sounds such as birdsong and rain, 0 to 1"`
+	EffectsVolume  float64 `json:"effects_volume" flag:"effects" usage:"Volume of sound effects, 0 to 1"`
+
+	AssetsDir     string `json:"assets_dir" flag:"assets" usage:"Directory of files overriding the built in assets, reloaded as they change in debug mode"`
+	ModsDir       string `json:"mods_dir" flag:"mods" usage:"Directory of mods to load, each a directory with a mod.json manifest"`
+	StartupScript string `json:"startup_script" flag:"startup" usage:"Developer console commands to run as the game starts in debug mode, one a line"`
+	SaveFile      string `json:"save_file" flag:"save" usage:"File the player's progress is saved to, save.json beside the config file if not set"`
+	Connect       string `json:"connect" flag:"connect" usage:"Join the multiplayer server at this address"`
+
+	Seed          uint64 `json:"-" flag:"seed" usage:"Seed to generate the map from, a random one is picked if not set"`
+	Edit          bool   `json:"-" flag:"edit" usage:"Open the map editor instead of playing"`
+	MapPath       string `json:"-" flag:"map" usage:"Map file to play or edit, generated from the seed if it doesn't exist yet"`
+	Server        bool   `json:"-" flag:"server" usage:"Run a headless multiplayer server instead of the game"`
+	ServerAddress string `json:"server_address" flag:"addr" usage:"Address for the multiplayer server to listen on"`
+}
+
+//Defaults returns the options the game runs with when nothing overrides them
+func Defaults() Options {
+	return Options{
+		WindowWidth:    800,
+		WindowHeight:   600,
+		CameraWidth:    800,
+		CameraHeight:   450,
+		MapWidth:       500,
+		MapHeight:      500,
+		MasterVolume:   1,
+		MusicVolume:    0.6,
+		AmbienceVolume: 0.5,
+		EffectsVolume:  0.8,
+		// the directory the built in assets are embedded from, so running from a checkout picks up edits to them
+		AssetsDir:     "res",
+		ModsDir:       "mods",
+		StartupScript: "startup.cfg",
+		ServerAddress: fmt.Sprintf(":%d", netplay.DefaultPort),
+	}
+}
+
+// smallest and largest map generated, the smallest still fitting the row of buildings placed along the top
+const (
+	minMapSize = 80
+	maxMapSize = 4096
+)
+
+//invalid is an option set to a value the game can't run with, and why
+type invalid struct {
+	key    string
+	reason string
+}
+
+//check returns every option set to a value the game can't run with
+func (o *Options) check() []invalid {
+	var problems []invalid
+	positive := func(key string, v int) {
+		if v <= 0 {
+			problems = append(problems, invalid{key, "must be more than 0"})
+		}
+	}
+	mapSize := func(key string, v int) {
+		if v < minMapSize || v > maxMapSize {
+			problems = append(problems, invalid{key, fmt.Sprintf("must be from %d to %d tiles", minMapSize, maxMapSize)})
+		}
+	}
+	volume := func(key string, v float64) {
+		if v < 0 || v > 1 {
+			problems = append(problems, invalid{key, "must be from 0 to 1"})
+		}
+	}
+
+	positive("window_width", o.WindowWidth)
+	positive("window_height", o.WindowHeight)
+	positive("camera_width", o.CameraWidth)
+	positive("camera_height", o.CameraHeight)
+	if o.CameraWidth > o.WindowWidth || o.CameraHeight > o.WindowHeight {
+		// the camera's view is scaled up to the window by a whole number, so can't be any bigger
+		problems = append(problems, invalid{"camera_width", fmt.Sprintf("the camera's %dx%d view doesn't fit the %dx%d window",
+			o.CameraWidth, o.CameraHeight, o.WindowWidth, o.WindowHeight)})
+	}
+	mapSize("map_width", o.MapWidth)
+	mapSize("map_height", o.MapHeight)
+	volume("master_volume", o.MasterVolume)
+	volume("music_volume", o.MusicVolume)
+	volume("ambience_volume", o.AmbienceVolume)
+	volume("effects_volume", o.EffectsVolume)
+	if o.ServerAddress == "" {
+		problems = append(problems, invalid{"server_address", "can't be empty"})
+	}
+	return problems
+}
+
+//option describes one of the fields of Options
+type option struct {
+	index int
+	key   string
+	flag  string
+	usage string
+	// whether the option is read from the config file and environment, and can be saved
+	setting bool
+}
+
+func (o option) env() string {
+	return "BERRYBUN_" + strings.ToUpper(o.key)
+}
+
+// every option, in the order they're declared
+var options = func() []option {
+	t := reflect.TypeOf(Options{})
+	opts := make([]option, t.NumField())
+	for i := range opts {
+		f := t.Field(i)
+		key := f.Tag.Get("json")
+		opts[i] = option{index: i, key: key, flag: f.Tag.Get("flag"), usage: f.Tag.Get("usage"), setting: key != "-"}
+		if !opts[i].setting {
+			opts[i].key = opts[i].flag
+		}
+	}
+	return opts
+}()
+
+func lookup(key string) (option, bool) {
+	for _, o := range options {
+		if o.key == key {
+			return o, true
+		}
+	}
+	return option{}, false
+}
+
+func (o *Options) field(opt option) reflect.Value {
+	return reflect.ValueOf(o).Elem().Field(opt.index)
+}
+
+//set parses a value into a field, which may be a bool, int, uint64, float64 or string
+func set(field reflect.Value, value string) error {
+	switch field.Kind() {
+	case reflect.Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("%q isn't true or false", value)
+		}
+		field.SetBool(b)
+	case reflect.Int:
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%q isn't a whole number", value)
+		}
+		field.SetInt(int64(n))
+	case reflect.Uint64:
+		n, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("%q isn't a whole number", value)
+		}
+		field.SetUint(n)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return fmt.Errorf("%q isn't a number", value)
+		}
+		field.SetFloat(f)
+	case reflect.String:
+		field.SetString(value)
+	default:
+		panic(fmt.Sprintf("config: options can't be a %s", field.Kind()))
+	}
+	return nil
+}
+
+//format formats a field the way set parses it
+func format(field reflect.Value) string {
+	switch field.Kind() {
+	case reflect.Float64:
+		return strconv.FormatFloat(field.Float(), 'g', -1, 64)
+	default:
+		return fmt.Sprint(field.Interface())
+	}
+}
@@ -0,0 +1,248 @@
+package config
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+)
+
+const (
+	//Env names the environment variable giving the config file's path, overriding the default
+	Env = "BERRYBUN_CONFIG"
+	// where in the user's config directory, $XDG_CONFIG_HOME or ~/.config on Linux, the config file is by default
+	defaultFile = "berrybun/config.json"
+)
+
+//Config is the options layered from their defaults, then the config file, then the environment, then flags, each
+//overriding those before it
+type Config struct {
+	Options
+	// the defaults overlaid with the config file and any settings changed since, what Save writes
+	saved Options
+	path  string
+	// what set each option, "default", the config file's path, an environment variable or a flag
+	sources map[string]string
+}
+
+//DefaultPath returns where the config file is looked for when neither the -config flag nor the environment say
+func DefaultPath() string {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, filepath.FromSlash(defaultFile))
+}
+
+//Load reads the options from every layer, args being the command line without the program name. A missing config
+//file is skipped, but one that can't be parsed, or any option with a value the game can't run with, is an error.
+func Load(args []string) (*Config, error) {
+	flagged := Defaults()
+	flags := flag.NewFlagSet("berrybun", flag.ContinueOnError)
+	path := flags.String("config", "", fmt.Sprintf("Config file to read options from and save settings to, defaults to $%s or %s", Env, DefaultPath()))
+	for _, opt := range options {
+		flags.Var(flagValue{flagged.field(opt)}, opt.flag, opt.usage)
+	}
+	if err := flags.Parse(args); err != nil {
+		return nil, err
+	}
+	if flags.NArg() > 0 {
+		return nil, fmt.Errorf("unexpected argument %s", flags.Arg(0))
+	}
+
+	c := &Config{Options: Defaults(), path: *path, sources: map[string]string{}}
+	if c.path == "" {
+		c.path = os.Getenv(Env)
+	}
+	if c.path == "" {
+		c.path = DefaultPath()
+	}
+	for _, opt := range options {
+		c.sources[opt.key] = "default"
+	}
+
+	if err := c.readFile(); err != nil {
+		return nil, err
+	}
+	c.saved = c.Options
+
+	for _, opt := range options {
+		if !opt.setting {
+			continue
+		}
+		value, ok := os.LookupEnv(opt.env())
+		if !ok {
+			continue
+		}
+		if err := set(c.field(opt), value); err != nil {
+			return nil, fmt.Errorf("unable to read %s from %s: %v", opt.key, opt.env(), err)
+		}
+		c.sources[opt.key] = opt.env()
+	}
+
+	flags.Visit(func(f *flag.Flag) {
+		for _, opt := range options {
+			if opt.flag == f.Name {
+				c.field(opt).Set(flagged.field(opt))
+				c.sources[opt.key] = "-" + f.Name
+			}
+		}
+	})
+
+	if problems := c.check(); len(problems) > 0 {
+		reasons := make([]string, len(problems))
+		for i, p := range problems {
+			reasons[i] = fmt.Sprintf("%s set by %s %s", p.key, c.sources[p.key], p.reason)
+		}
+		return nil, fmt.Errorf("invalid options: %s", strings.Join(reasons, ", "))
+	}
+	return c, nil
+}
+
+//readFile overlays the options with those in the config file, if there is one
+func (c *Config) readFile() error {
+	if c.path == "" {
+		return nil
+	}
+	data, err := ioutil.ReadFile(c.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to read config file: %v", err)
+	}
+
+	var values map[string]json.RawMessage
+	if err := json.Unmarshal(data, &values); err != nil {
+		return fmt.Errorf("unable to parse config file %s: %v", c.path, err)
+	}
+	for key, value := range values {
+		opt, ok := lookup(key)
+		if !ok || !opt.setting {
+			return fmt.Errorf("unable to parse config file %s: no option called %s", c.path, key)
+		}
+		if err := json.Unmarshal(value, c.field(opt).Addr().Interface()); err != nil {
+			return fmt.Errorf("unable to parse config file %s: %s: %v", c.path, key, err)
+		}
+		c.sources[key] = c.path
+	}
+	return nil
+}
+
+//Path returns where the config file is read from and saved to, empty if there's nowhere for it
+func (c *Config) Path() string {
+	return c.path
+}
+
+//Keys returns the name of every option which can be saved, in the order they're declared
+func (c *Config) Keys() []string {
+	var keys []string
+	for _, opt := range options {
+		if opt.setting {
+			keys = append(keys, opt.key)
+		}
+	}
+	return keys
+}
+
+//Get returns the value of an option, formatted as Set parses it
+func (c *Config) Get(key string) (string, error) {
+	opt, ok := lookup(key)
+	if !ok {
+		return "", fmt.Errorf("no option called %s", key)
+	}
+	return format(c.field(opt)), nil
+}
+
+//Source returns what set an option, "default", the config file's path, an environment variable, a flag or "settings"
+func (c *Config) Source(key string) string {
+	return c.sources[key]
+}
+
+//Set changes an option, as a settings menu does, so that Save saves it. The option is left as it was if the value
+//isn't valid.
+func (c *Config) Set(key, value string) error {
+	opt, ok := lookup(key)
+	if !ok || !opt.setting {
+		return fmt.Errorf("no setting called %s", key)
+	}
+
+	changed := c.Options
+	if err := set(changed.field(opt), value); err != nil {
+		return fmt.Errorf("unable to set %s: %v", key, err)
+	}
+	if problems := changed.check(); len(problems) > 0 {
+		return fmt.Errorf("unable to set %s: %s %s", key, problems[0].key, problems[0].reason)
+	}
+
+	c.Options = changed
+	c.saved.field(opt).Set(changed.field(opt))
+	c.sources[key] = "settings"
+	return nil
+}
+
+//Save writes the settings changed from their defaults to the config file, leaving out anything set by the environment
+//or flags just for this run
+func (c *Config) Save() error {
+	if c.path == "" {
+		return fmt.Errorf("unable to save settings: there's no config directory")
+	}
+
+	defaults := Defaults()
+	values := map[string]interface{}{}
+	for _, opt := range options {
+		if !opt.setting {
+			continue
+		}
+		value := c.saved.field(opt)
+		if value.Interface() != defaults.field(opt).Interface() {
+			values[opt.key] = value.Interface()
+		}
+	}
+
+	data, err := json.MarshalIndent(values, "", "\t")
+	if err != nil {
+		return fmt.Errorf("unable to save settings: %v", err)
+	}
+	if err := os.MkdirAll(filepath.Dir(c.path), 0755); err != nil {
+		return fmt.Errorf("unable to save settings: %v", err)
+	}
+
+	// written beside the config file then moved over it, so a crash can't leave it half written
+	tmp := c.path + ".tmp"
+	if err := ioutil.WriteFile(tmp, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("unable to save settings: %v", err)
+	}
+	if err := os.Rename(tmp, c.path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("unable to save settings: %v", err)
+	}
+	return nil
+}
+
+//flagValue lets a flag set a field of Options
+type flagValue struct {
+	field reflect.Value
+}
+
+func (v flagValue) String() string {
+	// the flag package formats a zero flagValue to tell whether a default is worth printing, which a switch that's off
+	// isn't
+	if !v.field.IsValid() || v.field.Kind() == reflect.Bool && !v.field.Bool() {
+		return ""
+	}
+	return format(v.field)
+}
+
+func (v flagValue) Set(value string) error {
+	return set(v.field, value)
+}
+
+//IsBoolFlag lets boolean flags be given without a value, such as -dbg
+func (v flagValue) IsBoolFlag() bool {
+	return v.field.IsValid() && v.field.Kind() == reflect.Bool
+}
@@ -0,0 +1,357 @@
+package console
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+const (
+	// most lines of output kept, and most lines of input remembered
+	maxLines   = 500
+	maxHistory = 100
+)
+
+//Command is something the console can run by name
+type Command struct {
+	Name string
+	// the arguments the command takes, such as "x y", shown in help
+	Usage string
+	Help  string
+	// suggests values for the last argument given those typed so far, nil if it has nothing to suggest
+	Complete func(args []string) []string
+	Run      func(c *Console, args []string) error
+}
+
+//LineKind is what a line of output is, for colouring it
+type LineKind int
+
+const (
+	//Input is a command that was run
+	Input LineKind = iota
+	//Output is anything printed
+	Output
+	//Error is a command failing
+	Error
+)
+
+//Line is a line of the console's output
+type Line struct {
+	Kind LineKind
+	Text string
+}
+
+//Field is a named value reported by a subsystem
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+//F names a value for a report
+func F(key string, value interface{}) Field {
+	return Field{Key: key, Value: value}
+}
+
+//Console runs commands typed into it or read from scripts, keeping their output and a history of what was typed
+type Console struct {
+	commands  map[string]*Command
+	reporters map[string]func() []Field
+	lines     []Line
+	history   []string
+	// which line of history is being browsed, len(history) when typing a new one
+	browse int
+}
+
+//New creates a console with the built in commands, help, inspect, clear and history
+func New() *Console {
+	c := &Console{
+		commands:  map[string]*Command{},
+		reporters: map[string]func() []Field{},
+	}
+	c.Register(Command{
+		Name:     "help",
+		Usage:    "[command]",
+		Help:     "lists every command, or describes one",
+		Complete: func(args []string) []string { return c.Names() },
+		Run:      c.help,
+	})
+	c.Register(Command{
+		Name:     "inspect",
+		Usage:    "[subsystem]",
+		Help:     "reports the state of a subsystem, or of every one",
+		Complete: func(args []string) []string { return c.subsystems() },
+		Run:      c.inspect,
+	})
+	c.Register(Command{
+		Name: "clear",
+		Help: "clears the console's output",
+		Run: func(c *Console, args []string) error {
+			c.lines = nil
+			return nil
+		},
+	})
+	c.Register(Command{
+		Name: "history",
+		Help: "lists the commands typed so far",
+		Run: func(c *Console, args []string) error {
+			for i, line := range c.history {
+				c.Printf("%3d %s", i+1, line)
+			}
+			return nil
+		},
+	})
+	return c
+}
+
+//Register adds a command, replacing any already registered with its name
+func (c *Console) Register(cmd Command) {
+	c.commands[cmd.Name] = &cmd
+}
+
+//AddReporter lets the inspect command report on a subsystem by name
+func (c *Console) AddReporter(subsystem string, report func() []Field) {
+	c.reporters[subsystem] = report
+}
+
+//Names returns the name of every command, sorted
+func (c *Console) Names() []string {
+	names := make([]string, 0, len(c.commands))
+	for name := range c.commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (c *Console) subsystems() []string {
+	names := make([]string, 0, len(c.reporters))
+	for name := range c.reporters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+//Exec runs a line typed into the console, remembering it in the history and printing any error
+func (c *Console) Exec(line string) error {
+	line = strings.TrimSpace(line)
+	c.browse = len(c.history)
+	if line == "" {
+		return nil
+	}
+
+	if len(c.history) == 0 || c.history[len(c.history)-1] != line {
+		c.history = append(c.history, line)
+		if len(c.history) > maxHistory {
+			c.history = c.history[len(c.history)-maxHistory:]
+		}
+	}
+	c.browse = len(c.history)
+
+	c.print(Input, "> "+line)
+	err := c.Run(line)
+	if err != nil {
+		c.print(Error, err.Error())
+	}
+	return err
+}
+
+//Run runs a line without remembering it, such as one read from a script
+func (c *Console) Run(line string) error {
+	args, err := Split(line)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return nil
+	}
+
+	cmd, ok := c.commands[args[0]]
+	if !ok {
+		return fmt.Errorf("no command called %s, try help", args[0])
+	}
+	return cmd.Run(c, args[1:])
+}
+
+//RunScript runs a script a command a line, skipping blank lines and those starting with #, stopping at the first
+//command to fail
+func (c *Console) RunScript(r io.Reader, name string) error {
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if err := c.Run(line); err != nil {
+			return fmt.Errorf("%s:%d: %v", name, n, err)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("unable to read %s: %v", name, err)
+	}
+	return nil
+}
+
+//Printf prints a line of output
+func (c *Console) Printf(format string, args ...interface{}) {
+	c.print(Output, fmt.Sprintf(format, args...))
+}
+
+//Report prints a subsystem's fields as one line, such as "weather: kind=rain season=autumn"
+func (c *Console) Report(subsystem string, fields ...Field) {
+	var b strings.Builder
+	b.WriteString(subsystem + ":")
+	for _, f := range fields {
+		value := fmt.Sprint(f.Value)
+		if strings.ContainsAny(value, " \t") || value == "" {
+			value = fmt.Sprintf("%q", value)
+		}
+		fmt.Fprintf(&b, " %s=%s", f.Key, value)
+	}
+	c.print(Output, b.String())
+}
+
+func (c *Console) print(kind LineKind, text string) {
+	for _, line := range strings.Split(text, "\n") {
+		c.lines = append(c.lines, Line{Kind: kind, Text: line})
+	}
+	if len(c.lines) > maxLines {
+		c.lines = c.lines[len(c.lines)-maxLines:]
+	}
+}
+
+//Lines returns the console's output, oldest first
+func (c *Console) Lines() []Line {
+	return c.lines
+}
+
+//Previous returns the line of history before the one being browsed, the oldest once there are no more
+func (c *Console) Previous() string {
+	if len(c.history) == 0 {
+		return ""
+	}
+	if c.browse > 0 {
+		c.browse--
+	}
+	return c.history[c.browse]
+}
+
+//Next returns the line of history after the one being browsed, empty once past the newest
+func (c *Console) Next() string {
+	if c.browse < len(c.history) {
+		c.browse++
+	}
+	if c.browse == len(c.history) {
+		return ""
+	}
+	return c.history[c.browse]
+}
+
+//Complete completes the last word of a line as far as every suggestion for it agrees, returning the completed line
+//and the suggestions
+func (c *Console) Complete(line string) (string, []string) {
+	args, err := Split(line)
+	if err != nil {
+		return line, nil
+	}
+	// a trailing space starts a new, empty word
+	if len(args) == 0 || strings.HasSuffix(line, " ") {
+		args = append(args, "")
+	}
+
+	var options []string
+	if len(args) == 1 {
+		options = c.Names()
+	} else if cmd, ok := c.commands[args[0]]; ok && cmd.Complete != nil {
+		options = cmd.Complete(args[1 : len(args)-1])
+	}
+
+	word := args[len(args)-1]
+	var matches []string
+	for _, o := range options {
+		if strings.HasPrefix(o, word) {
+			matches = append(matches, o)
+		}
+	}
+	if len(matches) == 0 {
+		return line, nil
+	}
+
+	prefix := matches[0]
+	for _, m := range matches[1:] {
+		for !strings.HasPrefix(m, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+
+	completed := strings.Join(append(args[:len(args)-1], prefix), " ")
+	if len(matches) == 1 {
+		completed += " "
+	}
+	return completed, matches
+}
+
+func (c *Console) help(_ *Console, args []string) error {
+	if len(args) > 0 {
+		cmd, ok := c.commands[args[0]]
+		if !ok {
+			return fmt.Errorf("no command called %s", args[0])
+		}
+		c.Printf("%s %s", cmd.Name, cmd.Usage)
+		c.Printf("  %s", cmd.Help)
+		return nil
+	}
+
+	for _, name := range c.Names() {
+		cmd := c.commands[name]
+		c.Printf("%-10s %s", name, cmd.Help)
+	}
+	return nil
+}
+
+func (c *Console) inspect(_ *Console, args []string) error {
+	names := args
+	if len(names) == 0 {
+		names = c.subsystems()
+	}
+	for _, name := range names {
+		report, ok := c.reporters[name]
+		if !ok {
+			return fmt.Errorf("no subsystem called %s, try one of %s", name, strings.Join(c.subsystems(), ", "))
+		}
+		c.Report(name, report()...)
+	}
+	return nil
+}
+
+//Split splits a line into words at spaces, keeping spaces within double quotes
+func Split(line string) ([]string, error) {
+	var words []string
+	var word strings.Builder
+	inWord, quoted := false, false
+	for _, r := range line {
+		switch {
+		case r == '"':
+			quoted = !quoted
+			inWord = true
+		case !quoted && (r == ' ' || r == '\t'):
+			if inWord {
+				words = append(words, word.String())
+				word.Reset()
+				inWord = false
+			}
+		default:
+			word.WriteRune(r)
+			inWord = true
+		}
+	}
+	if quoted {
+		return nil, fmt.Errorf("unclosed quote")
+	}
+	if inWord {
+		words = append(words, word.String())
+	}
+	return words, nil
+}
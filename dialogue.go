@@ -0,0 +1,105 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/tacusci/logging"
+	"github.com/tauraamui/berrybun/dialogue"
+	"github.com/tauraamui/berrybun/game"
+	"github.com/tauraamui/berrybun/script"
+)
+
+//runDialogue checks dialogue scripts for mistakes, such as jumps to nodes which don't exist or commands the game
+//doesn't have, and that the Lua scripts trigger zones run compile, for `berrybun dialogue [scripts or dirs]`
+func runDialogue(args []string) error {
+	flags := flag.NewFlagSet("dialogue", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "Usage: berrybun dialogue [scripts or directories of them, res/dialogue and res/scripts if none]\n")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err == flag.ErrHelp {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	paths := flags.Args()
+	if len(paths) == 0 {
+		paths = []string{filepath.Join("res", "dialogue"), filepath.Join("res", "scripts")}
+	}
+	var files []string
+	for _, path := range paths {
+		found, err := scripts(path)
+		if err != nil {
+			return err
+		}
+		files = append(files, found...)
+	}
+
+	problems := 0
+	for _, file := range files {
+		for _, err := range checkScript(file) {
+			fmt.Println(err)
+			problems++
+		}
+	}
+	if problems > 0 {
+		return fmt.Errorf("found %d problems in %d scripts", problems, len(files))
+	}
+	logging.Info(fmt.Sprintf("checked %d scripts, found no problems", len(files)))
+	return nil
+}
+
+//scripts returns the path if it's a file, or every .yarn and .lua script within it if it's a directory
+func scripts(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{path}, nil
+	}
+
+	var files []string
+	err = filepath.Walk(path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() && (strings.HasSuffix(p, ".yarn") || strings.HasSuffix(p, ".lua")) {
+			files = append(files, p)
+		}
+		return nil
+	})
+	return files, err
+}
+
+//checkScript returns everything wrong with a script, which is just why it won't parse if it won't. Lua scripts
+//are only compiled, what they call is only checked as they run.
+func checkScript(file string) []error {
+	f, err := os.Open(file)
+	if err != nil {
+		return []error{err}
+	}
+	defer f.Close()
+
+	if filepath.Ext(file) == ".lua" {
+		if _, err := script.Compile(f, file); err != nil {
+			return []error{err}
+		}
+		return nil
+	}
+
+	s, err := dialogue.Parse(f, file)
+	if err != nil {
+		return []error{err}
+	}
+	problems := s.Validate(game.DialogueCommands(), game.DialogueFunctions())
+	if _, ok := s.Nodes["start"]; !ok {
+		problems = append(problems, fmt.Errorf("%s: no start node for conversations to begin at", file))
+	}
+	return problems
+}
@@ -0,0 +1,451 @@
+package dialogue
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+//Value is the value of a variable or expression, a float64, string or bool. Variables which have never been set are
+//nil, read as false, 0 or an empty string depending on what they're used with.
+type Value interface{}
+
+//Variables are the variables scripts read and set, by name without the $
+type Variables map[string]Value
+
+//Function is a function scripts can call in expressions, such as carrying("berry")
+type Function func(args []Value) (Value, error)
+
+//expr is a parsed expression
+type expr interface {
+	eval(env *env) (Value, error)
+}
+
+//env is what expressions are evaluated against
+type env struct {
+	vars      Variables
+	functions map[string]Function
+}
+
+type literal struct{ value Value }
+
+type variable struct{ name string }
+
+type call struct {
+	name string
+	args []expr
+}
+
+type unary struct {
+	op      string
+	operand expr
+}
+
+type binary struct {
+	op          string
+	left, right expr
+}
+
+func (l literal) eval(*env) (Value, error) {
+	return l.value, nil
+}
+
+func (v variable) eval(e *env) (Value, error) {
+	return e.vars[v.name], nil
+}
+
+func (c call) eval(e *env) (Value, error) {
+	fn, ok := e.functions[c.name]
+	if !ok {
+		return nil, fmt.Errorf("no function called %s", c.name)
+	}
+	args := make([]Value, len(c.args))
+	for i, a := range c.args {
+		v, err := a.eval(e)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+	return fn(args)
+}
+
+func (u unary) eval(e *env) (Value, error) {
+	v, err := u.operand.eval(e)
+	if err != nil {
+		return nil, err
+	}
+	if u.op == "-" {
+		n, err := Number(v)
+		return -n, err
+	}
+	return !Truthy(v), nil
+}
+
+func (b binary) eval(e *env) (Value, error) {
+	left, err := b.left.eval(e)
+	if err != nil {
+		return nil, err
+	}
+	// and and or don't evaluate their right hand side unless they need to
+	switch b.op {
+	case "and":
+		if !Truthy(left) {
+			return false, nil
+		}
+	case "or":
+		if Truthy(left) {
+			return true, nil
+		}
+	}
+	right, err := b.right.eval(e)
+	if err != nil {
+		return nil, err
+	}
+
+	switch b.op {
+	case "and", "or":
+		return Truthy(right), nil
+	case "==":
+		return equal(left, right), nil
+	case "!=":
+		return !equal(left, right), nil
+	case "+":
+		// adding to a string joins them
+		if ls, ok := left.(string); ok {
+			return ls + String(right), nil
+		}
+		if rs, ok := right.(string); ok {
+			return String(left) + rs, nil
+		}
+	}
+
+	l, err := Number(left)
+	if err != nil {
+		return nil, err
+	}
+	r, err := Number(right)
+	if err != nil {
+		return nil, err
+	}
+	switch b.op {
+	case "<":
+		return l < r, nil
+	case "<=":
+		return l <= r, nil
+	case ">":
+		return l > r, nil
+	case ">=":
+		return l >= r, nil
+	case "+":
+		return l + r, nil
+	case "-":
+		return l - r, nil
+	case "*":
+		return l * r, nil
+	case "/":
+		if r == 0 {
+			return nil, fmt.Errorf("division by zero")
+		}
+		return l / r, nil
+	}
+	return nil, fmt.Errorf("unknown operator %s", b.op)
+}
+
+//Truthy returns whether a value counts as true, anything but false, 0, an empty string or unset
+func Truthy(v Value) bool {
+	switch v := v.(type) {
+	case bool:
+		return v
+	case float64:
+		return v != 0
+	case string:
+		return v != ""
+	}
+	return false
+}
+
+//Number returns a value as a number, unset being 0
+func Number(v Value) (float64, error) {
+	switch v := v.(type) {
+	case nil:
+		return 0, nil
+	case float64:
+		return v, nil
+	case bool:
+		if v {
+			return 1, nil
+		}
+		return 0, nil
+	}
+	return 0, fmt.Errorf("%q isn't a number", v)
+}
+
+//String returns a value as it's shown in a line, whole numbers without a decimal point
+func String(v Value) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return fmt.Sprint(v)
+}
+
+//equal compares values, an unset value equal to the zero value of whatever it's compared with
+func equal(a, b Value) bool {
+	if a == nil {
+		a, b = b, a
+	}
+	if b == nil {
+		return !Truthy(a)
+	}
+	if an, ok := a.(float64); ok {
+		bn, err := Number(b)
+		return err == nil && an == bn
+	}
+	return a == b
+}
+
+//tokenizeExpr splits an expression into numbers, strings, $variables, names and operators
+func tokenizeExpr(src string) ([]string, error) {
+	var tokens []string
+	for i := 0; i < len(src); {
+		c := rune(src[i])
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '"':
+			end := strings.IndexByte(src[i+1:], '"')
+			if end < 0 {
+				return nil, fmt.Errorf("unclosed string")
+			}
+			tokens = append(tokens, src[i:i+end+2])
+			i += end + 2
+		case unicode.IsDigit(c) || c == '.':
+			j := i
+			for j < len(src) && (unicode.IsDigit(rune(src[j])) || src[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, src[i:j])
+			i = j
+		case c == '$' || c == '_' || unicode.IsLetter(c):
+			j := i + 1
+			for j < len(src) && (src[j] == '_' || unicode.IsLetter(rune(src[j])) || unicode.IsDigit(rune(src[j]))) {
+				j++
+			}
+			tokens = append(tokens, src[i:j])
+			i = j
+		default:
+			op := ""
+			for _, o := range []string{"==", "!=", "<=", ">=", "&&", "||", "<", ">", "+", "-", "*", "/", "(", ")", ",", "!", "="} {
+				if strings.HasPrefix(src[i:], o) {
+					op = o
+					break
+				}
+			}
+			if op == "" {
+				return nil, fmt.Errorf("unexpected %q", c)
+			}
+			tokens = append(tokens, op)
+			i += len(op)
+		}
+	}
+	return tokens, nil
+}
+
+//exprParser parses expressions by recursive descent, loosest binding first
+type exprParser struct {
+	tokens []string
+	pos    int
+}
+
+//parseExpr parses a whole expression
+func parseExpr(src string) (expr, error) {
+	tokens, err := tokenizeExpr(src)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("expected an expression")
+	}
+	p := &exprParser{tokens: tokens}
+	e, err := p.or()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("unexpected %s", p.tokens[p.pos])
+	}
+	return e, nil
+}
+
+func (p *exprParser) peek() string {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return ""
+}
+
+//accept moves past the next token if it's one of ops, returning which
+func (p *exprParser) accept(ops ...string) (string, bool) {
+	next := p.peek()
+	for _, op := range ops {
+		if next == op {
+			p.pos++
+			return op, true
+		}
+	}
+	return "", false
+}
+
+//binaryLevel parses operands joined by any of ops, left to right
+func (p *exprParser) binaryLevel(operand func() (expr, error), ops map[string]string) (expr, error) {
+	left, err := operand()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		op, ok := ops[p.peek()]
+		if !ok {
+			return left, nil
+		}
+		p.pos++
+		right, err := operand()
+		if err != nil {
+			return nil, err
+		}
+		left = binary{op: op, left: left, right: right}
+	}
+}
+
+func (p *exprParser) or() (expr, error) {
+	return p.binaryLevel(p.and, map[string]string{"or": "or", "||": "or"})
+}
+
+func (p *exprParser) and() (expr, error) {
+	return p.binaryLevel(p.not, map[string]string{"and": "and", "&&": "and"})
+}
+
+func (p *exprParser) not() (expr, error) {
+	if _, ok := p.accept("not", "!"); ok {
+		operand, err := p.not()
+		if err != nil {
+			return nil, err
+		}
+		return unary{op: "not", operand: operand}, nil
+	}
+	return p.comparison()
+}
+
+func (p *exprParser) comparison() (expr, error) {
+	left, err := p.sum()
+	if err != nil {
+		return nil, err
+	}
+	// a single = compares too, as people write it in conditions
+	ops := map[string]string{"==": "==", "=": "==", "is": "==", "!=": "!=", "<": "<", "<=": "<=", ">": ">", ">=": ">="}
+	op, ok := ops[p.peek()]
+	if !ok {
+		return left, nil
+	}
+	p.pos++
+	right, err := p.sum()
+	if err != nil {
+		return nil, err
+	}
+	return binary{op: op, left: left, right: right}, nil
+}
+
+func (p *exprParser) sum() (expr, error) {
+	return p.binaryLevel(p.product, map[string]string{"+": "+", "-": "-"})
+}
+
+func (p *exprParser) product() (expr, error) {
+	return p.binaryLevel(p.negation, map[string]string{"*": "*", "/": "/"})
+}
+
+func (p *exprParser) negation() (expr, error) {
+	if _, ok := p.accept("-"); ok {
+		operand, err := p.negation()
+		if err != nil {
+			return nil, err
+		}
+		return unary{op: "-", operand: operand}, nil
+	}
+	return p.primary()
+}
+
+func (p *exprParser) primary() (expr, error) {
+	tok := p.peek()
+	if tok == "" {
+		return nil, fmt.Errorf("expression ends too soon")
+	}
+	p.pos++
+
+	switch {
+	case tok == "(":
+		e, err := p.or()
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := p.accept(")"); !ok {
+			return nil, fmt.Errorf("expected )")
+		}
+		return e, nil
+	case tok == "true" || tok == "false":
+		return literal{tok == "true"}, nil
+	case tok[0] == '"':
+		return literal{tok[1 : len(tok)-1]}, nil
+	case tok[0] == '$':
+		if len(tok) == 1 {
+			return nil, fmt.Errorf("expected a variable name after $")
+		}
+		return variable{tok[1:]}, nil
+	case unicode.IsDigit(rune(tok[0])) || tok[0] == '.':
+		n, err := strconv.ParseFloat(tok, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s isn't a number", tok)
+		}
+		return literal{n}, nil
+	case tok[0] == '_' || unicode.IsLetter(rune(tok[0])):
+		if _, ok := p.accept("("); !ok {
+			return nil, fmt.Errorf("unexpected %s, variables start with $", tok)
+		}
+		c := call{name: tok}
+		if _, ok := p.accept(")"); ok {
+			return c, nil
+		}
+		for {
+			arg, err := p.or()
+			if err != nil {
+				return nil, err
+			}
+			c.args = append(c.args, arg)
+			if _, ok := p.accept(")"); ok {
+				return c, nil
+			}
+			if _, ok := p.accept(","); !ok {
+				return nil, fmt.Errorf("expected , or ) in call to %s", tok)
+			}
+		}
+	}
+	return nil, fmt.Errorf("unexpected %s", tok)
+}
+
+//functions returns the names of every function an expression calls
+func functions(e expr, names []string) []string {
+	switch e := e.(type) {
+	case call:
+		names = append(names, e.name)
+		for _, a := range e.args {
+			names = functions(a, names)
+		}
+	case unary:
+		names = functions(e.operand, names)
+	case binary:
+		names = functions(e.right, functions(e.left, names))
+	}
+	return names
+}
@@ -0,0 +1,222 @@
+package dialogue
+
+import (
+	"fmt"
+	"strings"
+)
+
+//Command is a command the game provides to scripts, such as <<give berry 2>>, given the words after its name
+type Command func(args []string) error
+
+//Event is what a conversation does next, a Line, Options or End
+type Event interface{}
+
+//Line is a line of dialogue to show
+type Line struct {
+	Speaker string
+	Text    string
+}
+
+//Options are the options the player must pick between, with Choose, before the conversation carries on
+type Options []string
+
+//End is the end of a conversation
+type End struct{}
+
+//frame is a list of statements being run, and how far through them the runner is
+type frame struct {
+	stmts []stmt
+	pos   int
+}
+
+//Runner runs conversations from a script, keeping its variables between them
+type Runner struct {
+	Script    *Script
+	Variables Variables
+	Commands  map[string]Command
+	Functions map[string]Function
+	// most statements Next runs looking for something to show before giving up, so a script jumping around in a loop
+	// can't hang the game, none if 0
+	MaxSteps int
+
+	node    string
+	stack   []frame
+	choices []option
+}
+
+//NewRunner creates a runner for a script, its variables shared with whatever else uses vars
+func NewRunner(s *Script, vars Variables) *Runner {
+	return &Runner{Script: s, Variables: vars, Commands: map[string]Command{}, Functions: map[string]Function{}}
+}
+
+//Start starts a conversation at a node
+func (r *Runner) Start(node string) error {
+	n, ok := r.Script.Nodes[node]
+	if !ok {
+		return fmt.Errorf("%s has no node called %s", r.Script.Name, node)
+	}
+	r.node = n.Title
+	r.stack = []frame{{stmts: n.body}}
+	r.choices = nil
+	return nil
+}
+
+//Node returns the title of the node the conversation is in
+func (r *Runner) Node() string {
+	return r.node
+}
+
+//Running returns whether a conversation has started and not yet ended
+func (r *Runner) Running() bool {
+	return len(r.stack) > 0
+}
+
+//Next runs the conversation until it has a line to show, options to pick between or has ended
+func (r *Runner) Next() (Event, error) {
+	if r.choices != nil {
+		return nil, fmt.Errorf("waiting for an option to be chosen")
+	}
+	env := &env{vars: r.Variables, functions: r.Functions}
+
+	for steps := 0; len(r.stack) > 0; steps++ {
+		top := &r.stack[len(r.stack)-1]
+		if top.pos >= len(top.stmts) {
+			r.stack = r.stack[:len(r.stack)-1]
+			continue
+		}
+		s := top.stmts[top.pos]
+		top.pos++
+		if r.MaxSteps > 0 && steps >= r.MaxSteps {
+			line := 0
+			if n := r.Script.Nodes[r.node]; n != nil {
+				line = n.line
+			}
+			return nil, r.problem(line, fmt.Errorf("ran %d statements without anything to show, is it stuck in a loop?", r.MaxSteps))
+		}
+
+		switch s := s.(type) {
+		case lineStmt:
+			show, err := r.holds(env, s.cond, s.line)
+			if err != nil {
+				return nil, err
+			}
+			if !show {
+				continue
+			}
+			text, err := r.fill(env, s.text, s.line)
+			if err != nil {
+				return nil, err
+			}
+			return Line{Speaker: s.speaker, Text: text}, nil
+		case setStmt:
+			v, err := s.value.eval(env)
+			if err != nil {
+				return nil, r.problem(s.line, err)
+			}
+			r.Variables[s.name] = v
+		case ifStmt:
+			for _, b := range s.branches {
+				taken, err := r.holds(env, b.cond, s.line)
+				if err != nil {
+					return nil, err
+				}
+				if taken {
+					r.stack = append(r.stack, frame{stmts: b.body})
+					break
+				}
+			}
+		case jumpStmt:
+			if err := r.Start(s.node); err != nil {
+				return nil, r.problem(s.line, err)
+			}
+		case stopStmt:
+			r.stack = nil
+		case commandStmt:
+			cmd, ok := r.Commands[s.name]
+			if !ok {
+				return nil, r.problem(s.line, fmt.Errorf("no command called %s", s.name))
+			}
+			args, err := r.fill(env, s.args, s.line)
+			if err != nil {
+				return nil, err
+			}
+			if err := cmd(strings.Fields(args)); err != nil {
+				return nil, r.problem(s.line, err)
+			}
+		case optionsStmt:
+			var shown Options
+			for _, o := range s.options {
+				available, err := r.holds(env, o.cond, o.line)
+				if err != nil {
+					return nil, err
+				}
+				if !available {
+					continue
+				}
+				text, err := r.fill(env, o.text, o.line)
+				if err != nil {
+					return nil, err
+				}
+				shown = append(shown, text)
+				r.choices = append(r.choices, o)
+			}
+			if len(shown) > 0 {
+				return shown, nil
+			}
+		}
+	}
+
+	r.node = ""
+	return End{}, nil
+}
+
+//Choose picks one of the options Next returned, by its index
+func (r *Runner) Choose(i int) error {
+	if i < 0 || i >= len(r.choices) {
+		return fmt.Errorf("there's no option %d to choose", i)
+	}
+	r.stack = append(r.stack, frame{stmts: r.choices[i].body})
+	r.choices = nil
+	return nil
+}
+
+//Stop ends the conversation early
+func (r *Runner) Stop() {
+	r.node = ""
+	r.stack = nil
+	r.choices = nil
+}
+
+//holds returns whether a line, option or branch's condition holds, those without one always holding
+func (r *Runner) holds(env *env, cond expr, line int) (bool, error) {
+	if cond == nil {
+		return true, nil
+	}
+	v, err := cond.eval(env)
+	if err != nil {
+		return false, r.problem(line, err)
+	}
+	return Truthy(v), nil
+}
+
+//fill returns a template's text with its expressions' values filled in
+func (r *Runner) fill(env *env, t template, line int) (string, error) {
+	var text strings.Builder
+	for _, part := range t {
+		switch part := part.(type) {
+		case string:
+			text.WriteString(part)
+		case expr:
+			v, err := part.eval(env)
+			if err != nil {
+				return "", r.problem(line, err)
+			}
+			text.WriteString(String(v))
+		}
+	}
+	return text.String(), nil
+}
+
+func (r *Runner) problem(line int, err error) error {
+	return Problem{r.Script.Name, line, err.Error()}
+}
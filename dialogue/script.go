@@ -0,0 +1,459 @@
+//Package dialogue runs branching conversations written in a Yarn-like script format.
+//
+//A script is a list of nodes, each a header of `key: value` lines including its title, then `---`, then its body, then
+//`===`:
+//
+//	title: start
+//	---
+//	Fox: Hello {$name}, have you any berries?
+//	-> Here, have one <<if carrying("berry")>>
+//	    <<take berry>>
+//	    <<set $fed = $fed + 1>>
+//	    Fox: Thank you!
+//	-> Not today
+//	    <<jump sulk>>
+//	===
+//
+//A body line is said by whoever is named before its first colon. Lines starting -> are options the player picks
+//between, the lines indented beneath one running when it's picked, and a trailing <<if expr>> hiding it unless expr
+//holds. <<set $var = expr>>, <<if expr>>, <<elseif expr>>, <<else>>, <<endif>>, <<jump node>> and <<stop>> are
+//built in, any other <<name args>> calling a command the game provides. {expr} within a line or command is replaced
+//with its value, and lines starting // are comments.
+package dialogue
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+//Problem is something wrong with a script, at a line of its file
+type Problem struct {
+	File string
+	Line int
+	Msg  string
+}
+
+func (p Problem) Error() string {
+	return fmt.Sprintf("%s:%d: %s", p.File, p.Line, p.Msg)
+}
+
+//Script is a parsed script's nodes, by title
+type Script struct {
+	Name  string
+	Nodes map[string]*Node
+	// titles in the order the nodes were written
+	titles []string
+}
+
+//Node is a named part of a script, which conversations start at or jump to
+type Node struct {
+	Title string
+	// header values besides the title, such as tags
+	Headers map[string]string
+	line    int
+	body    []stmt
+}
+
+//Titles returns the script's node titles in the order they were written
+func (s *Script) Titles() []string {
+	return s.titles
+}
+
+//stmt is a statement of a node's body
+type stmt interface{}
+
+//template is text with {expr}s to fill in
+type template []interface{}
+
+type lineStmt struct {
+	line    int
+	speaker string
+	text    template
+	cond    expr
+}
+
+type setStmt struct {
+	line  int
+	name  string
+	value expr
+}
+
+type branch struct {
+	cond expr
+	body []stmt
+}
+
+type ifStmt struct {
+	line     int
+	branches []branch
+}
+
+type jumpStmt struct {
+	line int
+	node string
+}
+
+type stopStmt struct{}
+
+type commandStmt struct {
+	line int
+	name string
+	args template
+}
+
+type option struct {
+	line int
+	text template
+	cond expr
+	body []stmt
+}
+
+type optionsStmt struct {
+	options []option
+}
+
+//srcLine is a line of a node's body, without its indentation
+type srcLine struct {
+	n      int
+	indent int
+	text   string
+}
+
+//Parse reads a script, name being what problems with it are reported against
+func Parse(r io.Reader, name string) (*Script, error) {
+	s := &Script{Name: name, Nodes: map[string]*Node{}}
+
+	scanner := bufio.NewScanner(r)
+	n := 0
+	var node *Node
+	var body []srcLine
+	inBody := false
+	for scanner.Scan() {
+		n++
+		raw := strings.TrimRight(scanner.Text(), " \t\r")
+		text := strings.TrimSpace(raw)
+
+		switch {
+		case inBody && text == "===":
+			if err := s.add(node, body); err != nil {
+				return nil, err
+			}
+			node, body, inBody = nil, nil, false
+		case inBody:
+			if text == "" || strings.HasPrefix(text, "//") {
+				continue
+			}
+			body = append(body, srcLine{n: n, indent: indentOf(raw), text: text})
+		case text == "" || strings.HasPrefix(text, "//"):
+		case text == "---":
+			if node == nil || node.Title == "" {
+				return nil, Problem{name, n, "node has no title"}
+			}
+			inBody = true
+		default:
+			if node == nil {
+				node = &Node{Headers: map[string]string{}, line: n}
+			}
+			colon := strings.IndexByte(text, ':')
+			if colon < 0 {
+				return nil, Problem{name, n, fmt.Sprintf("expected a `key: value` header, got %q", text)}
+			}
+			key, value := strings.TrimSpace(text[:colon]), strings.TrimSpace(text[colon+1:])
+			if key == "title" {
+				node.Title = value
+				continue
+			}
+			node.Headers[key] = value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read %s: %v", name, err)
+	}
+	if inBody {
+		return nil, Problem{name, n, fmt.Sprintf("node %s has no closing ===", node.Title)}
+	}
+	if node != nil {
+		return nil, Problem{name, node.line, fmt.Sprintf("node %s has no body", node.Title)}
+	}
+	return s, nil
+}
+
+//add parses a node's body and adds it to the script
+func (s *Script) add(node *Node, lines []srcLine) error {
+	if other, ok := s.Nodes[node.Title]; ok {
+		return Problem{s.Name, node.line, fmt.Sprintf("node %s is already defined on line %d", node.Title, other.line)}
+	}
+	p := &parser{file: s.Name, lines: lines}
+	body, err := p.block(0)
+	if err != nil {
+		return err
+	}
+	if p.pos < len(p.lines) {
+		return p.problem(p.lines[p.pos], fmt.Sprintf("%s without <<if>>", p.lines[p.pos].text))
+	}
+	node.body = body
+	s.Nodes[node.Title] = node
+	s.titles = append(s.titles, node.Title)
+	return nil
+}
+
+//indentOf returns how far a line is indented, a tab counting as four spaces
+func indentOf(line string) int {
+	indent := 0
+	for _, c := range line {
+		switch c {
+		case ' ':
+			indent++
+		case '\t':
+			indent += 4
+		default:
+			return indent
+		}
+	}
+	return indent
+}
+
+//parser parses the statements of a node's body
+type parser struct {
+	file  string
+	lines []srcLine
+	pos   int
+}
+
+func (p *parser) problem(l srcLine, msg string) error {
+	return Problem{p.file, l.n, msg}
+}
+
+//block parses statements until a line indented less than indent, or an <<elseif>>, <<else>> or <<endif>> which is
+//left for the <<if>> it belongs to
+func (p *parser) block(indent int) ([]stmt, error) {
+	var stmts []stmt
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		if l.indent < indent {
+			break
+		}
+		if name, _ := command(l.text); name == "elseif" || name == "else" || name == "endif" {
+			break
+		}
+
+		var s stmt
+		var err error
+		if strings.HasPrefix(l.text, "->") {
+			s, err = p.options(l.indent)
+		} else {
+			p.pos++
+			s, err = p.statement(l)
+		}
+		if err != nil {
+			return nil, err
+		}
+		stmts = append(stmts, s)
+	}
+	return stmts, nil
+}
+
+//options parses a run of options at the same indentation, with the bodies beneath them
+func (p *parser) options(indent int) (stmt, error) {
+	var group optionsStmt
+	for p.pos < len(p.lines) {
+		l := p.lines[p.pos]
+		if l.indent != indent || !strings.HasPrefix(l.text, "->") {
+			break
+		}
+		p.pos++
+
+		text, cond, err := p.condition(l, strings.TrimSpace(l.text[2:]))
+		if err != nil {
+			return nil, err
+		}
+		if text == "" {
+			return nil, p.problem(l, "option has no text")
+		}
+		o := option{line: l.n, cond: cond}
+		if o.text, err = p.template(l, text); err != nil {
+			return nil, err
+		}
+		if o.body, err = p.block(indent + 1); err != nil {
+			return nil, err
+		}
+		group.options = append(group.options, o)
+	}
+	return group, nil
+}
+
+//statement parses a line of dialogue or a command
+func (p *parser) statement(l srcLine) (stmt, error) {
+	name, args := command(l.text)
+	if name == "" {
+		return p.line(l)
+	}
+
+	switch name {
+	case "set":
+		eq := strings.IndexByte(args, '=')
+		to := strings.Index(args, " to ")
+		if eq < 0 && to < 0 {
+			return nil, p.problem(l, "expected <<set $variable = value>>")
+		}
+		variable, value := "", ""
+		if eq >= 0 {
+			variable, value = args[:eq], args[eq+1:]
+		} else {
+			variable, value = args[:to], args[to+4:]
+		}
+		variable = strings.TrimSpace(variable)
+		if !strings.HasPrefix(variable, "$") || len(variable) == 1 {
+			return nil, p.problem(l, fmt.Sprintf("can only set variables, which start with $, not %q", variable))
+		}
+		e, err := parseExpr(value)
+		if err != nil {
+			return nil, p.problem(l, err.Error())
+		}
+		return setStmt{line: l.n, name: variable[1:], value: e}, nil
+	case "if":
+		return p.ifBlock(l, args)
+	case "jump":
+		if args == "" {
+			return nil, p.problem(l, "expected <<jump node>>")
+		}
+		return jumpStmt{line: l.n, node: args}, nil
+	case "stop":
+		return stopStmt{}, nil
+	}
+
+	t, err := p.template(l, args)
+	if err != nil {
+		return nil, err
+	}
+	return commandStmt{line: l.n, name: name, args: t}, nil
+}
+
+//ifBlock parses an <<if>>, its branches and the <<endif>> closing it
+func (p *parser) ifBlock(l srcLine, cond string) (stmt, error) {
+	s := ifStmt{line: l.n}
+	start := l
+	isElse := false
+	for {
+		var e expr
+		if !isElse {
+			if cond == "" {
+				return nil, p.problem(l, "expected a condition")
+			}
+			var err error
+			if e, err = parseExpr(cond); err != nil {
+				return nil, p.problem(l, err.Error())
+			}
+		}
+
+		body, err := p.block(0)
+		if err != nil {
+			return nil, err
+		}
+		s.branches = append(s.branches, branch{cond: e, body: body})
+
+		if p.pos >= len(p.lines) {
+			return nil, p.problem(start, "<<if>> without <<endif>>")
+		}
+		l = p.lines[p.pos]
+		p.pos++
+		name, args := command(l.text)
+		switch {
+		case name == "endif":
+			return s, nil
+		case isElse:
+			return nil, p.problem(l, fmt.Sprintf("<<%s>> after <<else>>", name))
+		}
+		isElse, cond = name == "else", args
+	}
+}
+
+//line parses a line of dialogue, said by whoever's named before its first colon
+func (p *parser) line(l srcLine) (stmt, error) {
+	text, cond, err := p.condition(l, l.text)
+	if err != nil {
+		return nil, err
+	}
+	s := lineStmt{line: l.n, cond: cond}
+	if colon := strings.IndexByte(text, ':'); colon > 0 && isSpeaker(text[:colon]) {
+		s.speaker, text = strings.TrimSpace(text[:colon]), strings.TrimSpace(text[colon+1:])
+	}
+	if s.text, err = p.template(l, text); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+//condition splits a trailing <<if expr>> from a line or option
+func (p *parser) condition(l srcLine, text string) (string, expr, error) {
+	if !strings.HasSuffix(text, ">>") {
+		return text, nil, nil
+	}
+	start := strings.LastIndex(text, "<<")
+	name, args := command(text[start:])
+	if name != "if" {
+		return text, nil, nil
+	}
+	e, err := parseExpr(args)
+	if err != nil {
+		return "", nil, p.problem(l, err.Error())
+	}
+	return strings.TrimSpace(text[:start]), e, nil
+}
+
+//template parses the {expr}s within text
+func (p *parser) template(l srcLine, text string) (template, error) {
+	var t template
+	for {
+		open := strings.IndexByte(text, '{')
+		if open < 0 {
+			break
+		}
+		end := strings.IndexByte(text[open:], '}')
+		if end < 0 {
+			return nil, p.problem(l, "unclosed {")
+		}
+		e, err := parseExpr(text[open+1 : open+end])
+		if err != nil {
+			return nil, p.problem(l, err.Error())
+		}
+		if open > 0 {
+			t = append(t, text[:open])
+		}
+		t = append(t, e)
+		text = text[open+end+1:]
+	}
+	if text != "" {
+		t = append(t, text)
+	}
+	return t, nil
+}
+
+//command returns the name and arguments of a line which is a <<command>>, or an empty name if it isn't one
+func command(text string) (string, string) {
+	if !strings.HasPrefix(text, "<<") || !strings.HasSuffix(text, ">>") {
+		return "", ""
+	}
+	inner := strings.TrimSpace(text[2 : len(text)-2])
+	space := strings.IndexAny(inner, " \t")
+	if space < 0 {
+		return inner, ""
+	}
+	return inner[:space], strings.TrimSpace(inner[space+1:])
+}
+
+//isSpeaker returns whether the text before a line's colon is a name, rather than part of what's said
+func isSpeaker(name string) bool {
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 24 {
+		return false
+	}
+	for _, c := range name {
+		if !(c == ' ' || c == '-' || c == '\'' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9') {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,91 @@
+package dialogue
+
+import (
+	"fmt"
+	"sort"
+)
+
+//Validate returns the problems with a script which parsing it can't catch: jumps to nodes it doesn't have, and, if
+//they're given, commands and functions the game doesn't provide
+func (s *Script) Validate(commands, functions []string) []error {
+	known := func(names []string) map[string]bool {
+		if names == nil {
+			return nil
+		}
+		set := map[string]bool{}
+		for _, n := range names {
+			set[n] = true
+		}
+		return set
+	}
+	v := &validator{script: s, commands: known(commands), functions: known(functions)}
+
+	for _, title := range s.titles {
+		v.block(s.Nodes[title].body)
+	}
+	sort.SliceStable(v.problems, func(i, j int) bool {
+		return v.problems[i].(Problem).Line < v.problems[j].(Problem).Line
+	})
+	return v.problems
+}
+
+type validator struct {
+	script              *Script
+	commands, functions map[string]bool
+	problems            []error
+}
+
+func (v *validator) report(line int, format string, args ...interface{}) {
+	v.problems = append(v.problems, Problem{v.script.Name, line, fmt.Sprintf(format, args...)})
+}
+
+func (v *validator) block(stmts []stmt) {
+	for _, s := range stmts {
+		switch s := s.(type) {
+		case lineStmt:
+			v.expr(s.line, s.cond)
+			v.template(s.line, s.text)
+		case setStmt:
+			v.expr(s.line, s.value)
+		case ifStmt:
+			for _, b := range s.branches {
+				v.expr(s.line, b.cond)
+				v.block(b.body)
+			}
+		case jumpStmt:
+			if _, ok := v.script.Nodes[s.node]; !ok {
+				v.report(s.line, "jump to %s, which isn't a node", s.node)
+			}
+		case commandStmt:
+			if v.commands != nil && !v.commands[s.name] {
+				v.report(s.line, "no command called %s", s.name)
+			}
+			v.template(s.line, s.args)
+		case optionsStmt:
+			for _, o := range s.options {
+				v.expr(o.line, o.cond)
+				v.template(o.line, o.text)
+				v.block(o.body)
+			}
+		}
+	}
+}
+
+func (v *validator) template(line int, t template) {
+	for _, part := range t {
+		if e, ok := part.(expr); ok {
+			v.expr(line, e)
+		}
+	}
+}
+
+func (v *validator) expr(line int, e expr) {
+	if e == nil || v.functions == nil {
+		return
+	}
+	for _, name := range functions(e, nil) {
+		if !v.functions[name] {
+			v.report(line, "no function called %s", name)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+//Package event passes gameplay events, such as a bunny hopping or an item being picked, from whatever makes them
+//happen to whatever reacts to them, so neither needs to know about the other. Each kind of event is its own struct
+//type, and handlers subscribe to the types they want as funcs taking that type.
+package event
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// most rounds of events deferred while flushing that Flush delivers before giving up, so handlers deferring events
+// to each other forever can't hang the game
+const maxFlushRounds = 16
+
+type handler struct {
+	id int
+	fn reflect.Value
+}
+
+//Bus delivers published events to the handlers subscribed to their type, either straight away or once it's next
+//flushed
+type Bus struct {
+	handlers map[reflect.Type][]handler
+	// handlers of every event whatever its type, such as for logging
+	all      []func(e interface{})
+	allIDs   []int
+	lastID   int
+	deferred []interface{}
+	// how many of each type of event have been delivered, by type name
+	counts map[string]int
+}
+
+//New creates a bus without any subscribers
+func New() *Bus {
+	return &Bus{handlers: map[reflect.Type][]handler{}, counts: map[string]int{}}
+}
+
+//Subscription is a handler subscribed to a bus, which can be cancelled
+type Subscription struct {
+	bus *Bus
+	t   reflect.Type
+	id  int
+}
+
+//Subscribe calls fn, a func taking a single event type such as func(e PlayerHopped), with every event of that type
+//delivered. It panics if fn is anything else, as it's always a mistake in the caller.
+func (b *Bus) Subscribe(fn interface{}) Subscription {
+	v := reflect.ValueOf(fn)
+	t := v.Type()
+	if t.Kind() != reflect.Func || t.NumIn() != 1 || t.NumOut() != 0 || v.IsNil() {
+		panic(fmt.Sprintf("event handlers must be a func taking one event, not %s", t))
+	}
+	b.lastID++
+	e := t.In(0)
+	b.handlers[e] = append(b.handlers[e], handler{id: b.lastID, fn: v})
+	return Subscription{bus: b, t: e, id: b.lastID}
+}
+
+//SubscribeAll calls fn with every event delivered, whatever its type
+func (b *Bus) SubscribeAll(fn func(e interface{})) Subscription {
+	b.lastID++
+	b.all = append(b.all, fn)
+	b.allIDs = append(b.allIDs, b.lastID)
+	return Subscription{bus: b, id: b.lastID}
+}
+
+//Cancel stops the handler receiving any more events, doing nothing if it already has been
+func (s Subscription) Cancel() {
+	if s.bus == nil {
+		return
+	}
+	b := s.bus
+	if s.t == nil {
+		for i, id := range b.allIDs {
+			if id == s.id {
+				// copied rather than removed in place, in case the handlers are being called
+				b.all = append(append([]func(interface{}){}, b.all[:i]...), b.all[i+1:]...)
+				b.allIDs = append(append([]int{}, b.allIDs[:i]...), b.allIDs[i+1:]...)
+				return
+			}
+		}
+		return
+	}
+	hs := b.handlers[s.t]
+	for i, h := range hs {
+		if h.id == s.id {
+			b.handlers[s.t] = append(append([]handler{}, hs[:i]...), hs[i+1:]...)
+			return
+		}
+	}
+}
+
+//Publish delivers an event to its handlers straight away, in the order they subscribed, before returning
+func (b *Bus) Publish(e interface{}) {
+	if e == nil {
+		return
+	}
+	t := reflect.TypeOf(e)
+	b.counts[name(t)]++
+
+	// handlers subscribing or cancelling while the event's delivered don't change who it's delivered to
+	if hs := b.handlers[t]; len(hs) > 0 {
+		args := []reflect.Value{reflect.ValueOf(e)}
+		for _, h := range hs {
+			h.fn.Call(args)
+		}
+	}
+	for _, fn := range b.all {
+		fn(e)
+	}
+}
+
+//Defer queues an event to be delivered the next time the bus is flushed, such as one happening partway through
+//updating the world which its handlers shouldn't change under it
+func (b *Bus) Defer(e interface{}) {
+	if e == nil {
+		return
+	}
+	b.deferred = append(b.deferred, e)
+}
+
+//Pending returns how many deferred events are waiting to be delivered
+func (b *Bus) Pending() int {
+	return len(b.deferred)
+}
+
+//Flush delivers the deferred events in the order they were deferred, along with any more deferred by their handlers.
+//It returns an error if handlers keep deferring events after many rounds, dropping those left.
+func (b *Bus) Flush() error {
+	for round := 0; len(b.deferred) > 0; round++ {
+		if round == maxFlushRounds {
+			dropped := len(b.deferred)
+			b.deferred = nil
+			return fmt.Errorf("dropped %d events still being deferred after %d rounds", dropped, maxFlushRounds)
+		}
+		queue := b.deferred
+		b.deferred = nil
+		for _, e := range queue {
+			b.Publish(e)
+		}
+	}
+	return nil
+}
+
+//Counts returns how many of each type of event have been delivered, by type name, sorted by name
+func (b *Bus) Counts() []Count {
+	counts := make([]Count, 0, len(b.counts))
+	for n, c := range b.counts {
+		counts = append(counts, Count{Name: n, Delivered: c})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		return counts[i].Name < counts[j].Name
+	})
+	return counts
+}
+
+//Count is how many events of a type have been delivered
+type Count struct {
+	Name      string
+	Delivered int
+}
+
+//Name returns the name of an event's type, such as PlayerHopped
+func Name(e interface{}) string {
+	if e == nil {
+		return "nil"
+	}
+	return name(reflect.TypeOf(e))
+}
+
+func name(t reflect.Type) string {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Name() == "" {
+		return t.String()
+	}
+	return t.Name()
+}
@@ -0,0 +1,246 @@
+package font
+
+import (
+	"encoding/json"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hajimehoshi/ebiten"
+)
+
+//Descriptor describes a bitmap font, read from JSON alongside the image of its glyphs
+type Descriptor struct {
+	Image string `json:"image"`
+	// height in pixels of every glyph, and the distance between the tops of one line and the next
+	Height     int `json:"height"`
+	LineHeight int `json:"lineHeight"`
+	// pixels from the top of a glyph down to the line letters sit on, descenders hang below it
+	Baseline int `json:"baseline"`
+	// pixels left between one glyph and the next, and how wide a space is
+	Spacing    int     `json:"spacing"`
+	SpaceWidth int     `json:"spaceWidth"`
+	Glyphs     []Glyph `json:"glyphs"`
+	Kerning    []Kern  `json:"kerning"`
+}
+
+//Glyph is where a character is drawn from on the font's image
+type Glyph struct {
+	Char string `json:"char"`
+	X    int    `json:"x"`
+	Y    int    `json:"y"`
+	W    int    `json:"w"`
+}
+
+//Kern moves the second character of a pair closer to the first, or further away if the amount is positive
+type Kern struct {
+	Pair   string `json:"pair"`
+	Amount int    `json:"amount"`
+}
+
+//Font draws text from a bitmap font's white glyphs, tinted to any colour
+type Font struct {
+	image      *ebiten.Image
+	glyphs     map[rune]image.Rectangle
+	kerning    map[[2]rune]int
+	height     int
+	lineHeight int
+	baseline   int
+	spacing    int
+	spaceWidth int
+}
+
+// drawn in place of characters the font has no glyph for
+const missing = '?'
+
+//Load reads a font's descriptor, loading the image of its glyphs by file name with img
+func Load(r io.Reader, img func(name string) (*ebiten.Image, error)) (*Font, error) {
+	var d Descriptor
+	if err := json.NewDecoder(r).Decode(&d); err != nil {
+		return nil, fmt.Errorf("unable to read font descriptor: %v", err)
+	}
+	if d.Height <= 0 || d.LineHeight <= 0 {
+		return nil, fmt.Errorf("font's height and line height must be more than 0")
+	}
+
+	glyphs, err := img(d.Image)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load font image %s: %v", d.Image, err)
+	}
+	w, h := glyphs.Size()
+
+	f := &Font{
+		image:      glyphs,
+		glyphs:     map[rune]image.Rectangle{},
+		kerning:    map[[2]rune]int{},
+		height:     d.Height,
+		lineHeight: d.LineHeight,
+		baseline:   d.Baseline,
+		spacing:    d.Spacing,
+		spaceWidth: d.SpaceWidth,
+	}
+	for _, g := range d.Glyphs {
+		c, size := utf8.DecodeRuneInString(g.Char)
+		if size == 0 || size != len(g.Char) {
+			return nil, fmt.Errorf("glyph %q must be a single character", g.Char)
+		}
+		rect := image.Rect(g.X, g.Y, g.X+g.W, g.Y+d.Height)
+		if !rect.In(image.Rect(0, 0, w, h)) {
+			return nil, fmt.Errorf("glyph %q at %v is off the %dx%d font image", g.Char, rect, w, h)
+		}
+		f.glyphs[c] = rect
+	}
+	for _, k := range d.Kerning {
+		pair := []rune(k.Pair)
+		if len(pair) != 2 {
+			return nil, fmt.Errorf("kerning pair %q must be two characters", k.Pair)
+		}
+		f.kerning[[2]rune{pair[0], pair[1]}] = k.Amount
+	}
+	return f, nil
+}
+
+//LineHeight returns the distance in pixels between the tops of one line and the next
+func (f *Font) LineHeight() int {
+	return f.lineHeight
+}
+
+//Baseline returns the pixels from the top of a line down to where letters sit
+func (f *Font) Baseline() int {
+	return f.baseline
+}
+
+//advance returns how far along the line a character moves the next, after kerning it against the one before
+func (f *Font) advance(prev, c rune) int {
+	if c == ' ' {
+		return f.spaceWidth + f.spacing
+	}
+	r, ok := f.glyphs[c]
+	if !ok {
+		r = f.glyphs[missing]
+	}
+	return r.Dx() + f.spacing + f.kerning[[2]rune{prev, c}]
+}
+
+//Measure returns the size in pixels of text once drawn, ignoring colour tags
+func (f *Font) Measure(text string) image.Point {
+	size := image.Pt(0, f.height)
+	x := 0
+	var prev rune
+	for _, t := range tokenize(text) {
+		switch {
+		case t.tag != "":
+			continue
+		case t.char == '\n':
+			size.Y += f.lineHeight
+			x, prev = 0, 0
+			continue
+		}
+		x += f.advance(prev, t.char)
+		prev = t.char
+		// the spacing after the last character on a line isn't part of it
+		if x-f.spacing > size.X {
+			size.X = x - f.spacing
+		}
+	}
+	return size
+}
+
+//Draw draws text with its top left at x, y in clr, colour tags within it changing the colour of what follows
+func (f *Font) Draw(dst *ebiten.Image, text string, x, y int, clr color.Color) error {
+	colours := []color.Color{clr}
+	cx, cy := x, y
+	var prev rune
+	for _, t := range tokenize(text) {
+		if t.tag != "" {
+			colours = applyTag(colours, t.tag)
+			continue
+		}
+		if t.char == '\n' {
+			cx, cy = x, cy+f.lineHeight
+			prev = 0
+			continue
+		}
+
+		cx += f.kerning[[2]rune{prev, t.char}]
+		prev = t.char
+		if t.char == ' ' {
+			cx += f.spaceWidth + f.spacing
+			continue
+		}
+		r, ok := f.glyphs[t.char]
+		if !ok {
+			r = f.glyphs[missing]
+		}
+
+		op := &ebiten.DrawImageOptions{}
+		op.SourceRect = &r
+		op.GeoM.Translate(float64(cx), float64(cy))
+		cr, cg, cb, ca := colours[len(colours)-1].RGBA()
+		if ca > 0 {
+			// the glyphs are white, tinting them by the colour's straight, not premultiplied, components
+			op.ColorM.Scale(float64(cr)/float64(ca), float64(cg)/float64(ca), float64(cb)/float64(ca), float64(ca)/0xffff)
+		} else {
+			op.ColorM.Scale(0, 0, 0, 0)
+		}
+		if err := dst.DrawImage(f.image, op); err != nil {
+			return err
+		}
+		cx += r.Dx() + f.spacing
+	}
+	return nil
+}
+
+//Wrap breaks text into lines no wider than width pixels, between words where it can, keeping its colour tags and
+//the line breaks already in it
+func (f *Font) Wrap(text string, width int) string {
+	var out strings.Builder
+	for i, line := range strings.Split(text, "\n") {
+		if i > 0 {
+			out.WriteByte('\n')
+		}
+		f.wrapLine(&out, line, width)
+	}
+	return out.String()
+}
+
+func (f *Font) wrapLine(out *strings.Builder, line string, width int) {
+	// the sum of the advances of everything on the line so far, which includes the spacing after the last character
+	x := 0
+	for i, word := range strings.Split(line, " ") {
+		w := f.Measure(word).X
+		if i > 0 {
+			space := f.spaceWidth + f.spacing
+			if x+space+w <= width {
+				out.WriteByte(' ')
+				x += space
+			} else {
+				out.WriteByte('\n')
+				x = 0
+			}
+		}
+
+		if x+w <= width {
+			out.WriteString(word)
+			x += w + f.spacing
+			continue
+		}
+		// a word too long for a line of its own is broken wherever it reaches the edge
+		var prev rune
+		for _, t := range tokenize(word) {
+			if t.tag == "" {
+				advance := f.advance(prev, t.char)
+				if x > 0 && x+advance-f.spacing > width {
+					out.WriteByte('\n')
+					x = 0
+				}
+				x += advance
+				prev = t.char
+			}
+			out.WriteString(t.raw)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+package font
+
+import (
+	"image/color"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+//Colours are the colours colour tags can name, such as [red]. [#rrggbb] gives any other colour, [/] goes back to the
+//colour before the last tag, and [[ is a [ that doesn't start a tag.
+var Colours = map[string]color.Color{
+	"white":  color.RGBA{0xff, 0xff, 0xff, 0xff},
+	"grey":   color.RGBA{0x9a, 0x9a, 0xa8, 0xff},
+	"black":  color.RGBA{0x10, 0x10, 0x18, 0xff},
+	"red":    color.RGBA{0xe8, 0x40, 0x40, 0xff},
+	"orange": color.RGBA{0xf0, 0x90, 0x30, 0xff},
+	"yellow": color.RGBA{0xf8, 0xe0, 0x50, 0xff},
+	"green":  color.RGBA{0x60, 0xd0, 0x50, 0xff},
+	"blue":   color.RGBA{0x50, 0x90, 0xf0, 0xff},
+	"pink":   color.RGBA{0xf0, 0x80, 0xc0, 0xff},
+	"berry":  color.RGBA{0xb0, 0x30, 0x70, 0xff},
+}
+
+//token is either a character of text or a colour tag, raw being how it was written
+type token struct {
+	char rune
+	tag  string
+	raw  string
+}
+
+//tokenize splits text into characters and colour tags, square brackets which don't make a tag being characters
+func tokenize(text string) []token {
+	tokens := make([]token, 0, len(text))
+	for i := 0; i < len(text); {
+		if strings.HasPrefix(text[i:], "[[") {
+			tokens = append(tokens, token{char: '[', raw: "[["})
+			i += 2
+			continue
+		}
+		if text[i] == '[' {
+			if end := strings.IndexByte(text[i:], ']'); end > 0 {
+				if tag := text[i+1 : i+end]; isTag(tag) {
+					tokens = append(tokens, token{tag: tag, raw: text[i : i+end+1]})
+					i += end + 1
+					continue
+				}
+			}
+		}
+		c, size := utf8.DecodeRuneInString(text[i:])
+		tokens = append(tokens, token{char: c, raw: text[i : i+size]})
+		i += size
+	}
+	return tokens
+}
+
+func isTag(tag string) bool {
+	if tag == "/" {
+		return true
+	}
+	if _, ok := Colours[tag]; ok {
+		return true
+	}
+	_, ok := hexColour(tag)
+	return ok
+}
+
+//hexColour parses a #rrggbb colour
+func hexColour(tag string) (color.Color, bool) {
+	if len(tag) != 7 || tag[0] != '#' {
+		return nil, false
+	}
+	v, err := strconv.ParseUint(tag[1:], 16, 32)
+	if err != nil {
+		return nil, false
+	}
+	return color.RGBA{uint8(v >> 16), uint8(v >> 8), uint8(v), 0xff}, true
+}
+
+//applyTag pushes the colour a tag names onto the stack of colours, or pops the last one for [/], never popping the
+//colour the text started in
+func applyTag(colours []color.Color, tag string) []color.Color {
+	if tag == "/" {
+		if len(colours) > 1 {
+			colours = colours[:len(colours)-1]
+		}
+		return colours
+	}
+	if c, ok := Colours[tag]; ok {
+		return append(colours, c)
+	}
+	c, _ := hexColour(tag)
+	return append(colours, c)
+}
+
+//Strip returns text with its colour tags taken out, and any [[ as [
+func Strip(text string) string {
+	var b strings.Builder
+	for _, t := range tokenize(text) {
+		if t.tag == "" {
+			b.WriteRune(t.char)
+		}
+	}
+	return b.String()
+}
+
+//Escape makes text safe to put within tagged text, so any square brackets in it are drawn rather than read as tags
+func Escape(text string) string {
+	return strings.ReplaceAll(text, "[", "[[")
+}
+
+//Len returns how many characters text draws, not counting its colour tags
+func Len(text string) int {
+	n := 0
+	for _, t := range tokenize(text) {
+		if t.tag == "" {
+			n++
+		}
+	}
+	return n
+}
+
+//Truncate returns text cut short after its first n characters, keeping the colour tags among them
+func Truncate(text string, n int) string {
+	var b strings.Builder
+	for _, t := range tokenize(text) {
+		if t.tag == "" {
+			if n <= 0 {
+				break
+			}
+			n--
+		}
+		b.WriteString(t.raw)
+	}
+	return b.String()
+}
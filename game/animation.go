@@ -1,31 +1,31 @@
 package game
 
 import (
-	"image"
+	"fmt"
+	"image/color"
 
 	"github.com/hajimehoshi/ebiten"
+	"github.com/tauraamui/berrybun/atlas"
 )
 
 type Animation struct {
-	game               *Game
-	id                 uint
-	spritesheet        *ebiten.Image
+	game *Game
+	id   uint
+	// name of the animation in the sprite atlas, and the sprites of each of its frames in order
+	name               string
+	frames             []atlas.Sprite
 	repeatLoopStart    int
 	repeatLoopEnd      int
 	maxRepeatLoopCount int
 	repeatLoopCount    int
-	frameWidth         int
-	frameHeight        int
-	frame0X            int
-	frame0Y            int
-	frameNum           int
 	defaultSpeed       int
 	speed              int
 	count              int
 	countForLoopStart  int
 }
 
-func (a *Animation) Update(screen *ebiten.Image) error {
+//Update advances the animation and draws its current frame centred on world position x, y
+func (a *Animation) Update(screen *ebiten.Image, x, y float64, tint color.Color) error {
 
 	if a.count < 0 {
 		a.count = 0
@@ -33,16 +33,13 @@ func (a *Animation) Update(screen *ebiten.Image) error {
 
 	a.count++
 
-	scale := ebiten.DeviceScaleFactor()
+	i := (a.count / a.speed) % len(a.frames)
+	sprite := a.frames[i]
 
 	op := &ebiten.DrawImageOptions{}
-	op.GeoM.Translate(-float64(a.frameWidth)/2, -float64(a.frameHeight)/2)
-	sw, sh := screen.Size()
-	swf := float64(sw / a.game.cameraWidth)
-	shf := float64(sh / a.game.cameraHeight)
-	op.GeoM.Scale(scale*swf, scale*shf)
-	op.GeoM.Translate(float64(sw)/2, float64(sh)/2)
-	i := (a.count / a.speed) % a.frameNum
+	op.GeoM.Translate(-float64(sprite.Rect.Dx())/2, -float64(sprite.Rect.Dy())/2)
+	op.GeoM.Translate(x, y)
+	a.game.applyCamera(op, screen)
 
 	//if current frame is now on start loop sprite
 	if i == a.repeatLoopStart {
@@ -63,24 +60,26 @@ func (a *Animation) Update(screen *ebiten.Image) error {
 	//if maximum number of loops occurred
 	if a.repeatLoopCount >= a.maxRepeatLoopCount {
 		//if current frame is end of animation
-		if i == a.frameNum-1 {
+		if i == len(a.frames)-1 {
 			//set the loop count back
 			a.repeatLoopCount = 0
 		}
 	}
 
-	sx, sy := a.frame0X+i*a.frameWidth, a.frame0Y
-	r := image.Rect(sx, sy, sx+a.frameWidth, sy+a.frameHeight)
-	op.SourceRect = &r
+	op.SourceRect = &sprite.Rect
 
 	var err error
 	if !ebiten.IsDrawingSkipped() {
 
-		if a.game.world.nightTime {
-			op.ColorM.ChangeHSV(0.0, 1.0, 0.4)
+		if tint != nil {
+			r, g, b, _ := tint.RGBA()
+			op.ColorM.Scale(float64(r)/0xffff, float64(g)/0xffff, float64(b)/0xffff, 1)
 		}
 
-		err = screen.DrawImage(a.spritesheet, op)
+		a.game.world.applyLighting(&op.ColorM)
+
+		err = screen.DrawImage(sprite.Image, op)
+		a.game.draws[drawAnimation]++
 	}
 
 	if err != nil {
@@ -90,8 +89,58 @@ func (a *Animation) Update(screen *ebiten.Image) error {
 	return nil
 }
 
+//Frame returns which of the animation's frames was last drawn
+func (a *Animation) Frame() int {
+	if a.count < 0 || len(a.frames) == 0 {
+		return 0
+	}
+	return (a.count / a.speed) % len(a.frames)
+}
+
+//Size returns the width and height of the animation's frames
+func (a *Animation) Size() (int, int) {
+	if len(a.frames) == 0 {
+		return 0, 0
+	}
+	return a.frames[0].Rect.Dx(), a.frames[0].Rect.Dy()
+}
+
 func (a *Animation) Reset() {
 	a.count = -1
 	a.speed = a.defaultSpeed
 	a.repeatLoopCount = 0
 }
+
+// updates each frame of an animation played once is shown for
+const oneShotSpeed = 8
+
+//oneShot is an animation played through once at a world position, such as one a script plays
+type oneShot struct {
+	animation *Animation
+	x, y      float64
+	updates   int
+}
+
+func (o *oneShot) Update(screen *ebiten.Image) error {
+	o.updates++
+	return o.animation.Update(screen, o.x, o.y, nil)
+}
+
+func (o *oneShot) depth() float64 {
+	return o.y
+}
+
+func (o *oneShot) done() bool {
+	return o.updates >= len(o.animation.frames)*o.animation.speed
+}
+
+//PlayAnimation plays the named animation in the sprite atlas through once, centred on world position x, y
+func (w *World) PlayAnimation(name string, x, y float64) error {
+	frames, err := w.sprites.Frames(name)
+	if err != nil {
+		return fmt.Errorf("unable to play animation: %v", err)
+	}
+	a := &Animation{game: w.game, name: name, frames: frames, defaultSpeed: oneShotSpeed, speed: oneShotSpeed, count: -1}
+	w.oneShots = append(w.oneShots, &oneShot{animation: a, x: x, y: y})
+	return nil
+}
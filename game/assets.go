@@ -0,0 +1,113 @@
+package game
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/tacusci/logging"
+	"github.com/tauraamui/berrybun/assets"
+	"github.com/tauraamui/berrybun/res"
+)
+
+// updates between checks for changed asset files in debug mode, twice a second
+const assetWatchInterval = 30
+
+//versioned is any asset handle, which can say when it's been reloaded
+type versioned interface {
+	Name() string
+	Version() int
+}
+
+//reloader refreshes whatever was built from an asset when it's reloaded
+type reloader struct {
+	asset   versioned
+	version int
+	reload  func() error
+}
+
+//initAssets creates the asset manager, reading files from any mods, then the assets directory, before those built into
+//the game and hot reloading them as they change in debug mode. Mods come before the assets directory as it's the
+//built in assets themselves when running from a checkout.
+func (g *Game) initAssets() {
+	var sources []assets.Source
+	if g.AssetsDir != "" {
+		if info, err := os.Stat(g.AssetsDir); err == nil && info.IsDir() {
+			logging.Info(fmt.Sprintf("overriding built in assets with those in %s", g.AssetsDir))
+			sources = append(sources, assets.Dir(g.AssetsDir))
+		}
+	}
+	sources = append(sources, assets.Source{Name: "built in assets", FS: res.FS})
+	g.gameAssets = assets.NewManager(sources...)
+	sources = append(g.loadMods(sources), sources...)
+
+	g.assets = assets.NewManager(sources...)
+	if g.Debug {
+		g.assets.Watch(assetWatchInterval)
+		g.gameAssets.Watch(assetWatchInterval)
+	}
+}
+
+//loadImage returns the named image asset, the game can't run without its images
+func (g *Game) loadImage(name string) *assets.Image {
+	img, err := g.assets.Image(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return img
+}
+
+//loadBytes returns the named asset's contents, the game can't run without them
+func (g *Game) loadBytes(name string) *assets.Bytes {
+	b, err := g.assets.Bytes(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return b
+}
+
+//loadModdable loads the named asset's contents with load, and again whenever it's reloaded. A mod's version which
+//won't load is logged and left out for the game's own, the game can't run with that broken, or for nothing if the mod
+//added it.
+func (g *Game) loadModdable(name string, load func(data []byte) error) {
+	file := g.loadBytes(name)
+	err := load(file.Data())
+	if err != nil && strings.HasPrefix(file.Source(), modSource) {
+		logging.Error(fmt.Sprintf("unable to load %s from %s, leaving it out: %v", name, file.Source(), err))
+		file.Release()
+		if !g.gameAssets.Has(name) {
+			return
+		}
+		if file, err = g.gameAssets.Bytes(name); err != nil {
+			log.Fatal(err)
+		}
+		err = load(file.Data())
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+	g.onReload(file, func() error {
+		return load(file.Data())
+	})
+}
+
+//onReload calls reload whenever the asset is reloaded, to rebuild whatever was made from it
+func (g *Game) onReload(asset versioned, reload func() error) {
+	g.reloaders = append(g.reloaders, &reloader{asset: asset, version: asset.Version(), reload: reload})
+}
+
+//updateAssets reloads any asset files which have changed, rebuilding what was made from them
+func (g *Game) updateAssets() {
+	g.assets.Update()
+	g.gameAssets.Update()
+	for _, r := range g.reloaders {
+		if r.asset.Version() == r.version {
+			continue
+		}
+		r.version = r.asset.Version()
+		if err := r.reload(); err != nil {
+			logging.Error(fmt.Sprintf("unable to reload %s: %v", r.asset.Name(), err))
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package game
+
+import (
+	"image"
+	"math"
+
+	"github.com/hajimehoshi/ebiten"
+)
+
+const (
+	// how far the camera is allowed to zoom out to keep every player in view
+	minCameraZoom = 0.5
+	maxCameraZoom = 1.0
+	// space in pixels kept clear between the outermost players and the edge of the view
+	cameraPlayerMargin = 96
+	// fraction of the remaining distance the camera closes on its target each update
+	cameraEasing = 0.1
+)
+
+//screenScale returns the factor to scale world pixels by to reach screen pixels, excluding zoom
+func (g *Game) screenScale(screen *ebiten.Image) (float64, float64) {
+	scale := ebiten.DeviceScaleFactor()
+
+	sw, sh := screen.Size()
+	// work out width/height scale factor based on percentage of screen size
+	swf := float64(sw / g.cameraWidth)
+	shf := float64(sh / g.cameraHeight)
+
+	return scale * swf, scale * shf
+}
+
+//applyCamera transforms draw options positioned in world space into screen space
+func (g *Game) applyCamera(op *ebiten.DrawImageOptions, screen *ebiten.Image) {
+	g.applyParallaxCamera(op, screen, 1, 1)
+}
+
+//applyParallaxCamera transforms draw options into screen space, scrolling by the parallax factors of the camera's movement
+func (g *Game) applyParallaxCamera(op *ebiten.DrawImageOptions, screen *ebiten.Image, px, py float64) {
+	sx, sy := g.screenScale(screen)
+	op.GeoM.Translate(float64(g.cameraX)*-px, float64(g.cameraY)*-py)
+	op.GeoM.Scale(sx*g.cameraZoom, sy*g.cameraZoom)
+}
+
+//visibleArea returns the rectangle of the world, in pixels, currently in view of the camera
+func (g *Game) visibleArea(screen *ebiten.Image) image.Rectangle {
+	return g.visibleParallaxArea(screen, 1, 1)
+}
+
+//visibleParallaxArea returns the rectangle in view of the camera for something scrolling by the parallax factors
+func (g *Game) visibleParallaxArea(screen *ebiten.Image, px, py float64) image.Rectangle {
+	sw, sh := screen.Size()
+	sx, sy := g.screenScale(screen)
+	vw := int(math.Ceil(float64(sw) / (sx * g.cameraZoom)))
+	vh := int(math.Ceil(float64(sh) / (sy * g.cameraZoom)))
+	x, y := int(float64(g.cameraX)*px), int(float64(g.cameraY)*py)
+	return image.Rect(x, y, x+vw, y+vh)
+}
+
+//toWorld converts a position on screen to world pixels
+func (g *Game) toWorld(screen *ebiten.Image, x, y int) image.Point {
+	sx, sy := g.screenScale(screen)
+	return image.Pt(
+		g.cameraX+int(math.Floor(float64(x)/(sx*g.cameraZoom))),
+		g.cameraY+int(math.Floor(float64(y)/(sy*g.cameraZoom))),
+	)
+}
+
+//toScreen converts a position in world pixels to the screen
+func (g *Game) toScreen(screen *ebiten.Image, x, y int) (float64, float64) {
+	sx, sy := g.screenScale(screen)
+	return float64(x-g.cameraX) * sx * g.cameraZoom, float64(y-g.cameraY) * sy * g.cameraZoom
+}
+
+//cursorTile returns the map tile under a position on screen
+func (g *Game) cursorTile(screen *ebiten.Image, x, y int) image.Point {
+	p := g.toWorld(screen, x, y)
+	return image.Pt(int(math.Floor(float64(p.X)/TileSize)), int(math.Floor(float64(p.Y)/TileSize)))
+}
+
+//updateCamera centres the camera on all players, zooming out as far as needed to fit them all in view
+func (g *Game) updateCamera(screen *ebiten.Image) {
+	players := g.world.players
+	if len(players) == 0 {
+		return
+	}
+
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	for _, p := range players {
+		minX, maxX = math.Min(minX, p.x), math.Max(maxX, p.x)
+		minY, maxY = math.Min(minY, p.y), math.Max(maxY, p.y)
+	}
+
+	sw, sh := screen.Size()
+	sx, sy := g.screenScale(screen)
+
+	// the zoom at which the players' bounds plus margin would exactly fill the screen
+	fitW := float64(sw) / (sx * (maxX - minX + cameraPlayerMargin*2))
+	fitH := float64(sh) / (sy * (maxY - minY + cameraPlayerMargin*2))
+	targetZoom := math.Max(minCameraZoom, math.Min(maxCameraZoom, math.Min(fitW, fitH)))
+	g.cameraZoom += (targetZoom - g.cameraZoom) * cameraEasing
+
+	centreX, centreY := (minX+maxX)/2, (minY+maxY)/2
+	g.cameraX = int(centreX - float64(sw)/(sx*g.cameraZoom)/2)
+	g.cameraY = int(centreY - float64(sh)/(sy*g.cameraZoom)/2)
+}
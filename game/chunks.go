@@ -0,0 +1,201 @@
+package game
+
+import (
+	"image"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/tauraamui/berrybun/tileset"
+)
+
+const (
+	// width and height of a chunk of a tile layer in tiles
+	chunkSize = 16
+	// chunks not drawn for this many draws have their images freed
+	chunkExpiry = 180
+)
+
+//chunk is a square block of a tile layer prerendered into an image, so drawing it is a single draw call
+type chunk struct {
+	// chunk's position within the layer in chunks
+	cx, cy int
+	image  *ebiten.Image
+	// whether the tiles have changed since the chunk was scanned and rendered
+	dirty bool
+	// whether the chunk has no tiles at all, such chunks never need an image
+	empty bool
+	// the animated tiles within the chunk and the frame each was last rendered with
+	animated []animatedTile
+	// draw count of the layer when the chunk was last drawn
+	lastDrawn int
+}
+
+type animatedTile struct {
+	// position within the layer in tiles
+	x, y  int
+	tile  tileset.TileID
+	frame tileset.TileID
+}
+
+//chunkAt returns the chunk at cx, cy, creating it if it hasn't been before
+func (l *TileLayer) chunkAt(cx, cy int) *chunk {
+	if l.chunks == nil {
+		l.chunks = make([][]*chunk, (l.height+chunkSize-1)/chunkSize)
+		for y := range l.chunks {
+			l.chunks[y] = make([]*chunk, (l.width+chunkSize-1)/chunkSize)
+		}
+	}
+	c := l.chunks[cy][cx]
+	if c == nil {
+		c = &chunk{cx: cx, cy: cy, dirty: true}
+		l.chunks[cy][cx] = c
+	}
+	return c
+}
+
+//invalidate marks the chunk holding the tile at x, y as needing to be rendered again
+func (l *TileLayer) invalidate(x, y int) {
+	if l.chunks == nil {
+		return
+	}
+	if c := l.chunks[y/chunkSize][x/chunkSize]; c != nil {
+		c.dirty = true
+	}
+}
+
+//invalidateAll marks every chunk as needing to be rendered again
+func (l *TileLayer) invalidateAll() {
+	for _, row := range l.chunks {
+		for _, c := range row {
+			if c != nil {
+				c.dirty = true
+			}
+		}
+	}
+}
+
+//bounds returns the area of the layer the chunk covers in tiles
+func (c *chunk) bounds(l *TileLayer) image.Rectangle {
+	r := image.Rect(c.cx*chunkSize, c.cy*chunkSize, (c.cx+1)*chunkSize, (c.cy+1)*chunkSize)
+	return r.Intersect(image.Rect(0, 0, l.width, l.height))
+}
+
+//scan finds the chunk's animated tiles and whether it has any tiles at all
+func (c *chunk) scan(l *TileLayer, ts *tileset.Tileset) {
+	c.empty = true
+	c.animated = c.animated[:0]
+
+	b := c.bounds(l)
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			t := l.tiles[y][x]
+			if t == tileset.NoTile {
+				continue
+			}
+			c.empty = false
+			if ts.Animated(t) {
+				c.animated = append(c.animated, animatedTile{x: x, y: y, tile: t, frame: tileset.NoTile})
+			}
+		}
+	}
+}
+
+//render brings the chunk's image up to date, redrawing everything if its tiles changed or only the animated tiles whose frame has moved on
+func (c *chunk) render(l *TileLayer, m *Map) error {
+	if c.dirty {
+		c.scan(l, m.tileset)
+	}
+	if c.empty {
+		if c.image != nil {
+			c.image.Dispose()
+			c.image = nil
+		}
+		c.dirty = false
+		return nil
+	}
+
+	if c.image == nil {
+		img, err := ebiten.NewImage(chunkSize*TileSize, chunkSize*TileSize, ebiten.FilterDefault)
+		if err != nil {
+			return err
+		}
+		c.image = img
+		c.dirty = true
+		l.rendered = append(l.rendered, c)
+	} else if c.dirty {
+		if err := c.image.Clear(); err != nil {
+			return err
+		}
+	}
+
+	b := c.bounds(l)
+	if c.dirty {
+		for y := b.Min.Y; y < b.Max.Y; y++ {
+			for x := b.Min.X; x < b.Max.X; x++ {
+				if t := l.tiles[y][x]; t != tileset.NoTile {
+					if err := c.drawTile(m, x, y, m.frame(t)); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		for i := range c.animated {
+			c.animated[i].frame = m.frame(c.animated[i].tile)
+		}
+		c.dirty = false
+		return nil
+	}
+
+	for i := range c.animated {
+		a := &c.animated[i]
+		frame := m.frame(a.tile)
+		if frame == a.frame {
+			continue
+		}
+		if err := c.drawTile(m, a.x, a.y, frame); err != nil {
+			return err
+		}
+		a.frame = frame
+	}
+
+	return nil
+}
+
+//frame returns the tile drawn in place of t right now, after the map's palette and any animation, which may be no tile at all
+func (m *Map) frame(t tileset.TileID) tileset.TileID {
+	return m.tileset.Frame(m.palette.Swap(t), m.clock)
+}
+
+//drawTile draws a tile into the chunk's image, replacing whatever was there so transparent tiles don't leave the last frame behind
+func (c *chunk) drawTile(m *Map, x, y int, t tileset.TileID) error {
+	if t == tileset.NoTile {
+		return nil
+	}
+
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(float64((x-c.cx*chunkSize)*TileSize), float64((y-c.cy*chunkSize)*TileSize))
+	op.CompositeMode = ebiten.CompositeModeCopy
+
+	r := m.tileset.Rect(t)
+	op.SourceRect = &r
+
+	m.game.draws[drawMap]++
+	return c.image.DrawImage(m.bgSpriteSheet, op)
+}
+
+//evictChunks frees the images of chunks which haven't been drawn in a while, they're rendered again if they come back into view
+func (l *TileLayer) evictChunks() {
+	live := l.rendered[:0]
+	for _, c := range l.rendered {
+		if c.image == nil {
+			continue
+		}
+		if l.draws-c.lastDrawn > chunkExpiry {
+			c.image.Dispose()
+			c.image = nil
+			c.dirty = true
+			continue
+		}
+		live = append(live, c)
+	}
+	l.rendered = live
+}
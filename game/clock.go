@@ -0,0 +1,65 @@
+package game
+
+import (
+	"fmt"
+	"time"
+)
+
+// the time of day the sun rises and sets, and the length of a day
+const (
+	dawn     = 6 * time.Hour
+	dusk     = 20 * time.Hour
+	fullDay  = 24 * time.Hour
+	midday   = 12 * time.Hour
+	midnight = 0
+)
+
+//SetTime sets the time of day, since midnight, night falling from dusk until dawn
+func (w *World) SetTime(t time.Duration) {
+	t %= fullDay
+	if t < 0 {
+		t += fullDay
+	}
+	w.timeOfDay = t
+	night := t < dawn || t >= dusk
+	if night != w.nightTime {
+		w.nightTime = night
+		w.game.events.Publish(TimeOfDayChanged{Time: t, Night: night})
+	}
+}
+
+//Time returns the time of day, since midnight
+func (w *World) Time() time.Duration {
+	return w.timeOfDay
+}
+
+//SetClock sets the time of day from hh:mm, day or night
+func (w *World) SetClock(s string) error {
+	switch s {
+	case "day":
+		w.SetTime(midday)
+	case "night":
+		w.SetTime(midnight)
+	default:
+		t, err := parseClock(s)
+		if err != nil {
+			return err
+		}
+		w.SetTime(t)
+	}
+	return nil
+}
+
+//parseClock reads a time of day written as hh:mm
+func parseClock(s string) (time.Duration, error) {
+	var h, m int
+	if _, err := fmt.Sscanf(s, "%d:%d", &h, &m); err != nil || h < 0 || h > 23 || m < 0 || m > 59 {
+		return 0, fmt.Errorf("time must be hh:mm, such as 18:00, not %s", s)
+	}
+	return time.Duration(h)*time.Hour + time.Duration(m)*time.Minute, nil
+}
+
+//formatClock writes a time of day as hh:mm
+func formatClock(t time.Duration) string {
+	return fmt.Sprintf("%02d:%02d", int(t/time.Hour), int(t%time.Hour/time.Minute))
+}
@@ -0,0 +1,554 @@
+package game
+
+import (
+	"fmt"
+	"image/color"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil"
+	"github.com/hajimehoshi/ebiten/inpututil"
+	"github.com/tacusci/logging"
+	"github.com/tauraamui/berrybun/console"
+	"github.com/tauraamui/berrybun/quest"
+	"github.com/tauraamui/berrybun/weather"
+)
+
+const (
+	consoleKey = ebiten.KeyGraveAccent
+	// lines of output shown when the console is open, debug text being 16 pixels a line
+	consoleLines = 14
+)
+
+var (
+	consoleBackground = color.RGBA{0x10, 0x10, 0x18, 0xe0}
+	consoleEdge       = color.RGBA{0x80, 0x80, 0xa0, 0xff}
+)
+
+//DevConsole is the drop-down developer console, opened with the backtick key in debug mode
+type DevConsole struct {
+	game    *Game
+	console *console.Console
+	open    bool
+	input   string
+	// completions offered for the last word typed, shown until the input changes
+	suggestions []string
+}
+
+func (d *DevConsole) Init() {
+	d.console = console.New()
+	d.game.registerCommands(d.console)
+}
+
+//Open returns whether the console is open, taking the keyboard for itself
+func (d *DevConsole) Open() bool {
+	return d.open
+}
+
+//RunScript runs a console script, logging its output, doing nothing if the file doesn't exist
+func (d *DevConsole) RunScript(path string) error {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to run %s: %v", path, err)
+	}
+	defer f.Close()
+
+	before := len(d.console.Lines())
+	err = d.console.RunScript(f, path)
+	for _, line := range d.console.Lines()[before:] {
+		logging.Info(line.Text)
+	}
+	return err
+}
+
+//Update opens and closes the console and, while open, edits and runs the line being typed
+func (d *DevConsole) Update() {
+	if inpututil.IsKeyJustPressed(consoleKey) {
+		d.open = !d.open
+		return
+	}
+	if !d.open {
+		return
+	}
+
+	for _, r := range ebiten.InputChars() {
+		if r == '`' || r < ' ' {
+			continue
+		}
+		d.edit(d.input + string(r))
+	}
+
+	switch {
+	case repeating(ebiten.KeyBackspace) && d.input != "":
+		_, size := lastRune(d.input)
+		d.edit(d.input[:len(d.input)-size])
+	case inpututil.IsKeyJustPressed(ebiten.KeyEnter):
+		d.console.Exec(d.input)
+		d.edit("")
+	case inpututil.IsKeyJustPressed(ebiten.KeyTab):
+		d.input, d.suggestions = d.console.Complete(d.input)
+	case inpututil.IsKeyJustPressed(ebiten.KeyUp):
+		d.edit(d.console.Previous())
+	case inpututil.IsKeyJustPressed(ebiten.KeyDown):
+		d.edit(d.console.Next())
+	case inpututil.IsKeyJustPressed(ebiten.KeyEscape):
+		d.open = false
+	}
+}
+
+func (d *DevConsole) edit(input string) {
+	d.input = input
+	d.suggestions = nil
+}
+
+func lastRune(s string) (rune, int) {
+	runes := []rune(s)
+	if len(runes) == 0 {
+		return 0, 0
+	}
+	r := runes[len(runes)-1]
+	return r, len(string(r))
+}
+
+//Draw drops the console down over the top of the screen, the latest output above the line being typed
+func (d *DevConsole) Draw(screen *ebiten.Image) error {
+	if !d.open || ebiten.IsDrawingSkipped() {
+		return nil
+	}
+
+	lines := d.console.Lines()
+	if len(lines) > consoleLines {
+		lines = lines[len(lines)-consoleLines:]
+	}
+	text := make([]string, 0, consoleLines+2)
+	for _, l := range lines {
+		prefix := ""
+		if l.Kind == console.Error {
+			prefix = "! "
+		}
+		text = append(text, prefix+l.Text)
+	}
+	for len(text) < consoleLines {
+		text = append([]string{""}, text...)
+	}
+	text = append(text, "> "+d.input+"_")
+	if len(d.suggestions) > 1 {
+		text = append(text, "  "+strings.Join(d.suggestions, "  "))
+	}
+
+	sw, _ := screen.Size()
+	height := float64(len(text)*16 + 4)
+	ebitenutil.DrawRect(screen, 0, 0, float64(sw), height, consoleBackground)
+	ebitenutil.DrawLine(screen, 0, height, float64(sw), height, consoleEdge)
+	return ebitenutil.DebugPrintAt(screen, strings.Join(text, "\n"), 4, 0)
+}
+
+//registerCommands adds the game's commands and subsystem reports to a console
+func (g *Game) registerCommands(c *console.Console) {
+	w := g.world
+
+	c.Register(console.Command{
+		Name:  "tp",
+		Usage: "x y",
+		Help:  "moves the first bunny to map tile x, y",
+		Run: func(c *console.Console, args []string) error {
+			p, err := g.firstPlayer()
+			if err != nil {
+				return err
+			}
+			x, y, err := tileArgs(args)
+			if err != nil {
+				return err
+			}
+			if x < 0 || y < 0 || x >= w.wMap.bgwidth || y >= w.wMap.bgheight {
+				return fmt.Errorf("%d, %d is off the %dx%d map", x, y, w.wMap.bgwidth, w.wMap.bgheight)
+			}
+			p.x, p.y = float64(x*TileSize+TileSize/2), float64(y*TileSize+TileSize/2)
+			c.Printf("moved to %d, %d", x, y)
+			return nil
+		},
+	})
+
+	c.Register(console.Command{
+		Name:  "time",
+		Usage: "[hh:mm]",
+		Help:  "sets the time of day, or prints it",
+		Run: func(c *console.Console, args []string) error {
+			if len(args) > 0 {
+				t, err := parseClock(args[0])
+				if err != nil {
+					return err
+				}
+				w.SetTime(t)
+			}
+			c.Printf("it's %s, %s", formatClock(w.Time()), dayOrNight(w.nightTime))
+			return nil
+		},
+	})
+
+	c.Register(console.Command{
+		Name:     "night",
+		Usage:    "on|off",
+		Help:     "jumps to midnight or midday",
+		Complete: func(args []string) []string { return []string{"on", "off"} },
+		Run: func(c *console.Console, args []string) error {
+			if len(args) != 1 || args[0] != "on" && args[0] != "off" {
+				return fmt.Errorf("usage: night on|off")
+			}
+			if args[0] == "on" {
+				w.SetTime(midnight)
+			} else {
+				w.SetTime(midday)
+			}
+			c.Printf("it's %s, %s", formatClock(w.Time()), dayOrNight(w.nightTime))
+			return nil
+		},
+	})
+
+	c.Register(console.Command{
+		Name:  "spawn",
+		Usage: "species [count]",
+		Help:  "spawns wildlife next to the first bunny",
+		Complete: func(args []string) []string {
+			names := make([]string, len(wildlife))
+			for i, s := range wildlife {
+				names[i] = s.name
+			}
+			return names
+		},
+		Run: func(c *console.Console, args []string) error {
+			if len(args) < 1 {
+				return fmt.Errorf("usage: spawn species [count]")
+			}
+			s := speciesNamed(args[0])
+			if s == nil {
+				return fmt.Errorf("no species called %s", args[0])
+			}
+			count, err := countArg(args, 1)
+			if err != nil {
+				return err
+			}
+			p, err := g.firstPlayer()
+			if err != nil {
+				return err
+			}
+			for i := 0; i < count; i++ {
+				if _, err := w.Spawn(s, p.x+float64(TileSize*(3+i)), p.y); err != nil {
+					return err
+				}
+			}
+			c.Printf("spawned %d %s", count, s.name)
+			return nil
+		},
+	})
+
+	c.Register(console.Command{
+		Name:  "seed",
+		Usage: "[seed]",
+		Help:  "generates a new map from the seed, or prints the map's seed",
+		Run: func(c *console.Console, args []string) error {
+			if len(args) > 0 {
+				if g.net != nil {
+					return fmt.Errorf("the server picks the seed of a multiplayer map")
+				}
+				seed, err := strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("seed must be a whole number, not %s", args[0])
+				}
+				w.Regenerate(seed)
+			}
+			c.Printf("seed %d", g.Seed)
+			return nil
+		},
+	})
+
+	c.Register(console.Command{
+		Name:     "give",
+		Usage:    "item [count]",
+		Help:     "gives the first bunny items, taking them away if count is negative",
+		Complete: func(args []string) []string { return itemKinds },
+		Run: func(c *console.Console, args []string) error {
+			if len(args) < 1 {
+				return fmt.Errorf("usage: give item [count]")
+			}
+			count, err := countArg(args, 1)
+			if err != nil {
+				return err
+			}
+			p, err := g.firstPlayer()
+			if err != nil {
+				return err
+			}
+			if err := p.Give(args[0], count); err != nil {
+				return err
+			}
+			c.Printf("carrying %d %s", p.Carrying(args[0]), args[0])
+			return nil
+		},
+	})
+
+	c.Register(console.Command{
+		Name:  "quest",
+		Usage: "[id [start|complete]]",
+		Help:  "starts or completes a quest, or prints how far along one or every quest is",
+		Complete: func(args []string) []string {
+			if len(args) == 0 {
+				var ids []string
+				for _, q := range g.quests.Quests() {
+					ids = append(ids, q.ID)
+				}
+				return ids
+			}
+			return []string{"start", "complete"}
+		},
+		Run: func(c *console.Console, args []string) error {
+			if g.quests == nil {
+				return fmt.Errorf("there are no quests in the editor")
+			}
+			if len(args) == 0 {
+				for _, q := range g.quests.Quests() {
+					c.Printf("%s: %s", q.ID, g.quests.State(q.ID))
+				}
+				return nil
+			}
+			q := g.quests.Quest(args[0])
+			if q == nil {
+				return fmt.Errorf("no quest called %s", args[0])
+			}
+			if len(args) > 1 {
+				var err error
+				switch args[1] {
+				case "start":
+					err = g.quests.Start(q.ID)
+				case "complete":
+					err = g.quests.Complete(q.ID)
+				default:
+					return fmt.Errorf("usage: quest [id [start|complete]]")
+				}
+				if err != nil {
+					return err
+				}
+			}
+			c.Printf("%s: %s", q.ID, g.quests.State(q.ID))
+			for i, o := range q.Objectives {
+				c.Printf("  %s %d/%d", o.Text, g.quests.Count(q.ID, i), o.Count)
+			}
+			return nil
+		},
+	})
+
+	c.Register(console.Command{
+		Name: "save",
+		Help: "saves the player's progress now, rather than waiting for the next to be made",
+		Run: func(c *console.Console, args []string) error {
+			if err := g.SaveProgress(); err != nil {
+				return err
+			}
+			c.Printf("saved to %s", g.savePath())
+			return nil
+		},
+	})
+
+	c.Register(console.Command{
+		Name:  "weather",
+		Usage: "[kind]",
+		Help:  "changes the weather, or prints it",
+		Complete: func(args []string) []string {
+			var kinds []string
+			for k := weather.Clear; k <= weather.Snow; k++ {
+				kinds = append(kinds, k.String())
+			}
+			return kinds
+		},
+		Run: func(c *console.Console, args []string) error {
+			if len(args) > 0 {
+				k, ok := weatherKind(args[0])
+				if !ok {
+					return fmt.Errorf("no weather called %s", args[0])
+				}
+				w.weather.Set(k)
+			}
+			c.Printf("%s in %s", w.weather.Kind(), w.weather.Season())
+			return nil
+		},
+	})
+
+	c.Register(console.Command{
+		Name:  "set",
+		Usage: "[setting [value]]",
+		Help:  "changes a setting and saves it, or prints one or all of them",
+		Complete: func(args []string) []string {
+			if len(args) == 0 {
+				return g.Keys()
+			}
+			// offers the setting's current value to edit, or either value of a switch
+			value, err := g.Get(args[0])
+			if err != nil {
+				return nil
+			}
+			if value == "true" || value == "false" {
+				return []string{"true", "false"}
+			}
+			return []string{value}
+		},
+		Run: func(c *console.Console, args []string) error {
+			if len(args) == 0 {
+				for _, key := range g.Keys() {
+					value, _ := g.Get(key)
+					c.Printf("%s = %s (%s)", key, value, g.Source(key))
+				}
+				return nil
+			}
+			if len(args) > 1 {
+				restart, err := g.ChangeSetting(args[0], strings.Join(args[1:], " "))
+				if err != nil {
+					return err
+				}
+				if restart {
+					c.Printf("saved, restart the game for it to take effect")
+				}
+			}
+			value, err := g.Get(args[0])
+			if err != nil {
+				return err
+			}
+			c.Printf("%s = %s", args[0], value)
+			return nil
+		},
+	})
+
+	c.Register(console.Command{
+		Name:  "exec",
+		Usage: "file",
+		Help:  "runs a script of commands, one a line",
+		Run: func(c *console.Console, args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("usage: exec file")
+			}
+			f, err := os.Open(args[0])
+			if err != nil {
+				return fmt.Errorf("unable to run %s: %v", args[0], err)
+			}
+			defer f.Close()
+			return c.RunScript(f, args[0])
+		},
+	})
+
+	c.AddReporter("camera", func() []console.Field {
+		return []console.Field{
+			console.F("x", g.cameraX), console.F("y", g.cameraY), console.F("zoom", fmt.Sprintf("%.2f", g.cameraZoom)),
+		}
+	})
+	c.AddReporter("world", func() []console.Field {
+		return []console.Field{
+			console.F("seed", g.Seed), console.F("time", formatClock(w.Time())), console.F("night", w.nightTime),
+			console.F("players", len(w.players)), console.F("remotes", len(w.remotes)),
+			console.F("creatures", len(w.creatures)), console.F("particles", w.particles.Len()),
+		}
+	})
+	c.AddReporter("quests", func() []console.Field {
+		counts := map[quest.State]int{}
+		for _, q := range g.quests.Quests() {
+			counts[g.quests.State(q.ID)]++
+		}
+		return []console.Field{
+			console.F("active", counts[quest.Active]), console.F("complete", counts[quest.Complete]),
+			console.F("available", counts[quest.Available]), console.F("locked", counts[quest.Locked]),
+			console.F("save", g.savePath()),
+		}
+	})
+	c.AddReporter("mods", func() []console.Field {
+		fields := []console.Field{console.F("dir", g.ModsDir), console.F("loaded", len(g.mods))}
+		for i, m := range g.mods {
+			fields = append(fields, console.F(fmt.Sprintf("%d", i+1), m.String()))
+		}
+		return fields
+	})
+	c.AddReporter("events", func() []console.Field {
+		fields := []console.Field{console.F("pending", g.events.Pending())}
+		for _, count := range g.events.Counts() {
+			fields = append(fields, console.F(count.Name, count.Delivered))
+		}
+		return fields
+	})
+	c.AddReporter("weather", func() []console.Field {
+		cond := w.conditions
+		return []console.Field{
+			console.F("kind", cond.Kind), console.F("season", cond.Season), console.F("wind", fmt.Sprintf("%.2f", cond.Wind)),
+			console.F("rain", fmt.Sprintf("%.2f", cond.Rain)), console.F("snow", fmt.Sprintf("%.2f", cond.Snow)),
+			console.F("fog", fmt.Sprintf("%.2f", cond.Fog)),
+		}
+	})
+	c.AddReporter("sound", func() []console.Field {
+		return []console.Field{
+			console.F("music", g.sound.Music()), console.F("ambience", g.sound.Ambience()),
+			console.F("volume", fmt.Sprintf("%.2f", g.sound.MasterVolume())),
+		}
+	})
+	c.AddReporter("player", func() []console.Field {
+		p, err := g.firstPlayer()
+		if err != nil {
+			return []console.Field{console.F("error", err)}
+		}
+		fields := []console.Field{
+			console.F("x", int(p.x)/TileSize), console.F("y", int(p.y)/TileSize), console.F("animation", p.animation.name),
+		}
+		for _, item := range itemKinds {
+			fields = append(fields, console.F(item, p.Carrying(item)))
+		}
+		return fields
+	})
+}
+
+//firstPlayer returns the first local bunny, which commands act on
+func (g *Game) firstPlayer() (*Player, error) {
+	if len(g.world.players) == 0 {
+		return nil, fmt.Errorf("there are no bunnies")
+	}
+	return g.world.players[0], nil
+}
+
+func tileArgs(args []string) (int, int, error) {
+	if len(args) != 2 {
+		return 0, 0, fmt.Errorf("expected x and y")
+	}
+	x, errX := strconv.Atoi(args[0])
+	y, errY := strconv.Atoi(args[1])
+	if errX != nil || errY != nil {
+		return 0, 0, fmt.Errorf("x and y must be whole numbers")
+	}
+	return x, y, nil
+}
+
+//countArg reads an optional count from args[i], 1 if it isn't given
+func countArg(args []string, i int) (int, error) {
+	if len(args) <= i {
+		return 1, nil
+	}
+	n, err := strconv.Atoi(args[i])
+	if err != nil {
+		return 0, fmt.Errorf("count must be a whole number, not %s", args[i])
+	}
+	return n, nil
+}
+
+func dayOrNight(night bool) string {
+	if night {
+		return "night"
+	}
+	return "day"
+}
+
+func weatherKind(name string) (weather.Kind, bool) {
+	for k := weather.Clear; k <= weather.Snow; k++ {
+		if k.String() == name {
+			return k, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,305 @@
+package game
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"strings"
+	"time"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil"
+	"github.com/hajimehoshi/ebiten/inpututil"
+	"github.com/tauraamui/berrybun/tileset"
+)
+
+//drawSubsystem is a part of the game whose draw calls are counted for the debug overlay
+type drawSubsystem int
+
+const (
+	drawMap drawSubsystem = iota
+	drawBuilding
+	drawAnimation
+	drawSubsystems
+)
+
+func (s drawSubsystem) String() string {
+	switch s {
+	case drawMap:
+		return "Map"
+	case drawBuilding:
+		return "Building"
+	case drawAnimation:
+		return "Animation"
+	}
+	return "unknown"
+}
+
+//drawCalls counts the draw calls each subsystem made this frame
+type drawCalls [drawSubsystems]int
+
+// hotkeys toggling the debug overlay's panels, F12 hiding or showing the whole overlay
+const (
+	debugToggleKey   = ebiten.KeyF12
+	debugCameraKey   = ebiten.KeyF1
+	debugTilesKey    = ebiten.KeyF2
+	debugEntitiesKey = ebiten.KeyF3
+	debugPerfKey     = ebiten.KeyF4
+)
+
+const (
+	// frames of history the frame time graph shows, and the frame time drawn as the top of the graph
+	debugGraphFrames = 120
+	debugGraphMax    = 50 * time.Millisecond
+	debugGraphHeight = 50
+	// width of the column of panel text on the right of the screen, debug text being 6 pixels a character
+	debugTextWidth = 42 * 6
+)
+
+var (
+	debugBackground  = color.RGBA{0x00, 0x00, 0x00, 0xa0}
+	debugCameraColor = color.RGBA{0xff, 0xff, 0x00, 0xff}
+	debugGridColor   = color.RGBA{0xff, 0xff, 0xff, 0x30}
+	debugCursorColor = color.RGBA{0x00, 0xff, 0xff, 0xff}
+	debugPlayerColor = color.RGBA{0x00, 0xff, 0x00, 0xff}
+	debugAnimalColor = color.RGBA{0xff, 0x80, 0x00, 0xff}
+	debugBuildColor  = color.RGBA{0xff, 0x00, 0xff, 0xff}
+	debugFrameColor  = color.RGBA{0x40, 0xff, 0x40, 0xff}
+	debugSlowColor   = color.RGBA{0xff, 0x40, 0x40, 0xff}
+	debugTargetColor = color.RGBA{0xff, 0xff, 0xff, 0x80}
+)
+
+//DebugOverlay draws what's going on under the hood over the game in debug mode, each panel toggled by its own hotkey
+type DebugOverlay struct {
+	game    *Game
+	visible bool
+	// which panels are showing
+	camera, tiles, entities, perf bool
+	// time between each of the last frames, oldest overwritten first
+	frameTimes [debugGraphFrames]time.Duration
+	frame      int
+	lastFrame  time.Time
+}
+
+func (d *DebugOverlay) Init() {
+	d.visible = true
+	d.perf = true
+	d.lastFrame = time.Now()
+}
+
+//Update toggles panels with their hotkeys and records how long the last frame took
+func (d *DebugOverlay) Update() {
+	toggles := map[ebiten.Key]*bool{
+		debugToggleKey:   &d.visible,
+		debugCameraKey:   &d.camera,
+		debugTilesKey:    &d.tiles,
+		debugEntitiesKey: &d.entities,
+		debugPerfKey:     &d.perf,
+	}
+	for key, panel := range toggles {
+		if inpututil.IsKeyJustPressed(key) {
+			*panel = !*panel
+		}
+	}
+
+	now := time.Now()
+	d.frameTimes[d.frame%debugGraphFrames] = now.Sub(d.lastFrame)
+	d.frame++
+	d.lastFrame = now
+}
+
+//Draw draws the showing panels over everything else, call it last
+func (d *DebugOverlay) Draw(screen *ebiten.Image) error {
+	if !d.visible || ebiten.IsDrawingSkipped() {
+		return nil
+	}
+
+	var text []string
+	if d.tiles {
+		text = append(text, d.drawTiles(screen)...)
+	}
+	if d.entities {
+		entities, err := d.drawEntities(screen)
+		if err != nil {
+			return err
+		}
+		text = append(text, entities...)
+	}
+	if d.camera {
+		text = append(text, d.drawCamera(screen)...)
+	}
+	if d.perf {
+		text = append(text, d.drawPerf(screen)...)
+	}
+	text = append(text, "F1 camera F2 tiles F3 entities F4 perf")
+
+	sw, _ := screen.Size()
+	x := sw - debugTextWidth - 4
+	ebitenutil.DrawRect(screen, float64(x-4), 0, float64(debugTextWidth+8), float64(len(text)*16+4), debugBackground)
+	return ebitenutil.DebugPrintAt(screen, strings.Join(text, "\n"), x, 0)
+}
+
+//drawCamera outlines the camera's view and the area it scales to the screen
+func (d *DebugOverlay) drawCamera(screen *ebiten.Image) []string {
+	g := d.game
+	view := g.visibleArea(screen)
+	x0, y0 := g.toScreen(screen, g.cameraX, g.cameraY)
+	x1, y1 := g.toScreen(screen, g.cameraX+g.cameraWidth, g.cameraY+g.cameraHeight)
+	drawOutline(screen, x0+1, y0+1, x1-1, y1-1, debugCameraColor)
+
+	return []string{
+		fmt.Sprintf("camera %d,%d zoom %.2f", g.cameraX, g.cameraY, g.cameraZoom),
+		fmt.Sprintf("camera size %dx%d", g.cameraWidth, g.cameraHeight),
+		fmt.Sprintf("view %d,%d to %d,%d", view.Min.X, view.Min.Y, view.Max.X, view.Max.Y),
+	}
+}
+
+//drawTiles draws the tile grid over the view and describes the tiles under the cursor
+func (d *DebugOverlay) drawTiles(screen *ebiten.Image) []string {
+	g := d.game
+	m := g.world.wMap
+	view := g.visibleArea(screen)
+	sw, sh := screen.Size()
+
+	for x := view.Min.X / TileSize * TileSize; x <= view.Max.X; x += TileSize {
+		sx, _ := g.toScreen(screen, x, 0)
+		ebitenutil.DrawLine(screen, sx, 0, sx, float64(sh), debugGridColor)
+	}
+	for y := view.Min.Y / TileSize * TileSize; y <= view.Max.Y; y += TileSize {
+		_, sy := g.toScreen(screen, 0, y)
+		ebitenutil.DrawLine(screen, 0, sy, float64(sw), sy, debugGridColor)
+	}
+
+	mx, my := ebiten.CursorPosition()
+	p := g.cursorTile(screen, mx, my)
+	x0, y0 := g.toScreen(screen, p.X*TileSize, p.Y*TileSize)
+	x1, y1 := g.toScreen(screen, (p.X+1)*TileSize, (p.Y+1)*TileSize)
+	drawOutline(screen, x0, y0, x1, y1, debugCursorColor)
+
+	text := []string{fmt.Sprintf("tile %d,%d", p.X, p.Y)}
+	for _, layer := range m.layers {
+		l, ok := layer.(*TileLayer)
+		if !ok {
+			continue
+		}
+		id := l.Tile(p.X, p.Y)
+		if id == tileset.NoTile {
+			continue
+		}
+		line := fmt.Sprintf(" %s: %d", l.Name, id)
+		if t := m.tileset.Tile(id); t != nil {
+			if drawn := m.frame(id); drawn != id {
+				line += fmt.Sprintf(" as %d", drawn)
+			}
+			if t.Terrain != "" {
+				line += " " + t.Terrain
+			}
+			if t.Solid {
+				line += " solid"
+			}
+		}
+		text = append(text, line)
+	}
+	return text
+}
+
+//drawEntities outlines the players, creatures and buildings, labelling each with its animation and frame
+func (d *DebugOverlay) drawEntities(screen *ebiten.Image) ([]string, error) {
+	g := d.game
+	w := g.world
+
+	outline := func(a *Animation, x, y float64, clr color.Color) error {
+		fw, fh := a.Size()
+		x0, y0 := g.toScreen(screen, int(x)-fw/2, int(y)-fh/2)
+		x1, y1 := g.toScreen(screen, int(x)+fw/2, int(y)+fh/2)
+		drawOutline(screen, x0, y0, x1, y1, clr)
+		return ebitenutil.DebugPrintAt(screen, fmt.Sprintf("%s %d", a.name, a.Frame()), int(x0), int(y1))
+	}
+
+	players := 0
+	for _, p := range w.players {
+		if err := outline(p.animation, p.x, p.y, debugPlayerColor); err != nil {
+			return nil, err
+		}
+		players++
+	}
+	for _, p := range w.remotes {
+		if err := outline(p.animation, p.x, p.y, debugPlayerColor); err != nil {
+			return nil, err
+		}
+		players++
+	}
+
+	view := g.visibleArea(screen)
+	shown, hidden := 0, 0
+	for _, c := range w.creatures {
+		if c.hidden {
+			hidden++
+			continue
+		}
+		if !image.Pt(int(c.x), int(c.y)).In(view) {
+			continue
+		}
+		if err := outline(c.animation, c.x, c.y, debugAnimalColor); err != nil {
+			return nil, err
+		}
+		shown++
+	}
+
+	for _, b := range w.wMap.buildings {
+		x0, y0 := g.toScreen(screen, b.x*TileSize, b.y*TileSize)
+		x1, y1 := g.toScreen(screen, (b.x+b.width)*TileSize, (b.y+b.height)*TileSize)
+		drawOutline(screen, x0, y0, x1, y1, debugBuildColor)
+	}
+
+	return []string{
+		fmt.Sprintf("players %d", players),
+		fmt.Sprintf("creatures %d, %d in view, %d hidden", len(w.creatures), shown, hidden),
+		fmt.Sprintf("particles %d", w.particles.Len()),
+	}, nil
+}
+
+//drawPerf draws the frame time graph under the panel text and counts the draw calls made this frame
+func (d *DebugOverlay) drawPerf(screen *ebiten.Image) []string {
+	sw, sh := screen.Size()
+	x0 := float64(sw - debugGraphFrames - 4)
+	y0 := float64(sh - debugGraphHeight - 4)
+	ebitenutil.DrawRect(screen, x0, y0, debugGraphFrames, debugGraphHeight, debugBackground)
+
+	// how long a frame takes at full speed
+	full := time.Second / time.Duration(ebiten.MaxTPS())
+
+	var worst, total time.Duration
+	for i := 0; i < debugGraphFrames; i++ {
+		// oldest on the left
+		t := d.frameTimes[(d.frame+i)%debugGraphFrames]
+		total += t
+		if t > worst {
+			worst = t
+		}
+		h := float64(debugGraphHeight) * float64(t) / float64(debugGraphMax)
+		if h > debugGraphHeight {
+			h = debugGraphHeight
+		}
+		clr := debugFrameColor
+		if t > full {
+			clr = debugSlowColor
+		}
+		ebitenutil.DrawRect(screen, x0+float64(i), y0+debugGraphHeight-h, 1, h, clr)
+	}
+	target := y0 + debugGraphHeight - float64(debugGraphHeight)*float64(full)/float64(debugGraphMax)
+	ebitenutil.DrawLine(screen, x0, target, x0+debugGraphFrames, target, debugTargetColor)
+
+	text := []string{
+		fmt.Sprintf("FPS %.1f TPS %.1f", ebiten.CurrentFPS(), ebiten.CurrentTPS()),
+		fmt.Sprintf("frame avg %.1fms worst %.1fms", ms(total/debugGraphFrames), ms(worst)),
+	}
+	for s := drawSubsystem(0); s < drawSubsystems; s++ {
+		text = append(text, fmt.Sprintf("%s draws %d", s, d.game.draws[s]))
+	}
+	return text
+}
+
+func ms(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
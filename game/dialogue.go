@@ -0,0 +1,426 @@
+package game
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/inpututil"
+	"github.com/tacusci/logging"
+	"github.com/tauraamui/berrybun/dialogue"
+	"github.com/tauraamui/berrybun/font"
+	"github.com/tauraamui/berrybun/gamepad"
+	"github.com/tauraamui/berrybun/ui"
+)
+
+const (
+	// node every conversation starts at
+	dialogueStart = "start"
+	// furthest a bunny can be from a creature to talk to it, in world pixels
+	talkDistance = 28
+	// characters of a line typed out each update
+	typewriterSpeed = 0.75
+	// most statements a script runs between lines before it's stopped, in case it's stuck in a loop
+	scriptSteps = 10000
+)
+
+//loadDialogue loads a dialogue script, the game can't run with its own broken. Problems which don't stop it running,
+//such as jumps to nodes it doesn't have, are logged, and it's parsed again whenever it's reloaded.
+func (g *Game) loadDialogue(name string) {
+	g.loadModdable(name, func(data []byte) error {
+		s, err := dialogue.Parse(bytes.NewReader(data), name)
+		if err != nil {
+			return err
+		}
+		for _, problem := range s.Validate(DialogueCommands(), DialogueFunctions()) {
+			logging.Error(problem.Error())
+		}
+		if g.dialogues == nil {
+			g.dialogues = map[string]*dialogue.Script{}
+		}
+		g.dialogues[name] = s
+		return nil
+	})
+}
+
+//DialogueCommands returns the names of the commands the game gives dialogue scripts
+func DialogueCommands() []string {
+	return names((&Conversation{}).commands())
+}
+
+//DialogueFunctions returns the names of the functions the game gives dialogue scripts
+func DialogueFunctions() []string {
+	return names((&Conversation{}).functions())
+}
+
+func names(m interface{}) []string {
+	var list []string
+	for _, key := range reflect.ValueOf(m).MapKeys() {
+		list = append(list, key.String())
+	}
+	sort.Strings(list)
+	return list
+}
+
+//Conversation is a bunny talking with a creature, or being told something by a script, shown in a box along the
+//bottom of the screen with each line typed out a character at a time
+type Conversation struct {
+	game   *Game
+	player *Player
+	with   *Creature
+	runner lines
+
+	ui      *ui.UI
+	speaker *ui.Label
+	text    *ui.Label
+	// characters of the line typed out so far, and whether there are options to pick between once it's typed
+	typed    float64
+	choosing bool
+	options  []ui.Widget
+}
+
+//lines are what a conversation shows, a dialogue script's runner or the lines a trigger zone's script said
+type lines interface {
+	Next() (dialogue.Event, error)
+	Choose(i int) error
+	Stop()
+}
+
+//updateTalking starts a conversation when a bunny next to a creature with something to say presses talk, or passes
+//the conversation input while one is going on
+func (g *Game) updateTalking(screen *ebiten.Image) {
+	if g.conversation != nil {
+		g.conversation.Update(screen)
+		return
+	}
+	if g.inputCaptured() {
+		return
+	}
+
+	for _, p := range g.world.players {
+		if !p.talkPressed() {
+			continue
+		}
+		c := g.world.nearestTalker(p)
+		if c == nil {
+			continue
+		}
+		if err := g.talk(p, c); err != nil {
+			logging.Error(err.Error())
+		}
+		return
+	}
+}
+
+//talkPressed returns whether the player pressed talk this update, E on the keyboard or A on their gamepad
+func (p *Player) talkPressed() bool {
+	if p.gamepad != nil && p.gamepad.Connected() && p.gamepad.JustPressed(gamepad.ButtonA) {
+		return true
+	}
+	return p.keyboard && inpututil.IsKeyJustPressed(ebiten.KeyE)
+}
+
+//nearestTalker returns the closest creature with something to say within talking distance of a bunny, nil if there
+//isn't one
+func (w *World) nearestTalker(p *Player) *Creature {
+	var nearest *Creature
+	best := float64(talkDistance)
+	for _, c := range w.creatures {
+		if c.hidden || c.species.dialogue == "" {
+			continue
+		}
+		if d := math.Hypot(c.x-p.x, c.y-p.y); d <= best {
+			nearest, best = c, d
+		}
+	}
+	return nearest
+}
+
+//talk starts a conversation between a bunny and a creature, from the start of its species' script
+func (g *Game) talk(p *Player, c *Creature) error {
+	script, ok := g.dialogues[c.species.dialogue]
+	if !ok {
+		return fmt.Errorf("no dialogue loaded for %s", c.species.name)
+	}
+	if err := g.converse(p, c, script, dialogueStart); err != nil {
+		return err
+	}
+	g.events.Publish(ConversationStarted{Player: p, With: c})
+	return nil
+}
+
+//converse starts a conversation between a bunny and a creature from a node of a script, shown until it ends
+func (g *Game) converse(p *Player, with *Creature, script *dialogue.Script, node string) error {
+	conv := &Conversation{game: g, player: p, with: with}
+	runner := dialogue.NewRunner(script, g.dialogueVars)
+	runner.Commands = conv.commands()
+	runner.Functions = conv.functions()
+	runner.MaxSteps = scriptSteps
+	if err := runner.Start(node); err != nil {
+		return err
+	}
+	conv.runner = runner
+	g.show(conv)
+	return nil
+}
+
+//show puts a conversation up on screen until it ends
+func (g *Game) show(conv *Conversation) {
+	conv.speaker = &ui.Label{}
+	conv.text = &ui.Label{Wrap: true, Typewriter: true}
+	conv.ui = ui.New(g.theme, &ui.Panel{Framed: true, MinWidth: 300})
+	conv.ui.Anchor = ui.Bottom
+	conv.ui.OnBack = conv.Close
+
+	g.conversation = conv
+	conv.next()
+}
+
+//position returns where in the world the conversation's happening, by the creature if it's with one
+func (c *Conversation) position() (float64, float64) {
+	if c.with != nil {
+		return c.with.x, c.with.y
+	}
+	return c.player.x, c.player.y
+}
+
+//next shows whatever the conversation does next, closing it once it ends
+func (c *Conversation) next() {
+	event, err := c.runner.Next()
+	if err != nil {
+		logging.Error(fmt.Sprintf("dialogue stopped: %v", err))
+		c.Close()
+		return
+	}
+
+	switch event := event.(type) {
+	case dialogue.Line:
+		c.speaker.Text = ""
+		if event.Speaker != "" {
+			c.speaker.Text = "[berry]" + event.Speaker
+		}
+		c.text.Text = event.Text
+		c.text.Shown = 0
+		c.typed = 0
+		c.choosing = false
+		c.options = nil
+	case dialogue.Options:
+		// the line before stays up while the player picks what to say to it
+		c.choosing = true
+		c.options = nil
+		for i, text := range event {
+			i := i
+			c.options = append(c.options, ui.NewButton(text, func() { c.choose(i) }))
+		}
+	case dialogue.End:
+		c.Close()
+		return
+	}
+	c.layout()
+}
+
+func (c *Conversation) choose(i int) {
+	if err := c.runner.Choose(i); err != nil {
+		logging.Error(err.Error())
+		c.Close()
+		return
+	}
+	c.next()
+}
+
+//layout fills the box with the speaker, their line and any options to pick between
+func (c *Conversation) layout() {
+	var widgets []ui.Widget
+	if c.speaker.Text != "" {
+		widgets = append(widgets, c.speaker)
+	}
+	widgets = append(widgets, c.text)
+	if c.typedOut() {
+		widgets = append(widgets, c.options...)
+	}
+	c.ui.Root.(*ui.Panel).Widgets = widgets
+}
+
+func (c *Conversation) typedOut() bool {
+	return c.text.Shown >= font.Len(c.text.Text)
+}
+
+//Update types out the line, skipping to the end of it or carrying on once it's typed when the player presses
+//activate, and lets them pick between options once they're shown
+func (c *Conversation) Update(screen *ebiten.Image) {
+	in := c.game.input.read(c.game.gamepads)
+	sw, sh := screen.Size()
+
+	if !c.typedOut() {
+		c.typed += typewriterSpeed
+		if in.Activate || in.Click {
+			c.typed = float64(font.Len(c.text.Text))
+		}
+		c.text.Shown = int(c.typed)
+		if c.typedOut() {
+			c.layout()
+		}
+		c.ui.Update(sw, sh, ui.Input{})
+		return
+	}
+
+	if c.choosing {
+		c.ui.Update(sw, sh, in)
+		return
+	}
+	c.ui.Update(sw, sh, ui.Input{})
+	switch {
+	case in.Activate || in.Click:
+		c.next()
+	case in.Back:
+		c.Close()
+	}
+}
+
+//Draw draws the conversation's box over the game
+func (c *Conversation) Draw(screen *ebiten.Image) error {
+	return c.ui.Draw(screen)
+}
+
+//Close ends the conversation
+func (c *Conversation) Close() {
+	c.runner.Stop()
+	if c.game.conversation == c {
+		c.game.conversation = nil
+	}
+	// whatever the conversation set is kept
+	c.game.unsaved = true
+}
+
+//commands are what dialogue scripts can do to the game, such as <<give berry 2>>
+func (c *Conversation) commands() map[string]dialogue.Command {
+	return map[string]dialogue.Command{
+		"give": func(args []string) error {
+			if len(args) < 1 {
+				return fmt.Errorf("expected an item and how many")
+			}
+			n, err := countArg(args, 1)
+			if err != nil {
+				return err
+			}
+			return c.player.Give(args[0], n)
+		},
+		"take": func(args []string) error {
+			if len(args) < 1 {
+				return fmt.Errorf("expected an item and how many")
+			}
+			n, err := countArg(args, 1)
+			if err != nil {
+				return err
+			}
+			return c.player.Give(args[0], -n)
+		},
+		"startquest": func(args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("expected a quest to start")
+			}
+			return c.game.quests.Start(args[0])
+		},
+		"play": func(args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("expected a sound to play")
+			}
+			x, y := c.position()
+			c.game.playAt(args[0], x, y)
+			return nil
+		},
+		// spawns a creature of a species at a map tile, beside the bunny if none is given
+		"spawn": func(args []string) error {
+			if len(args) != 1 && len(args) != 3 {
+				return fmt.Errorf("expected a species and optionally a tile")
+			}
+			s := speciesNamed(args[0])
+			if s == nil {
+				return fmt.Errorf("no species called %s", args[0])
+			}
+			x, y, err := c.placeArgs(args[1:])
+			if err != nil {
+				return err
+			}
+			_, err = c.game.world.Spawn(s, x, y)
+			return err
+		},
+		// plays an animation from the sprite atlas once at a map tile, over the bunny if none is given
+		"animate": func(args []string) error {
+			if len(args) != 1 && len(args) != 3 {
+				return fmt.Errorf("expected an animation and optionally a tile")
+			}
+			x, y, err := c.placeArgs(args[1:])
+			if err != nil {
+				return err
+			}
+			return c.game.world.PlayAnimation(args[0], x, y)
+		},
+		// sets the time of day, as hh:mm or day or night
+		"time": func(args []string) error {
+			if len(args) != 1 {
+				return fmt.Errorf("expected a time, hh:mm, day or night")
+			}
+			return c.game.world.SetClock(args[0])
+		},
+	}
+}
+
+//placeArgs returns the middle of the map tile given as x and y arguments, or beside the bunny if there aren't any
+func (c *Conversation) placeArgs(args []string) (float64, float64, error) {
+	if len(args) == 0 {
+		return c.player.x + TileSize, c.player.y, nil
+	}
+	var at [2]int
+	for i, arg := range args {
+		n, err := strconv.Atoi(arg)
+		if err != nil {
+			return 0, 0, fmt.Errorf("expected a tile, not %s", strings.Join(args, " "))
+		}
+		at[i] = n
+	}
+	return float64(at[0]*TileSize + TileSize/2), float64(at[1]*TileSize + TileSize/2), nil
+}
+
+//functions are what dialogue scripts can ask of the game, such as carrying("berry")
+func (c *Conversation) functions() map[string]dialogue.Function {
+	return map[string]dialogue.Function{
+		"carrying": func(args []dialogue.Value) (dialogue.Value, error) {
+			if len(args) != 1 {
+				return nil, fmt.Errorf("carrying takes an item")
+			}
+			return float64(c.player.Carrying(dialogue.String(args[0]))), nil
+		},
+		"night": func(args []dialogue.Value) (dialogue.Value, error) {
+			return c.game.world.nightTime, nil
+		},
+		// the hour of the day, 0 to 23
+		"hour": func(args []dialogue.Value) (dialogue.Value, error) {
+			return float64(c.game.world.Time() / time.Hour), nil
+		},
+		// the map tile the bunny's on
+		"player_x": func(args []dialogue.Value) (dialogue.Value, error) {
+			return float64(c.player.tile.X), nil
+		},
+		"player_y": func(args []dialogue.Value) (dialogue.Value, error) {
+			return float64(c.player.tile.Y), nil
+		},
+		// how far along a quest is, "locked", "available", "active" or "complete"
+		"quest": func(args []dialogue.Value) (dialogue.Value, error) {
+			if len(args) != 1 {
+				return nil, fmt.Errorf("quest takes a quest's id")
+			}
+			id := dialogue.String(args[0])
+			if c.game.quests.Quest(id) == nil {
+				return nil, fmt.Errorf("no quest called %s", id)
+			}
+			return c.game.quests.State(id).String(), nil
+		},
+	}
+}
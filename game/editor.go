@@ -0,0 +1,634 @@
+package game
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"time"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil"
+	"github.com/hajimehoshi/ebiten/inpututil"
+	"github.com/tacusci/logging"
+	"github.com/tauraamui/berrybun/tileset"
+	"github.com/tauraamui/berrybun/utils"
+)
+
+const (
+	// map file the editor saves to when the game wasn't given one
+	defaultMapPath = "world.json"
+	// how far the camera flies each update in world pixels at full zoom
+	editorCameraSpeed = 8
+	editorMinZoom     = 0.25
+	editorMaxZoom     = 4
+	// how many edits can be undone
+	editorHistory = 200
+	// gap in screen pixels between the palette and the edge of the screen
+	paletteMargin = 8
+)
+
+var (
+	editorCursorColor    = color.RGBA{0xff, 0xff, 0xff, 0xc0}
+	editorSelectionColor = color.RGBA{0xff, 0xd0, 0x40, 0xff}
+	paletteBackground    = color.RGBA{0x10, 0x10, 0x18, 0xd0}
+)
+
+//EditorTool is what clicking on the map does in the editor
+type EditorTool int
+
+const (
+	//ToolPaint stamps the palette selection wherever the mouse is dragged
+	ToolPaint EditorTool = iota
+	//ToolFill floods the connected area of matching tiles
+	ToolFill
+	//ToolRect fills a dragged out rectangle
+	ToolRect
+	//ToolBuilding places, moves and removes buildings
+	ToolBuilding
+)
+
+func (t EditorTool) String() string {
+	switch t {
+	case ToolPaint:
+		return "paint"
+	case ToolFill:
+		return "fill"
+	case ToolRect:
+		return "rect"
+	case ToolBuilding:
+		return "building"
+	}
+	return "unknown"
+}
+
+//tileChange is a single tile of a layer changed by an edit
+type tileChange struct {
+	layer  *TileLayer
+	x, y   int
+	before tileset.TileID
+	// filled in as the change is undone, ready to be redone
+	after tileset.TileID
+}
+
+//buildingChange is a building added, moved or removed by an edit, a nil before is an addition and a nil after a removal
+type buildingChange struct {
+	index         int
+	before, after *Building
+}
+
+//edit is every change made by one use of a tool, undone and redone as a whole
+type edit struct {
+	tiles     []tileChange
+	buildings []buildingChange
+	// positions already changed by the edit on each layer, so a stroke crossing itself keeps the first before
+	touched map[*TileLayer]map[image.Point]bool
+}
+
+func (e *edit) empty() bool {
+	return len(e.tiles) == 0 && len(e.buildings) == 0
+}
+
+//Editor lets the map be changed from within the game, flying a free camera over it
+type Editor struct {
+	game *Game
+	tool EditorTool
+	// index of the layer being edited within the map's layers
+	layer int
+	// block of tiles picked from the palette, in tiles of the spritesheet
+	selection      image.Rectangle
+	paletteVisible bool
+	// whether the current drag started on the palette
+	paletteDrag bool
+	// where the current drag started, in tiles of the map or the palette
+	dragStart image.Point
+	// edit being built by the current drag, nil between drags
+	current *edit
+	// building being dragged and where it was grabbed relative to its top left, in tiles
+	moving     int
+	moveOffset image.Point
+	undo       []*edit
+	redo       []*edit
+	status     string
+	statusTime time.Time
+}
+
+func (e *Editor) Init() {
+	e.selection = image.Rect(18, 9, 19, 10)
+	e.paletteVisible = true
+	e.moving = -1
+	if e.game.MapPath == "" {
+		e.game.MapPath = defaultMapPath
+	}
+	e.setStatus(fmt.Sprintf("editing %s", e.game.MapPath))
+}
+
+func (e *Editor) setStatus(status string) {
+	logging.Info(status)
+	e.status = status
+	e.statusTime = time.Now()
+}
+
+func (e *Editor) tileLayer() *TileLayer {
+	m := e.game.world.wMap
+	if e.layer < 0 || e.layer >= len(m.layers) {
+		return nil
+	}
+	l, _ := m.layers[e.layer].(*TileLayer)
+	return l
+}
+
+//Update flies the camera and applies the tools from this update's input, before the map is drawn
+func (e *Editor) Update(screen *ebiten.Image) error {
+	e.updateKeys()
+	e.updateCamera(screen)
+
+	mx, my := ebiten.CursorPosition()
+	if e.paletteVisible && (e.paletteDrag || image.Pt(mx, my).In(e.paletteArea(screen))) && e.current == nil {
+		e.updatePalette(screen, mx, my)
+		return nil
+	}
+
+	e.updateTools(screen, mx, my)
+	return nil
+}
+
+func (e *Editor) updateKeys() {
+	ctrl := ebiten.IsKeyPressed(ebiten.KeyControl)
+	shift := ebiten.IsKeyPressed(ebiten.KeyShift)
+
+	switch {
+	case ctrl && inpututil.IsKeyJustPressed(ebiten.KeyZ) && shift, ctrl && inpututil.IsKeyJustPressed(ebiten.KeyY):
+		e.Redo()
+	case ctrl && inpututil.IsKeyJustPressed(ebiten.KeyZ):
+		e.Undo()
+	case ctrl && inpututil.IsKeyJustPressed(ebiten.KeyS):
+		e.save()
+	case ctrl && inpututil.IsKeyJustPressed(ebiten.KeyO):
+		e.load()
+	}
+	if ctrl {
+		return
+	}
+
+	for key, tool := range map[ebiten.Key]EditorTool{ebiten.KeyP: ToolPaint, ebiten.KeyF: ToolFill, ebiten.KeyR: ToolRect, ebiten.KeyB: ToolBuilding} {
+		if inpututil.IsKeyJustPressed(key) {
+			e.tool = tool
+			e.setStatus(fmt.Sprintf("tool: %s", tool))
+		}
+	}
+
+	m := e.game.world.wMap
+	for i := 0; i < len(m.layers) && i < 9; i++ {
+		if inpututil.IsKeyJustPressed(ebiten.Key1 + ebiten.Key(i)) {
+			e.layer = i
+			e.setStatus(fmt.Sprintf("layer: %s", m.layers[i].Props().Name))
+		}
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyV) && e.layer < len(m.layers) {
+		props := m.layers[e.layer].Props()
+		props.Visible = !props.Visible
+		e.setStatus(fmt.Sprintf("layer %s visible: %t", props.Name, props.Visible))
+	}
+
+	if inpututil.IsKeyJustPressed(ebiten.KeyTab) {
+		e.paletteVisible = !e.paletteVisible
+	}
+}
+
+//updateCamera flies the camera with the arrow keys or WASD and zooms with the mouse wheel
+func (e *Editor) updateCamera(screen *ebiten.Image) {
+	g := e.game
+
+	if _, wy := ebiten.Wheel(); wy != 0 {
+		// zoom about the cursor so the tile under it stays put
+		mx, my := ebiten.CursorPosition()
+		before := e.game.toWorld(screen, mx, my)
+		g.cameraZoom = math.Max(editorMinZoom, math.Min(editorMaxZoom, g.cameraZoom*math.Pow(1.1, wy)))
+		after := e.game.toWorld(screen, mx, my)
+		g.cameraX += int(before.X - after.X)
+		g.cameraY += int(before.Y - after.Y)
+	}
+
+	if ebiten.IsKeyPressed(ebiten.KeyControl) {
+		return
+	}
+
+	speed := int(math.Ceil(editorCameraSpeed / g.cameraZoom))
+	if ebiten.IsKeyPressed(ebiten.KeyLeft) || ebiten.IsKeyPressed(ebiten.KeyA) {
+		g.cameraX -= speed
+	}
+	if ebiten.IsKeyPressed(ebiten.KeyRight) || ebiten.IsKeyPressed(ebiten.KeyD) {
+		g.cameraX += speed
+	}
+	if ebiten.IsKeyPressed(ebiten.KeyUp) || ebiten.IsKeyPressed(ebiten.KeyW) {
+		g.cameraY -= speed
+	}
+	if ebiten.IsKeyPressed(ebiten.KeyDown) || ebiten.IsKeyPressed(ebiten.KeyS) {
+		g.cameraY += speed
+	}
+}
+
+//paletteArea returns where on screen the palette is drawn
+func (e *Editor) paletteArea(screen *ebiten.Image) image.Rectangle {
+	sw, _ := screen.Size()
+	sx, sy := e.game.screenScale(screen)
+	pw, ph := e.game.world.wMap.bgSpriteSheet.Size()
+	w, h := int(float64(pw)*sx), int(float64(ph)*sy)
+	return image.Rect(sw-w-paletteMargin, paletteMargin, sw-paletteMargin, paletteMargin+h)
+}
+
+//paletteTile returns the tile of the spritesheet under a position on screen, clamped to the palette
+func (e *Editor) paletteTile(screen *ebiten.Image, x, y int) image.Point {
+	area := e.paletteArea(screen)
+	ts := e.game.world.wMap.tileset
+	tx := (x - area.Min.X) * ts.Columns / area.Dx()
+	ty := (y - area.Min.Y) * ts.Rows / area.Dy()
+	return image.Pt(utils.Max(0, utils.Min(ts.Columns-1, tx)), utils.Max(0, utils.Min(ts.Rows-1, ty)))
+}
+
+//updatePalette picks the block of tiles dragged out over the palette
+func (e *Editor) updatePalette(screen *ebiten.Image, mx, my int) {
+	p := e.paletteTile(screen, mx, my)
+
+	if inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft) {
+		e.paletteDrag = true
+		e.dragStart = p
+	}
+	if e.paletteDrag && ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) {
+		e.selection = tileRect(e.dragStart, p)
+	}
+	if inpututil.IsMouseButtonJustReleased(ebiten.MouseButtonLeft) {
+		e.paletteDrag = false
+	}
+}
+
+//tileRect returns the rectangle of tiles with a and b as opposite corners, including both
+func tileRect(a, b image.Point) image.Rectangle {
+	r := image.Rectangle{Min: a, Max: b}.Canon()
+	r.Max = r.Max.Add(image.Pt(1, 1))
+	return r
+}
+
+//selectedTile returns the tile of the palette selection to paint at x, y, repeating the selection across the map from origin
+func (e *Editor) selectedTile(origin image.Point, x, y int) tileset.TileID {
+	w, h := e.selection.Dx(), e.selection.Dy()
+	ox, oy := ((x-origin.X)%w+w)%w, ((y-origin.Y)%h+h)%h
+	return e.game.world.wMap.tileset.ID(e.selection.Min.X+ox, e.selection.Min.Y+oy)
+}
+
+//updateTools applies the current tool to the map under the mouse
+func (e *Editor) updateTools(screen *ebiten.Image, mx, my int) {
+	p := e.game.cursorTile(screen, mx, my)
+	left := inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft)
+	right := inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonRight)
+
+	if (left || right) && e.current == nil {
+		e.current = &edit{touched: map[*TileLayer]map[image.Point]bool{}}
+		e.dragStart = p
+		if e.tool == ToolBuilding {
+			e.grabBuilding(p, right)
+		}
+	}
+	if e.current == nil {
+		return
+	}
+
+	l := e.tileLayer()
+	erase := ebiten.IsMouseButtonPressed(ebiten.MouseButtonRight)
+	released := !ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft) && !ebiten.IsMouseButtonPressed(ebiten.MouseButtonRight)
+
+	switch e.tool {
+	case ToolPaint:
+		if l != nil && !released {
+			if erase {
+				e.setTile(l, p.X, p.Y, tileset.NoTile)
+			} else {
+				// stamp the whole selection with its top left under the cursor
+				for y := 0; y < e.selection.Dy(); y++ {
+					for x := 0; x < e.selection.Dx(); x++ {
+						e.setTile(l, p.X+x, p.Y+y, e.selectedTile(p, p.X+x, p.Y+y))
+					}
+				}
+			}
+		}
+	case ToolFill:
+		if l != nil && (left || right) {
+			e.fill(l, p, right)
+		}
+	case ToolRect:
+		if l != nil && (inpututil.IsMouseButtonJustReleased(ebiten.MouseButtonLeft) || inpututil.IsMouseButtonJustReleased(ebiten.MouseButtonRight)) {
+			r := tileRect(e.dragStart, p)
+			for y := r.Min.Y; y < r.Max.Y; y++ {
+				for x := r.Min.X; x < r.Max.X; x++ {
+					t := tileset.NoTile
+					if !inpututil.IsMouseButtonJustReleased(ebiten.MouseButtonRight) {
+						t = e.selectedTile(r.Min, x, y)
+					}
+					e.setTile(l, x, y, t)
+				}
+			}
+		}
+	case ToolBuilding:
+		if e.moving >= 0 {
+			e.moveBuilding(p)
+		}
+	}
+
+	if released {
+		e.commit()
+	}
+}
+
+//setTile changes a tile of the layer as part of the current edit
+func (e *Editor) setTile(l *TileLayer, x, y int, t tileset.TileID) {
+	before := l.Tile(x, y)
+	if x < 0 || y < 0 || x >= l.width || y >= l.height || before == t {
+		return
+	}
+
+	touched := e.current.touched[l]
+	if touched == nil {
+		touched = map[image.Point]bool{}
+		e.current.touched[l] = touched
+	}
+	if !touched[image.Pt(x, y)] {
+		touched[image.Pt(x, y)] = true
+		e.current.tiles = append(e.current.tiles, tileChange{layer: l, x: x, y: y, before: before})
+	}
+	l.SetTile(x, y, t)
+}
+
+//fill floods the tiles connected to p which match it with the selected tile, or erases them
+func (e *Editor) fill(l *TileLayer, p image.Point, erase bool) {
+	if p.X < 0 || p.Y < 0 || p.X >= l.width || p.Y >= l.height {
+		return
+	}
+
+	target := l.Tile(p.X, p.Y)
+	// flooding with what's already there would change nothing, so there's nothing to undo either
+	single := e.selection.Dx() == 1 && e.selection.Dy() == 1
+	if (erase && target == tileset.NoTile) || (!erase && single && e.selectedTile(p, p.X, p.Y) == target) {
+		return
+	}
+
+	visited := map[image.Point]bool{p: true}
+	queue := []image.Point{p}
+	for len(queue) > 0 {
+		c := queue[0]
+		queue = queue[1:]
+
+		t := tileset.NoTile
+		if !erase {
+			t = e.selectedTile(p, c.X, c.Y)
+		}
+		e.setTile(l, c.X, c.Y, t)
+
+		for _, d := range []image.Point{{0, -1}, {1, 0}, {0, 1}, {-1, 0}} {
+			n := c.Add(d)
+			if visited[n] || n.X < 0 || n.Y < 0 || n.X >= l.width || n.Y >= l.height {
+				continue
+			}
+			// compare against the fill's own changes as they're made, so only tiles matching beforehand are flooded
+			if e.current.touched[l][n] || l.Tile(n.X, n.Y) != target {
+				continue
+			}
+			visited[n] = true
+			queue = append(queue, n)
+		}
+	}
+}
+
+//buildingAt returns the index of the topmost building covering the map tile, -1 if there isn't one
+func (e *Editor) buildingAt(p image.Point) int {
+	buildings := e.game.world.wMap.buildings
+	for i := len(buildings) - 1; i >= 0; i-- {
+		b := buildings[i]
+		if p.In(image.Rect(b.x, b.y, b.x+b.width, b.y+b.height)) {
+			return i
+		}
+	}
+	return -1
+}
+
+//grabBuilding starts moving the building under the cursor, places a new one from the selection if there isn't one, or removes it
+func (e *Editor) grabBuilding(p image.Point, remove bool) {
+	m := e.game.world.wMap
+	i := e.buildingAt(p)
+
+	switch {
+	case remove && i >= 0:
+		e.applyBuilding(buildingChange{index: i, before: copyBuilding(m.buildings[i])}, true)
+	case remove:
+	case i >= 0:
+		e.moving = i
+		e.moveOffset = p.Sub(image.Pt(m.buildings[i].x, m.buildings[i].y))
+		b := copyBuilding(m.buildings[i])
+		e.current.buildings = append(e.current.buildings, buildingChange{index: i, before: b, after: b})
+	default:
+		b := m.newBuilding(p.X, p.Y, e.selection.Dx(), e.selection.Dy(), m.tileset.ID(e.selection.Min.X, e.selection.Min.Y))
+		e.applyBuilding(buildingChange{index: len(m.buildings), after: &b}, true)
+	}
+}
+
+//moveBuilding drags the grabbed building so it stays under the cursor where it was grabbed
+func (e *Editor) moveBuilding(p image.Point) {
+	b := &e.game.world.wMap.buildings[e.moving]
+	b.x, b.y = p.X-e.moveOffset.X, p.Y-e.moveOffset.Y
+
+	change := &e.current.buildings[len(e.current.buildings)-1]
+	change.after = copyBuilding(*b)
+}
+
+func copyBuilding(b Building) *Building {
+	return &b
+}
+
+//applyBuilding makes a building change to the map, recording it in the current edit if forward
+func (e *Editor) applyBuilding(c buildingChange, record bool) {
+	m := e.game.world.wMap
+	if record {
+		e.current.buildings = append(e.current.buildings, c)
+	}
+
+	switch {
+	case c.before == nil:
+		m.buildings = append(m.buildings[:c.index], append([]Building{*c.after}, m.buildings[c.index:]...)...)
+	case c.after == nil:
+		m.buildings = append(m.buildings[:c.index], m.buildings[c.index+1:]...)
+	default:
+		m.buildings[c.index] = *c.after
+	}
+}
+
+//commit finishes the current edit, making it the latest to undo
+func (e *Editor) commit() {
+	if e.current != nil && !e.current.empty() {
+		// moving a building back where it was isn't worth undoing
+		if len(e.current.tiles) > 0 || !e.unmovedBuilding(e.current) {
+			e.current.touched = nil
+			e.undo = append(e.undo, e.current)
+			if len(e.undo) > editorHistory {
+				e.undo = e.undo[len(e.undo)-editorHistory:]
+			}
+			e.redo = nil
+		}
+	}
+	e.current = nil
+	e.moving = -1
+}
+
+func (e *Editor) unmovedBuilding(ed *edit) bool {
+	for _, c := range ed.buildings {
+		if c.before == nil || c.after == nil || c.before.x != c.after.x || c.before.y != c.after.y {
+			return false
+		}
+	}
+	return true
+}
+
+//Undo reverts the latest edit
+func (e *Editor) Undo() {
+	if len(e.undo) == 0 {
+		e.setStatus("nothing to undo")
+		return
+	}
+
+	ed := e.undo[len(e.undo)-1]
+	e.undo = e.undo[:len(e.undo)-1]
+
+	for i := len(ed.tiles) - 1; i >= 0; i-- {
+		c := &ed.tiles[i]
+		c.after = c.layer.Tile(c.x, c.y)
+		c.layer.SetTile(c.x, c.y, c.before)
+	}
+	for i := len(ed.buildings) - 1; i >= 0; i-- {
+		c := ed.buildings[i]
+		e.applyBuilding(buildingChange{index: c.index, before: c.after, after: c.before}, false)
+	}
+
+	e.redo = append(e.redo, ed)
+	e.setStatus(fmt.Sprintf("undid %d changes", len(ed.tiles)+len(ed.buildings)))
+}
+
+//Redo makes the latest undone edit again
+func (e *Editor) Redo() {
+	if len(e.redo) == 0 {
+		e.setStatus("nothing to redo")
+		return
+	}
+
+	ed := e.redo[len(e.redo)-1]
+	e.redo = e.redo[:len(e.redo)-1]
+
+	for _, c := range ed.tiles {
+		c.layer.SetTile(c.x, c.y, c.after)
+	}
+	for _, c := range ed.buildings {
+		e.applyBuilding(c, false)
+	}
+
+	e.undo = append(e.undo, ed)
+	e.setStatus(fmt.Sprintf("redid %d changes", len(ed.tiles)+len(ed.buildings)))
+}
+
+func (e *Editor) save() {
+	if err := e.game.world.wMap.saveFile(e.game.MapPath); err != nil {
+		logging.Error(fmt.Sprintf("unable to save map to %s: %v", e.game.MapPath, err))
+		e.setStatus(fmt.Sprintf("save failed: %v", err))
+		return
+	}
+	e.setStatus(fmt.Sprintf("saved %s", e.game.MapPath))
+}
+
+//load throws away any unsaved changes, going back to the map as last saved
+func (e *Editor) load() {
+	if err := e.game.world.wMap.loadFile(e.game.MapPath); err != nil {
+		logging.Error(fmt.Sprintf("unable to load map from %s: %v", e.game.MapPath, err))
+		e.setStatus(fmt.Sprintf("load failed: %v", err))
+		return
+	}
+	e.game.world.repopulate()
+	e.undo, e.redo = nil, nil
+	if e.layer >= len(e.game.world.wMap.layers) {
+		e.layer = 0
+	}
+	e.setStatus(fmt.Sprintf("loaded %s", e.game.MapPath))
+}
+
+//Draw draws the editor over the map, the cursor, palette and status
+func (e *Editor) Draw(screen *ebiten.Image) error {
+	if ebiten.IsDrawingSkipped() {
+		return nil
+	}
+
+	mx, my := ebiten.CursorPosition()
+	overPalette := e.paletteVisible && image.Pt(mx, my).In(e.paletteArea(screen))
+
+	if !overPalette || e.current != nil {
+		// outline the tiles the tool would change
+		p := e.game.cursorTile(screen, mx, my)
+		r := image.Rect(p.X, p.Y, p.X+1, p.Y+1)
+		switch {
+		case e.tool == ToolRect && e.current != nil:
+			r = tileRect(e.dragStart, p)
+		case e.tool == ToolPaint:
+			r = image.Rect(p.X, p.Y, p.X+e.selection.Dx(), p.Y+e.selection.Dy())
+		case e.tool == ToolBuilding:
+			if i := e.buildingAt(p); i >= 0 {
+				b := e.game.world.wMap.buildings[i]
+				r = image.Rect(b.x, b.y, b.x+b.width, b.y+b.height)
+			}
+		}
+		x0, y0 := e.game.toScreen(screen, r.Min.X*TileSize, r.Min.Y*TileSize)
+		x1, y1 := e.game.toScreen(screen, r.Max.X*TileSize, r.Max.Y*TileSize)
+		drawOutline(screen, x0, y0, x1, y1, editorCursorColor)
+	}
+
+	if e.paletteVisible {
+		if err := e.drawPalette(screen); err != nil {
+			return err
+		}
+	}
+
+	layer := "none"
+	if e.layer < len(e.game.world.wMap.layers) {
+		layer = e.game.world.wMap.layers[e.layer].Props().Name
+	}
+	status := fmt.Sprintf("EDIT %s | tool: %s | layer %d: %s | undo %d redo %d", e.game.MapPath, e.tool, e.layer+1, layer, len(e.undo), len(e.redo))
+	if time.Since(e.statusTime) < 3*time.Second {
+		status += "\n" + e.status
+	}
+	return ebitenutil.DebugPrintAt(screen, status, 4, 16)
+}
+
+func (e *Editor) drawPalette(screen *ebiten.Image) error {
+	area := e.paletteArea(screen)
+	ebitenutil.DrawRect(screen, float64(area.Min.X-2), float64(area.Min.Y-2), float64(area.Dx()+4), float64(area.Dy()+4), paletteBackground)
+
+	sheet := e.game.world.wMap.bgSpriteSheet
+	pw, ph := sheet.Size()
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Scale(float64(area.Dx())/float64(pw), float64(area.Dy())/float64(ph))
+	op.GeoM.Translate(float64(area.Min.X), float64(area.Min.Y))
+	if err := screen.DrawImage(sheet, op); err != nil {
+		return err
+	}
+
+	ts := e.game.world.wMap.tileset
+	tw, th := float64(area.Dx())/float64(ts.Columns), float64(area.Dy())/float64(ts.Rows)
+	drawOutline(screen,
+		float64(area.Min.X)+float64(e.selection.Min.X)*tw, float64(area.Min.Y)+float64(e.selection.Min.Y)*th,
+		float64(area.Min.X)+float64(e.selection.Max.X)*tw, float64(area.Min.Y)+float64(e.selection.Max.Y)*th,
+		editorSelectionColor)
+
+	return nil
+}
+
+func drawOutline(screen *ebiten.Image, x0, y0, x1, y1 float64, clr color.Color) {
+	ebitenutil.DrawLine(screen, x0, y0, x1, y0, clr)
+	ebitenutil.DrawLine(screen, x1, y0, x1, y1, clr)
+	ebitenutil.DrawLine(screen, x1, y1, x0, y1, clr)
+	ebitenutil.DrawLine(screen, x0, y1, x0, y0, clr)
+}
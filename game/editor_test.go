@@ -0,0 +1,68 @@
+package game
+
+import (
+	"image"
+	"testing"
+
+	"github.com/tauraamui/berrybun/tileset"
+)
+
+//fillEditor returns an editor over a layer of grass with a square of dirt from 1,1 to 3,3, with grass selected
+func fillEditor() (*Editor, *TileLayer) {
+	l := NewTileLayer("ground", 6, 6)
+	for y := 0; y < 6; y++ {
+		for x := 0; x < 6; x++ {
+			l.SetTile(x, y, tile(18, 9))
+		}
+	}
+	for y := 1; y < 4; y++ {
+		for x := 1; x < 4; x++ {
+			l.SetTile(x, y, tile(22, 9))
+		}
+	}
+	e := &Editor{game: &Game{world: &World{wMap: &Map{tileset: mapTiles, layers: []Layer{l}}}}}
+	e.selection = image.Rect(18, 9, 19, 10)
+	return e, l
+}
+
+//fillOnce fills from p as one use of the tool
+func fillOnce(e *Editor, l *TileLayer, p image.Point, erase bool) {
+	e.current = &edit{touched: map[*TileLayer]map[image.Point]bool{}}
+	e.fill(l, p, erase)
+	e.commit()
+}
+
+func TestFill(t *testing.T) {
+	e, l := fillEditor()
+	fillOnce(e, l, image.Pt(2, 2), false)
+	for y := 0; y < 6; y++ {
+		for x := 0; x < 6; x++ {
+			if l.Tile(x, y) != tile(18, 9) {
+				t.Fatalf("tile %d,%d is %d after filling the dirt with grass", x, y, l.Tile(x, y))
+			}
+		}
+	}
+	if len(e.undo) != 1 || len(e.undo[0].tiles) != 9 {
+		t.Errorf("filling recorded %d edits, expected one changing the 9 dirt tiles", len(e.undo))
+	}
+}
+
+func TestFillMatchingTile(t *testing.T) {
+	e, l := fillEditor()
+	fillOnce(e, l, image.Pt(0, 0), false)
+	if len(e.undo) != 0 {
+		t.Errorf("filling grass with grass recorded an edit to undo")
+	}
+
+	e.selection = image.Rect(22, 9, 23, 10)
+	fillOnce(e, l, image.Pt(2, 2), false)
+	if len(e.undo) != 0 {
+		t.Errorf("filling dirt with dirt recorded an edit to undo")
+	}
+
+	fillOnce(e, l, image.Pt(2, 2), true)
+	fillOnce(e, l, image.Pt(2, 2), true)
+	if len(e.undo) != 1 || l.Tile(2, 2) != tileset.NoTile {
+		t.Errorf("erasing twice recorded %d edits, expected only the first", len(e.undo))
+	}
+}
@@ -0,0 +1,176 @@
+package game
+
+import (
+	"image"
+	"image/color"
+	"log"
+	"math"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/tauraamui/berrybun/atlas"
+	"github.com/tauraamui/berrybun/particles"
+)
+
+// most particles alive in the world at once
+const maxParticles = 8192
+
+//effects are the kinds of particle effect the world gives off
+type effects struct {
+	dust     *particles.Effect
+	sparkles *particles.Effect
+	rain     *particles.Effect
+	snow     *particles.Effect
+	petals   *particles.Effect
+	leaves   *particles.Effect
+}
+
+//newEffects creates the world's particle effects, drawn from the particle sprites in the world's atlas or the map's spritesheet
+func newEffects(w *World, mapSheet *ebiten.Image) *effects {
+	dot, rain, petal, sparkle := w.sprite("particles/dot"), w.sprite("particles/rain"), w.sprite("particles/petal"), w.sprite("particles/sparkle")
+
+	// an effect draws every particle from one image, so the particle sprites must all be packed onto one page
+	sheet := dot.Image
+	for _, s := range []atlas.Sprite{rain, petal, sparkle} {
+		if s.Image != sheet {
+			log.Fatal("particle sprites are split across pages of the sprite atlas")
+		}
+	}
+
+	return &effects{
+		// kicked up from under a bunny's feet as it lands each hop
+		dust: &particles.Effect{
+			Image:     sheet,
+			Frames:    []image.Rectangle{dot.Rect},
+			Burst:     5,
+			MinLife:   14,
+			MaxLife:   24,
+			MinSpeed:  0.3,
+			MaxSpeed:  0.8,
+			Direction: -math.Pi / 2,
+			Spread:    math.Pi / 2,
+			Size:      particles.Points(0, 0.4, 0.3, 0.7, 1, 0.9),
+			Color:     particles.Solid(color.RGBA{0xc8, 0xb4, 0x96, 0xff}),
+			Alpha:     particles.Points(0, 0.6, 1, 0),
+			Gravity:   0.02,
+			Drag:      0.08,
+			Wind:      0.02,
+		},
+		// burst from a berry bush as its berries are picked
+		sparkles: &particles.Effect{
+			Image:     sheet,
+			Frames:    []image.Rectangle{sparkle.Rect, dot.Rect},
+			Burst:     12,
+			MinLife:   20,
+			MaxLife:   40,
+			MinSpeed:  0.5,
+			MaxSpeed:  1.5,
+			Direction: -math.Pi / 2,
+			Spread:    math.Pi,
+			Size:      particles.Points(0, 0.2, 0.2, 0.8, 1, 0.3),
+			Color: particles.Blend(
+				color.RGBA{0xff, 0xff, 0xe0, 0xff},
+				color.RGBA{0xff, 0x70, 0xa0, 0xff},
+				color.RGBA{0xa0, 0x40, 0xc0, 0xff},
+			),
+			Alpha:   particles.Points(0, 1, 0.7, 1, 1, 0),
+			Gravity: 0.03,
+			Drag:    0.05,
+			Spin:    0.2,
+		},
+		// falls across the view while it rains, slanted by the wind
+		rain: &particles.Effect{
+			Image:     sheet,
+			Frames:    []image.Rectangle{rain.Rect},
+			Rate:      12,
+			MinLife:   30,
+			MaxLife:   45,
+			MinSpeed:  6,
+			MaxSpeed:  8,
+			Direction: math.Pi / 2,
+			Size:      particles.Constant(1),
+			Color:     particles.Solid(color.RGBA{0xa0, 0xc0, 0xff, 0xff}),
+			Alpha:     particles.Points(0, 0, 0.1, 0.7, 0.9, 0.7, 1, 0),
+			Gravity:   0.35,
+			Drag:      0.05,
+			Wind:      0.2,
+		},
+		// drifts down across the view while it snows
+		snow: &particles.Effect{
+			Image:     sheet,
+			Frames:    []image.Rectangle{dot.Rect},
+			Rate:      6,
+			MinLife:   120,
+			MaxLife:   200,
+			MinSpeed:  0.5,
+			MaxSpeed:  1,
+			Direction: math.Pi / 2,
+			Spread:    math.Pi / 8,
+			Size:      particles.Points(0, 0.3, 1, 0.5),
+			Color:     particles.Solid(color.RGBA{0xff, 0xff, 0xff, 0xff}),
+			Alpha:     particles.Points(0, 0, 0.1, 0.9, 0.9, 0.9, 1, 0),
+			Gravity:   0.02,
+			Drag:      0.02,
+			Wind:      0.05,
+			Spin:      0.02,
+		},
+		// drift on the breeze from flowers in spring, tumbling as they go
+		petals: &particles.Effect{
+			Image:     mapSheet,
+			Frames:    []image.Rectangle{mapTiles.Rect(tile(3, 13)), mapTiles.Rect(tile(4, 13))},
+			Rate:      0.3,
+			MinLife:   180,
+			MaxLife:   300,
+			MinSpeed:  0.2,
+			MaxSpeed:  0.5,
+			Direction: math.Pi / 4,
+			Spread:    math.Pi / 4,
+			Size:      particles.Points(0, 0, 0.1, 0.25, 1, 0.2),
+			Color:     particles.Solid(color.RGBA{0xff, 0xff, 0xff, 0xff}),
+			Alpha:     particles.Points(0, 1, 0.8, 1, 1, 0),
+			Gravity:   0.002,
+			Drag:      0.02,
+			Wind:      0.05,
+			Spin:      0.05,
+		},
+		// blow about in autumn as the trees turn
+		leaves: &particles.Effect{
+			Image:     sheet,
+			Frames:    []image.Rectangle{petal.Rect},
+			Rate:      0.4,
+			MinLife:   180,
+			MaxLife:   300,
+			MinSpeed:  0.2,
+			MaxSpeed:  0.6,
+			Direction: math.Pi / 3,
+			Spread:    math.Pi / 4,
+			Size:      particles.Points(0, 0, 0.1, 0.6, 1, 0.5),
+			Color: particles.Blend(
+				color.RGBA{0xd0, 0x80, 0x20, 0xff},
+				color.RGBA{0xa0, 0x50, 0x20, 0xff},
+			),
+			Alpha:   particles.Points(0, 1, 0.8, 1, 1, 0),
+			Gravity: 0.003,
+			Drag:    0.02,
+			Wind:    0.06,
+			Spin:    0.08,
+		},
+	}
+}
+
+//Sparkle bursts sparkles from world position x, y, such as a berry bush being harvested
+func (w *World) Sparkle(x, y float64) {
+	w.particles.Burst(w.effects.sparkles, x, y)
+}
+
+//drawParticles draws the world's particles through the camera, lit like everything else
+func (w *World) drawParticles(screen *ebiten.Image) {
+	if ebiten.IsDrawingSkipped() {
+		return
+	}
+
+	op := &ebiten.DrawImageOptions{}
+	w.game.applyCamera(op, screen)
+	w.applyLighting(&op.ColorM)
+
+	w.particles.Draw(screen, op, w.game.visibleArea(screen))
+}
@@ -0,0 +1,139 @@
+package game
+
+import (
+	"fmt"
+	"image"
+	"time"
+
+	"github.com/tacusci/logging"
+	"github.com/tauraamui/berrybun/gamepad"
+	"github.com/tauraamui/berrybun/quest"
+)
+
+//PlayerHopped is a bunny landing a hop at x, y
+type PlayerHopped struct {
+	Player *Player
+	X, Y   float64
+}
+
+//TileEntered is a bunny's feet moving onto a new map tile
+type TileEntered struct {
+	Player *Player
+	Tile   image.Point
+}
+
+//ItemPicked is a bunny picking up Count of an item at x, y, such as berries from a bush
+type ItemPicked struct {
+	Player *Player
+	Item   string
+	Count  int
+	X, Y   float64
+}
+
+//BuildingEntered is a bunny hopping up to or into a named building
+type BuildingEntered struct {
+	Player   *Player
+	Building string
+}
+
+//ConversationStarted is a bunny starting to talk with a creature
+type ConversationStarted struct {
+	Player *Player
+	With   *Creature
+}
+
+//TimeOfDayChanged is night falling or the sun rising
+type TimeOfDayChanged struct {
+	Time  time.Duration
+	Night bool
+}
+
+//GamepadConnected is a gamepad being plugged in, or found at startup
+type GamepadConnected struct {
+	Device *gamepad.Device
+}
+
+//GamepadDisconnected is a gamepad being unplugged
+type GamepadDisconnected struct {
+	Device *gamepad.Device
+}
+
+//QuestStarted is a quest becoming active
+type QuestStarted struct {
+	Quest *quest.Quest
+}
+
+//QuestCompleted is every objective of a quest being done
+type QuestCompleted struct {
+	Quest *quest.Quest
+}
+
+//subscribeEvents has each part of the game react to the events it cares about
+func (g *Game) subscribeEvents() {
+	w := g.world
+
+	// sound
+	g.events.Subscribe(func(e PlayerHopped) {
+		g.playAt(g.hopSound(e.X, e.Y), e.X, e.Y)
+	})
+	g.events.Subscribe(func(e ItemPicked) {
+		g.playAt("pickup", e.X, e.Y)
+	})
+	g.events.Subscribe(func(e QuestCompleted) {
+		if p, err := g.firstPlayer(); err == nil {
+			g.playAt("pickup", p.x, p.y)
+		}
+	})
+
+	// effects
+	g.events.Subscribe(func(e PlayerHopped) {
+		w.particles.Burst(w.effects.dust, e.X, e.Y+playerFeet)
+	})
+	g.events.Subscribe(func(e ItemPicked) {
+		w.Sparkle(e.X, e.Y)
+	})
+
+	// the world
+	g.events.Subscribe(func(e TileEntered) {
+		w.harvest(e.Player, e.Tile)
+		if b := w.wMap.buildingNear(e.Tile); b != nil && b.name != "" {
+			g.events.Publish(BuildingEntered{Player: e.Player, Building: b.name})
+		}
+	})
+	g.events.Subscribe(func(e TileEntered) {
+		g.crossTriggers(e.Player, e.Tile)
+	})
+	g.events.Subscribe(func(e GamepadConnected) {
+		w.AssignGamepad(e.Device)
+	})
+
+	// quests
+	g.events.Subscribe(func(e ItemPicked) {
+		g.recordQuest(quest.Collect, e.Item, e.Count)
+	})
+	g.events.Subscribe(func(e BuildingEntered) {
+		g.recordQuest(quest.Visit, e.Building, 1)
+	})
+	g.events.Subscribe(func(e ConversationStarted) {
+		g.recordQuest(quest.Talk, e.With.species.name, 1)
+	})
+
+	if logging.CurrentLoggingLevel == logging.DebugLevel {
+		g.events.Subscribe(func(e GamepadConnected) {
+			logging.Debug(fmt.Sprintf("gamepad connected: slot: %d, name: %s, guid: %s", e.Device.Slot(), e.Device.Name(), e.Device.GUID()))
+		})
+		g.events.Subscribe(func(e GamepadDisconnected) {
+			logging.Debug(fmt.Sprintf("gamepad disconnected: slot: %d, name: %s", e.Device.Slot(), e.Device.Name()))
+		})
+		g.events.Subscribe(func(e TimeOfDayChanged) {
+			logging.Debug(fmt.Sprintf("it's %s at %s", dayOrNight(e.Night), formatClock(e.Time)))
+		})
+	}
+}
+
+//updateEvents delivers the events deferred while updating, such as bunnies hopping
+func (g *Game) updateEvents() {
+	if err := g.events.Flush(); err != nil {
+		logging.Error(err.Error())
+	}
+}
@@ -2,144 +2,310 @@ package game
 
 import (
 	"fmt"
-	"sync"
+	"os"
+	"strings"
 
 	"github.com/tacusci/logging"
 
 	"github.com/hajimehoshi/ebiten"
 	"github.com/hajimehoshi/ebiten/ebitenutil"
-	"github.com/hajimehoshi/ebiten/inpututil"
+	"github.com/tauraamui/berrybun/assets"
+	"github.com/tauraamui/berrybun/config"
+	"github.com/tauraamui/berrybun/dialogue"
+	"github.com/tauraamui/berrybun/event"
+	"github.com/tauraamui/berrybun/gamepad"
+	"github.com/tauraamui/berrybun/mods"
+	"github.com/tauraamui/berrybun/netplay"
+	"github.com/tauraamui/berrybun/quest"
+	"github.com/tauraamui/berrybun/script"
+	"github.com/tauraamui/berrybun/sound"
+	"github.com/tauraamui/berrybun/ui"
 )
 
 const (
 	screenWidth  = 320
 	screenHeight = 240
-)
-
-type GamePadInput struct {
-	id   int
-	axes []float64
-}
 
-func (gpi *GamePadInput) update() {
-	for a := 0; a < len(gpi.axes); a++ {
-		v := ebiten.GamepadAxis(gpi.id, a)
-		gpi.axes[a] = v
-	}
-}
+	gamepadMappingsFile = "gamecontrollerdb.txt"
+)
 
+//Game is the whole game, run with the options in its config
 type Game struct {
-	mu            sync.Mutex
-	Debug         bool
-	Fullscreen    bool
-	AllowKeyboard bool
-	cameraX       int
-	cameraY       int
-	cameraWidth   int
-	cameraHeight  int
-	world         *World
-	gamepads      []GamePadInput
+	*config.Config
+	cameraX      int
+	cameraY      int
+	cameraWidth  int
+	cameraHeight int
+	cameraZoom   float64
+	world        *World
+	gamepads     *gamepad.Manager
+	net          *netplay.Client
+	editor       *Editor
+	sound        *sound.Manager
+	assets       *assets.Manager
+	reloaders    []*reloader
+	debug        *DebugOverlay
+	theme        *ui.Theme
+	// the assets without any mods, for when a mod's are broken
+	gameAssets *assets.Manager
+	// the settings menu, nil while it's closed
+	settings *ui.UI
+	input    uiInput
+	console  *DevConsole
+	// dialogue scripts by asset name, the variables they share, and the conversation going on, nil if there isn't one
+	dialogues    map[string]*dialogue.Script
+	dialogueVars dialogue.Variables
+	conversation *Conversation
+	// the Lua scripts trigger zones run, by asset name
+	scripts map[string]*script.Script
+	// the player's progress through their quests, shown in a tracker and journal
+	quests   *quest.Log
+	questHUD *QuestHUD
+	// whether progress has been made since it was last saved
+	unsaved bool
+	// mods loaded, in load order
+	mods []*mods.Mod
+	// gameplay events, such as bunnies hopping, passed to whatever reacts to them
+	events *event.Bus
+	// draw calls made so far this frame, by subsystem
+	draws drawCalls
 }
 
 func (g *Game) Init() {
 	g.cameraX = 0
 	g.cameraY = 0
-	g.cameraWidth = 800
-	g.cameraHeight = 450
+	g.cameraWidth = g.CameraWidth
+	g.cameraHeight = g.CameraHeight
+	g.cameraZoom = 1
+	g.events = event.New()
 	g.world = &World{
 		game: g,
-		player: &Player{
-			game: g,
-		},
-		nightTime: g.Debug,
 	}
-	g.world.Init()
-}
 
-//AddGamepad adds a gamepad struct to collection if doesn't already contain gamepad of same id
-func (g *Game) AddGamepad(gp GamePadInput) {
-	g.mu.Lock()
-	defer g.mu.Unlock()
-	gamepadAlreadyInList := false
-	for _, existingGamepad := range g.gamepads {
-		if existingGamepad.id == gp.id {
-			gamepadAlreadyInList = true
-			break
+	if g.Edit && g.Connect != "" {
+		logging.Info("the map editor only edits locally, not joining server")
+		g.Connect = ""
+	}
+
+	if g.Connect != "" {
+		client, err := netplay.Dial(g.Connect)
+		if err != nil {
+			logging.Error(fmt.Sprintf("unable to join server %s, playing offline: %v", g.Connect, err))
+		} else {
+			logging.Info(fmt.Sprintf("joined server %s as bunny %d", g.Connect, client.ID()))
+			g.net = client
+			// generate the same map as the server's, from the same seed at the same size
+			g.Seed = client.Seed()
+			bounds := client.Bounds()
+			g.MapWidth, g.MapHeight = int(bounds.Width)/TileSize, int(bounds.Height)/TileSize
 		}
 	}
-	if !gamepadAlreadyInList {
-		g.gamepads = append(g.gamepads, gp)
+
+	if g.Debug {
+		g.debug = &DebugOverlay{game: g}
+		g.debug.Init()
 	}
-}
 
-//DeleteGamepad finds gamepad of provided id and then deletes from collection
-func (g *Game) DeleteGamepad(gpid int) {
-	g.mu.Lock()
-	defer g.mu.Unlock()
+	g.initAssets()
+	g.theme = ui.DefaultTheme(g.loadFont())
+	g.world.Init()
+	g.initSound()
+	g.subscribeEvents()
 
-	deleteIndex := -1
-	for i := 0; i < len(g.gamepads); i++ {
-		if g.gamepads[i].id == gpid {
-			deleteIndex = i
-			break
-		}
+	if g.Edit {
+		g.editor = &Editor{game: g}
+		g.editor.Init()
+		return
 	}
-	if deleteIndex > 0 {
-		g.gamepads = append(g.gamepads[:deleteIndex], g.gamepads[:deleteIndex+1]...)
+
+	g.world.AddPlayer(g.AllowKeyboard)
+
+	g.dialogueVars = dialogue.Variables{}
+	g.loadTriggerScripts()
+	g.questHUD = &QuestHUD{game: g}
+	g.questHUD.Init()
+	g.loadQuests()
+	if err := g.loadProgress(); err != nil {
+		logging.Error(err.Error())
 	}
-}
+	g.quests.Unlock()
 
-func (g *Game) updateGamepads() {
-	// check for any disconnected gamepads and remove from game
-	for _, id := range inpututil.JustConnectedGamepadIDs() {
-		if logging.CurrentLoggingLevel == logging.DebugLevel {
-			logging.Debug(fmt.Sprintf("gamepad connected: id: %d", id))
-		}
-		gamepadAlreadyInList := false
-		for _, gp := range g.gamepads {
-			if gp.id == id {
-				gamepadAlreadyInList = true
-				break
-			}
-		}
-		if !gamepadAlreadyInList {
-			g.AddGamepad(GamePadInput{
-				id:   id,
-				axes: make([]float64, ebiten.GamepadAxisNum(id)),
-			})
+	g.gamepads = gamepad.NewManager(ebitenGamepads{})
+	g.gamepads.OnConnect = g.gamepadConnected
+	g.gamepads.OnDisconnect = g.gamepadDisconnected
+	g.loadGamepadMappings()
+
+	if g.Debug {
+		g.console = &DevConsole{game: g}
+		g.console.Init()
+		if err := g.console.RunScript(g.StartupScript); err != nil {
+			logging.Error(err.Error())
 		}
 	}
+}
 
-	// check for any connected gamepads and add them to the game
-	for i := 0; i < len(g.gamepads); i++ {
-		if inpututil.IsGamepadJustDisconnected(g.gamepads[i].id) {
-			if logging.CurrentLoggingLevel == logging.DebugLevel {
-				logging.Debug(fmt.Sprintf("gamepad disconnected: id: %d", g.gamepads[i].id))
-			}
-			g.DeleteGamepad(g.gamepads[i].id)
+//inputCaptured returns whether the developer console, a menu, a conversation or the journal is open, so the keyboard and gamepads
+//are used in it rather than playing
+func (g *Game) inputCaptured() bool {
+	return g.console != nil && g.console.Open() || g.settings != nil || g.conversation != nil ||
+		g.questHUD != nil && g.questHUD.JournalOpen()
+}
+
+//loadGamepadMappings applies any SDL GameControllerDB mappings found in the working directory or environment
+func (g *Game) loadGamepadMappings() {
+	if f, err := os.Open(gamepadMappingsFile); err == nil {
+		defer f.Close()
+		if err := g.gamepads.LoadMappings(f); err != nil {
+			logging.Error(fmt.Sprintf("unable to load every gamepad mapping from %s: %v", gamepadMappingsFile, err))
 		}
 	}
 
-	for i := 0; i < len(g.gamepads); i++ {
-		g.gamepads[i].update()
+	if env := os.Getenv("SDL_GAMECONTROLLERCONFIG"); env != "" {
+		if err := g.gamepads.LoadMappings(strings.NewReader(env)); err != nil {
+			logging.Error(fmt.Sprintf("unable to load every gamepad mapping from the environment: %v", err))
+		}
 	}
 }
 
+func (g *Game) gamepadConnected(d *gamepad.Device) {
+	g.events.Publish(GamepadConnected{Device: d})
+}
+
+func (g *Game) gamepadDisconnected(d *gamepad.Device) {
+	g.events.Publish(GamepadDisconnected{Device: d})
+}
+
 //Update updates everything within game state
 func (g *Game) Update(screen *ebiten.Image) error {
-	g.updateGamepads()
+	g.updateAssets()
+	g.draws = drawCalls{}
+	if g.debug != nil {
+		g.debug.Update()
+	}
+	// escape closing the console, ending a conversation or closing the journal doesn't open the settings as well
+	typing := g.console != nil && g.console.Open()
+	talking := g.conversation != nil
+	reading := g.questHUD != nil && g.questHUD.JournalOpen()
+	if g.console != nil {
+		g.console.Update()
+	}
+	if !typing && !talking && !reading && g.editor == nil {
+		g.updateSettings(screen)
+	}
+
+	if g.editor != nil {
+		if err := g.editor.Update(screen); err != nil {
+			return err
+		}
+	} else {
+		g.gamepads.Update()
+		if !typing && g.settings == nil {
+			g.updateTalking(screen)
+			g.questHUD.Update(screen)
+		}
+		g.updateNetwork()
+		g.updateCamera(screen)
+		if err := g.updateSound(screen); err != nil {
+			return err
+		}
+	}
 
 	if err := g.world.Update(screen); err != nil {
 		return err
 	}
+	g.updateEvents()
+
+	if g.editor != nil {
+		if err := g.editor.Draw(screen); err != nil {
+			return err
+		}
+	}
 
 	if err := ebitenutil.DebugPrint(screen, fmt.Sprintf("FPS: %0.2f", ebiten.CurrentFPS())); err != nil {
 		return nil
 	}
 
+	if g.questHUD != nil {
+		if err := g.questHUD.Draw(screen); err != nil {
+			return err
+		}
+	}
+
+	if g.conversation != nil {
+		if err := g.conversation.Draw(screen); err != nil {
+			return err
+		}
+	}
+
+	if g.settings != nil {
+		if err := g.settings.Draw(screen); err != nil {
+			return err
+		}
+	}
+
+	if g.debug != nil {
+		if err := g.debug.Draw(screen); err != nil {
+			return err
+		}
+	}
+
+	if g.console != nil {
+		if err := g.console.Draw(screen); err != nil {
+			return err
+		}
+	}
+
+	g.updateSave()
 	return nil
 
 	// if ebiten.IsDrawingSkipped() {
 	// 	return nil
 	// }
 }
+
+//updateNetwork falls back to playing offline if the server connection has been lost, otherwise syncs the other players' bunnies
+func (g *Game) updateNetwork() {
+	if g.net == nil {
+		return
+	}
+
+	if err := g.net.Err(); err != nil {
+		logging.Error(fmt.Sprintf("lost connection to server, playing offline: %v", err))
+		g.net = nil
+		g.world.RemoveRemotes()
+		return
+	}
+
+	g.world.SyncRemotes(g.net.Others())
+}
+
+//ebitenGamepads reads gamepads through ebiten
+type ebitenGamepads struct{}
+
+func (ebitenGamepads) IDs() []int {
+	return ebiten.GamepadIDs()
+}
+
+func (ebitenGamepads) GUID(id int) string {
+	return ebiten.GamepadSDLID(id)
+}
+
+func (ebitenGamepads) Name(id int) string {
+	return ebiten.GamepadName(id)
+}
+
+func (ebitenGamepads) AxisNum(id int) int {
+	return ebiten.GamepadAxisNum(id)
+}
+
+func (ebitenGamepads) Axis(id, axis int) float64 {
+	return ebiten.GamepadAxis(id, axis)
+}
+
+func (ebitenGamepads) ButtonNum(id int) int {
+	return ebiten.GamepadButtonNum(id)
+}
+
+func (ebitenGamepads) ButtonPressed(id, button int) bool {
+	return ebiten.IsGamepadButtonPressed(id, ebiten.GamepadButton(button))
+}
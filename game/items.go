@@ -0,0 +1,132 @@
+package game
+
+import (
+	"fmt"
+	"image"
+
+	"github.com/tacusci/logging"
+	"github.com/tauraamui/berrybun/tileset"
+)
+
+// how far below the bunny's centre its feet are, in world pixels
+const playerFeet = 12
+
+// ticks a picked berry bush takes to grow back on a clear summer day, sooner in spring, longer in other weather and
+// never in winter
+const berryRegrowTicks = 3 * 60 * 60
+
+//itemKinds are the things a bunny can carry
+var itemKinds = []string{"berry"}
+
+func isItem(kind string) bool {
+	for _, k := range itemKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+//Give adds n of an item to what the bunny is carrying, taking them away if n is negative but never below none
+func (p *Player) Give(item string, n int) error {
+	if !isItem(item) {
+		return fmt.Errorf("no item called %s", item)
+	}
+	if p.items == nil {
+		p.items = map[string]int{}
+	}
+	p.items[item] += n
+	if p.items[item] < 0 {
+		p.items[item] = 0
+	}
+	return nil
+}
+
+//Carrying returns how many of an item the bunny is carrying
+func (p *Player) Carrying(item string) int {
+	return p.items[item]
+}
+
+//isBerryBush returns whether a decoration tile is a bush a bunny can pick berries from
+func isBerryBush(t tileset.TileID) bool {
+	return t == tile(1, 13) || t == tile(2, 13)
+}
+
+//enterTile notes which map tile the bunny's feet are on, letting the rest of the game know when it hops onto a new one
+func (p *Player) enterTile() {
+	at := image.Pt(int(p.x)/TileSize, int(p.y+playerFeet)/TileSize)
+	if at == p.tile {
+		return
+	}
+	p.tile = at
+	p.game.events.Defer(TileEntered{Player: p, Tile: at})
+}
+
+//regrowth is a picked berry bush growing back
+type regrowth struct {
+	at   image.Point
+	bush tileset.TileID
+	// how far it's grown back, fully at berryRegrowTicks
+	grown float64
+}
+
+//harvest picks the berries from a bush a bunny's standing in, leaving the bare ground behind until it grows back
+func (w *World) harvest(p *Player, at image.Point) {
+	decoration := w.wMap.tileLayer("decoration")
+	if decoration == nil || !isBerryBush(decoration.Tile(at.X, at.Y)) {
+		return
+	}
+	w.wMap.regrowing = append(w.wMap.regrowing, regrowth{at: at, bush: decoration.Tile(at.X, at.Y)})
+	decoration.SetTile(at.X, at.Y, tileset.NoTile)
+	if err := p.Give("berry", 1); err != nil {
+		logging.Error(err.Error())
+		return
+	}
+	x, y := float64(at.X*TileSize+TileSize/2), float64(at.Y*TileSize+TileSize/2)
+	w.game.events.Publish(ItemPicked{Player: p, Item: "berry", Count: 1, X: x, Y: y})
+}
+
+//regrowBushes grows picked berry bushes back a tick, as fast as the weather and season let plants grow
+func (w *World) regrowBushes() {
+	m := w.wMap
+	if len(m.regrowing) == 0 {
+		return
+	}
+
+	decoration := m.tileLayer("decoration")
+	rate := w.GrowthRate()
+	growing := m.regrowing[:0]
+	for _, r := range m.regrowing {
+		r.grown += rate
+		if r.grown < berryRegrowTicks {
+			growing = append(growing, r)
+			continue
+		}
+		// anything else that's ended up there in the meantime stays
+		if decoration != nil && decoration.Tile(r.at.X, r.at.Y) == tileset.NoTile {
+			decoration.SetTile(r.at.X, r.at.Y, r.bush)
+		}
+	}
+	m.regrowing = growing
+}
+
+//tileLayer returns the map's tile layer with the name, nil if it has none
+func (m *Map) tileLayer(name string) *TileLayer {
+	for _, layer := range m.layers {
+		if l, ok := layer.(*TileLayer); ok && l.Name == name {
+			return l
+		}
+	}
+	return nil
+}
+
+//buildingNear returns the building covering a map tile or right beside it, nil if there isn't one
+func (m *Map) buildingNear(at image.Point) *Building {
+	for i := range m.buildings {
+		b := &m.buildings[i]
+		if at.In(image.Rect(b.x, b.y, b.x+b.width, b.y+b.height).Inset(-1)) {
+			return b
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package game
+
+import (
+	"image"
+	"testing"
+
+	"github.com/tauraamui/berrybun/event"
+	"github.com/tauraamui/berrybun/tileset"
+)
+
+//bushWorld returns a world whose map is nothing but a berry bush at 1,1, growing as fast as growth
+func bushWorld(growth float64) (*World, *TileLayer) {
+	decoration := NewTileLayer("decoration", 4, 4)
+	decoration.SetTile(1, 1, tile(2, 13))
+	w := &World{game: &Game{events: event.New()}, wMap: &Map{layers: []Layer{decoration}}}
+	w.conditions.Growth = growth
+	return w, decoration
+}
+
+func TestHarvest(t *testing.T) {
+	w, decoration := bushWorld(1)
+	p := &Player{}
+
+	w.harvest(p, image.Pt(0, 0))
+	if p.Carrying("berry") != 0 {
+		t.Errorf("picked berries from bare ground")
+	}
+	w.harvest(p, image.Pt(1, 1))
+	if p.Carrying("berry") != 1 || decoration.Tile(1, 1) != tileset.NoTile {
+		t.Errorf("carrying %d berries with tile %d left, expected one berry and the bush bare", p.Carrying("berry"), decoration.Tile(1, 1))
+	}
+	w.harvest(p, image.Pt(1, 1))
+	if p.Carrying("berry") != 1 {
+		t.Errorf("picked a bare bush again")
+	}
+}
+
+func TestBushRegrows(t *testing.T) {
+	tests := []struct {
+		season string
+		growth float64
+		// ticks before the bush is back, 0 for never
+		ticks int
+	}{
+		{"spring", 1.5, berryRegrowTicks * 2 / 3},
+		{"summer", 1, berryRegrowTicks},
+		{"autumn", 0.5, berryRegrowTicks * 2},
+		{"winter", 0, 0},
+	}
+	for _, test := range tests {
+		w, decoration := bushWorld(test.growth)
+		w.harvest(&Player{}, image.Pt(1, 1))
+
+		ticks := 0
+		for decoration.Tile(1, 1) == tileset.NoTile && ticks < berryRegrowTicks*3 {
+			w.regrowBushes()
+			ticks++
+		}
+		switch {
+		case test.ticks == 0 && ticks < berryRegrowTicks*3:
+			t.Errorf("%s: bush grew back after %d ticks, expected it not to grow", test.season, ticks)
+		case test.ticks > 0 && ticks != test.ticks:
+			t.Errorf("%s: bush grew back after %d ticks, expected %d", test.season, ticks, test.ticks)
+		case test.ticks > 0 && decoration.Tile(1, 1) != tile(2, 13):
+			t.Errorf("%s: tile %d grew back, expected the bush that was picked", test.season, decoration.Tile(1, 1))
+		case test.ticks > 0 && len(w.wMap.regrowing) != 0:
+			t.Errorf("%s: bush still growing once it's back", test.season)
+		}
+	}
+}
+
+func TestRegrowingLeavesWhatsThere(t *testing.T) {
+	w, decoration := bushWorld(1)
+	w.harvest(&Player{}, image.Pt(1, 1))
+	decoration.SetTile(1, 1, tile(3, 13))
+	for i := 0; i < berryRegrowTicks; i++ {
+		w.regrowBushes()
+	}
+	if decoration.Tile(1, 1) != tile(3, 13) {
+		t.Errorf("bush grew back over the flower put where it was picked")
+	}
+}
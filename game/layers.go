@@ -0,0 +1,171 @@
+package game
+
+import (
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/tauraamui/berrybun/tileset"
+	"github.com/tauraamui/berrybun/utils"
+)
+
+//LayerProperties are the settings shared by every kind of map layer
+type LayerProperties struct {
+	Name    string
+	Opacity float64
+	// how far the layer scrolls relative to the camera, less than 1 is further away and more than 1 is closer
+	ParallaxX float64
+	ParallaxY float64
+	Tint      color.RGBA
+	Visible   bool
+	// whether the layer is drawn over the players and buildings, such as tree canopies
+	AboveEntities bool
+}
+
+func newLayerProperties(name string) LayerProperties {
+	return LayerProperties{
+		Name:      name,
+		Opacity:   1,
+		ParallaxX: 1,
+		ParallaxY: 1,
+		Tint:      color.RGBA{0xff, 0xff, 0xff, 0xff},
+		Visible:   true,
+	}
+}
+
+//Props returns the layer's properties so they can be changed
+func (lp *LayerProperties) Props() *LayerProperties {
+	return lp
+}
+
+//applyColor tints and fades the draw options by the layer's properties
+func (lp *LayerProperties) applyColor(op *ebiten.DrawImageOptions) {
+	op.ColorM.Scale(float64(lp.Tint.R)/0xff, float64(lp.Tint.G)/0xff, float64(lp.Tint.B)/0xff, lp.Opacity)
+}
+
+//Layer is one of the ordered layers a map is drawn from
+type Layer interface {
+	Props() *LayerProperties
+	Draw(screen *ebiten.Image, m *Map) error
+}
+
+//TileLayer is a grid of tiles from the map's spritesheet
+type TileLayer struct {
+	LayerProperties
+	width  int
+	height int
+	tiles  [][]tileset.TileID
+	// prerendered blocks of tiles, created as they come into view
+	chunks [][]*chunk
+	// chunks which currently have an image
+	rendered []*chunk
+	// how many times the layer has been drawn, for expiring chunks out of view
+	draws int
+}
+
+//NewTileLayer creates an empty tile layer of the provided size in tiles
+func NewTileLayer(name string, width, height int) *TileLayer {
+	l := &TileLayer{
+		LayerProperties: newLayerProperties(name),
+		width:           width,
+		height:          height,
+		tiles:           make([][]tileset.TileID, height),
+	}
+	for y := range l.tiles {
+		l.tiles[y] = make([]tileset.TileID, width)
+		for x := range l.tiles[y] {
+			l.tiles[y][x] = tileset.NoTile
+		}
+	}
+	return l
+}
+
+//Tile returns the tile at x, y, tileset.NoTile if it's empty or out of bounds
+func (l *TileLayer) Tile(x, y int) tileset.TileID {
+	if x < 0 || y < 0 || x >= l.width || y >= l.height {
+		return tileset.NoTile
+	}
+	return l.tiles[y][x]
+}
+
+//SetTile sets the tile at x, y, ignoring positions out of bounds
+func (l *TileLayer) SetTile(x, y int, tile tileset.TileID) {
+	if x < 0 || y < 0 || x >= l.width || y >= l.height {
+		return
+	}
+	l.tiles[y][x] = tile
+	l.invalidate(x, y)
+}
+
+func (l *TileLayer) Draw(screen *ebiten.Image, m *Map) error {
+	l.draws++
+
+	// only visit the chunks within the camera's view
+	area := m.game.visibleParallaxArea(screen, l.ParallaxX, l.ParallaxY)
+	chunkPixels := chunkSize * TileSize
+	minX, minY := utils.Max(0, area.Min.X/chunkPixels), utils.Max(0, area.Min.Y/chunkPixels)
+	maxX := utils.Min((l.width+chunkSize-1)/chunkSize, area.Max.X/chunkPixels+1)
+	maxY := utils.Min((l.height+chunkSize-1)/chunkSize, area.Max.Y/chunkPixels+1)
+
+	for cy := minY; cy < maxY; cy++ {
+		for cx := minX; cx < maxX; cx++ {
+			c := l.chunkAt(cx, cy)
+			if err := c.render(l, m); err != nil {
+				return err
+			}
+			c.lastDrawn = l.draws
+			if c.empty {
+				continue
+			}
+
+			// set rendering location on screen
+			op := &ebiten.DrawImageOptions{}
+			op.GeoM.Translate(float64(cx*chunkPixels), float64(cy*chunkPixels))
+			m.game.applyParallaxCamera(op, screen, l.ParallaxX, l.ParallaxY)
+
+			l.applyColor(op)
+
+			m.game.world.applyLighting(&op.ColorM)
+
+			if err := screen.DrawImage(c.image, op); err != nil {
+				return err
+			}
+			m.game.draws[drawMap]++
+		}
+	}
+
+	l.evictChunks()
+
+	return nil
+}
+
+//ImageLayer is a single image placed in the world, such as a backdrop or a large shadow
+type ImageLayer struct {
+	LayerProperties
+	image *ebiten.Image
+	// position of the image's top left corner in world pixels
+	x float64
+	y float64
+}
+
+//NewImageLayer creates a layer drawing the image with its top left corner at world position x, y
+func NewImageLayer(name string, img *ebiten.Image, x, y float64) *ImageLayer {
+	return &ImageLayer{
+		LayerProperties: newLayerProperties(name),
+		image:           img,
+		x:               x,
+		y:               y,
+	}
+}
+
+func (l *ImageLayer) Draw(screen *ebiten.Image, m *Map) error {
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Translate(l.x, l.y)
+	m.game.applyParallaxCamera(op, screen, l.ParallaxX, l.ParallaxY)
+
+	l.applyColor(op)
+
+	m.game.world.applyLighting(&op.ColorM)
+
+	m.game.draws[drawMap]++
+	return screen.DrawImage(l.image, op)
+}
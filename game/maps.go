@@ -0,0 +1,174 @@
+package game
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/tacusci/logging"
+	"github.com/tauraamui/berrybun/mapfile"
+	"github.com/tauraamui/berrybun/pathfind"
+	"github.com/tauraamui/berrybun/tileset"
+)
+
+//loadFile replaces the map with the one saved at path
+func (m *Map) loadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	logging.Info(fmt.Sprintf("loading map from %s", path))
+
+	return m.Load(f)
+}
+
+//loadAsset loads the map from an asset, such as one a mod adds
+func (m *Map) loadAsset(name string) error {
+	b, err := m.game.assets.Bytes(name)
+	if err != nil {
+		return err
+	}
+	defer b.Release()
+
+	logging.Info(fmt.Sprintf("loading map %s from %s", name, b.Source()))
+
+	return m.Load(bytes.NewReader(b.Data()))
+}
+
+//saveFile writes the map to path, going through a temporary file so a failed save never leaves half a map behind
+func (m *Map) saveFile(path string) error {
+	tmp, err := os.Create(filepath.Join(filepath.Dir(path), "."+filepath.Base(path)+".tmp"))
+	if err != nil {
+		return err
+	}
+
+	if err := m.Save(tmp); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+//Load replaces the map's layers and buildings with those read from a map file, forgetting every path found over the
+//old one. Whatever's living on the world needs repopulating after.
+func (m *Map) Load(r io.Reader) error {
+	f, err := mapfile.Load(r)
+	if err != nil {
+		return err
+	}
+
+	if f.Tileset != m.tileset.Image {
+		return fmt.Errorf("map uses tileset %s, only %s is available", f.Tileset, m.tileset.Image)
+	}
+
+	layers := make([]Layer, 0, len(f.Layers))
+	for _, fl := range f.Layers {
+		l := NewTileLayer(fl.Name, f.Width, f.Height)
+		l.Opacity = fl.Opacity
+		l.ParallaxX, l.ParallaxY = fl.ParallaxX, fl.ParallaxY
+		l.Tint = fl.Tint
+		l.Visible = fl.Visible
+		l.AboveEntities = fl.AboveEntities
+		for i, t := range fl.Tiles {
+			if t != tileset.NoTile && m.tileset.Tile(t) == nil {
+				return fmt.Errorf("layer %s has unknown tile %d", fl.Name, t)
+			}
+			l.tiles[i/f.Width][i%f.Width] = t
+		}
+		layers = append(layers, l)
+	}
+
+	buildings := make([]Building, 0, len(f.Buildings))
+	for _, fb := range f.Buildings {
+		if m.tileset.Tile(fb.Tile) == nil {
+			return fmt.Errorf("building at %d,%d has unknown tile %d", fb.X, fb.Y, fb.Tile)
+		}
+		b := m.newBuilding(fb.X, fb.Y, fb.Width, fb.Height, fb.Tile)
+		b.name = fb.Name
+		buildings = append(buildings, b)
+	}
+
+	triggers := make([]*Trigger, 0, len(f.Triggers))
+	for _, ft := range f.Triggers {
+		area := image.Rect(ft.X, ft.Y, ft.X+ft.Width, ft.Y+ft.Height)
+		triggers = append(triggers, newTrigger(area, ft.Script, ft.Enter, ft.Exit))
+	}
+
+	m.bgwidth, m.bgheight = f.Width, f.Height
+	m.layers = layers
+	m.regrowing = nil
+	m.buildings = buildings
+	m.triggers = triggers
+	m.paths = pathfind.NewPlanner()
+	m.game.Seed = f.Seed
+
+	return nil
+}
+
+//Save writes the map's tile layers and buildings as a map file
+func (m *Map) Save(w io.Writer) error {
+	f := &mapfile.File{
+		Width:   m.bgwidth,
+		Height:  m.bgheight,
+		Seed:    m.game.Seed,
+		Tileset: m.tileset.Image,
+	}
+
+	for _, layer := range m.layers {
+		// only tile layers are part of the map, image layers come from elsewhere
+		l, ok := layer.(*TileLayer)
+		if !ok {
+			continue
+		}
+
+		fl := mapfile.Layer{
+			Name:          l.Name,
+			Opacity:       l.Opacity,
+			ParallaxX:     l.ParallaxX,
+			ParallaxY:     l.ParallaxY,
+			Tint:          l.Tint,
+			Visible:       l.Visible,
+			AboveEntities: l.AboveEntities,
+		}
+		for _, row := range l.tiles {
+			fl.Tiles = append(fl.Tiles, row...)
+		}
+		f.Layers = append(f.Layers, fl)
+	}
+
+	for _, b := range m.buildings {
+		f.Buildings = append(f.Buildings, mapfile.Building{
+			X:      b.x,
+			Y:      b.y,
+			Width:  b.width,
+			Height: b.height,
+			Tile:   b.tile,
+			Name:   b.name,
+		})
+	}
+
+	for _, t := range m.triggers {
+		f.Triggers = append(f.Triggers, mapfile.Trigger{
+			X:      t.area.Min.X,
+			Y:      t.area.Min.Y,
+			Width:  t.area.Dx(),
+			Height: t.area.Dy(),
+			Script: t.script,
+			Enter:  t.enter,
+			Exit:   t.exit,
+		})
+	}
+
+	return f.Save(w)
+}
@@ -0,0 +1,30 @@
+package game
+
+import (
+	"bytes"
+	"image"
+	"testing"
+
+	"github.com/tauraamui/berrybun/config"
+	"github.com/tauraamui/berrybun/pathfind"
+)
+
+func TestLoadResizedMap(t *testing.T) {
+	saved := &Map{game: &Game{Config: &config.Config{}}, tileset: mapTiles, bgwidth: 4, bgheight: 3, layers: []Layer{NewTileLayer("ground", 4, 3)}}
+	var buf bytes.Buffer
+	if err := saved.Save(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	m := &Map{game: &Game{Config: &config.Config{}}, tileset: mapTiles, bgwidth: 40, bgheight: 40, paths: pathfind.NewPlanner()}
+	m.paths.Plan(newFakeHabitat(40, 40), image.Pt(1, 1), image.Pt(38, 38), pathfind.DefaultOptions)
+	if err := m.Load(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if m.bgwidth != 4 || m.bgheight != 3 {
+		t.Errorf("loaded map is %dx%d, expected 4x3", m.bgwidth, m.bgheight)
+	}
+	if m.paths.Pending() != 0 {
+		t.Errorf("%d searches over the old map still pending after loading", m.paths.Pending())
+	}
+}
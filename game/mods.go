@@ -0,0 +1,97 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/tacusci/logging"
+	"github.com/tauraamui/berrybun/assets"
+	"github.com/tauraamui/berrybun/mods"
+)
+
+// names the source of each mod's assets, followed by its id
+const modSource = "mod "
+
+//loadMods finds the mods in the mods directory and works out their load order, logging whatever stops any loading
+//and every file more than one changes. It returns a source for each mod to read assets from ahead of base, the last
+//loaded first so it overrides those before it.
+func (g *Game) loadMods(base []assets.Source) []assets.Source {
+	if g.ModsDir == "" {
+		return nil
+	}
+	found, errs := mods.Discover(g.ModsDir)
+	for _, err := range errs {
+		logging.Error(err.Error())
+	}
+	if len(found) == 0 {
+		return nil
+	}
+	logging.Info(fmt.Sprintf("found %d mods in %s", len(found), g.ModsDir))
+
+	ordered, errs := mods.Order(found)
+	for _, err := range errs {
+		logging.Error(err.Error())
+	}
+
+	exists := func(name string) bool {
+		for _, s := range base {
+			if _, err := fs.Stat(s.FS, name); err == nil || !errors.Is(err, fs.ErrNotExist) {
+				return true
+			}
+		}
+		return false
+	}
+	names := make([]string, len(ordered))
+	for i, m := range ordered {
+		names[i] = m.String()
+		for _, err := range mods.Check(m, exists) {
+			logging.Error(err.Error())
+		}
+		for _, err := range dropUnsupported(m, exists) {
+			logging.Error(err.Error())
+		}
+	}
+	if len(ordered) > 0 {
+		logging.Info(fmt.Sprintf("loading mods in order: %s", strings.Join(names, ", ")))
+	}
+	for _, c := range mods.Conflicts(ordered) {
+		logging.Info(c.String())
+	}
+
+	g.mods = ordered
+	sources := make([]assets.Source, len(ordered))
+	for i, m := range ordered {
+		sources[len(ordered)-1-i] = assets.Source{Name: modSource + m.ID, FS: m.FS}
+	}
+	return sources
+}
+
+//dropUnsupported leaves out the new tilesets, spritesheets, animations and dialogue a mod adds, returning why for
+//each. Nothing loads them yet, maps only being drawn with the game's own tileset and sprites and only its own
+//creatures talking. Those named after one of the game's assets override it, so are kept.
+func dropUnsupported(m *mods.Mod, exists func(name string) bool) []error {
+	var errs []error
+	drop := func(kind string, names []string) {
+		for _, name := range names {
+			if exists(name) {
+				continue
+			}
+			m.Drop(name)
+			errs = append(errs, fmt.Errorf("mod %s adds %s %s, but the game can't use new %ss yet, so it's left out", m.ID, kind, name, kind))
+		}
+	}
+	drop("tileset", m.Adds.Tilesets)
+	drop("spritesheet", m.Adds.Spritesheets)
+	drop("animation", m.Adds.Animations)
+	var dialogues []string
+	for _, name := range m.Adds.Scripts {
+		if path.Ext(name) == ".yarn" {
+			dialogues = append(dialogues, name)
+		}
+	}
+	drop("dialogue", dialogues)
+	return errs
+}
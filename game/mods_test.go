@@ -0,0 +1,80 @@
+package game
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+	"testing"
+	"testing/fstest"
+
+	"github.com/tauraamui/berrybun/assets"
+	"github.com/tauraamui/berrybun/mods"
+	"github.com/tauraamui/berrybun/res"
+)
+
+func TestBrokenModAssets(t *testing.T) {
+	builtIn := assets.Source{Name: "built in assets", FS: res.FS}
+	mod := assets.Source{Name: modSource + "broken", FS: fstest.MapFS{
+		"dialogue/fox.yarn":  {Data: []byte("title: start\n---\n<<if $fox_friend>>\n    Fox: Hello.\n===\n")},
+		"scripts/burrow.lua": {Data: []byte("function enter(\n")},
+		"scripts/added.lua":  {Data: []byte("function enter(\n")},
+	}}
+	g := &Game{assets: assets.NewManager(mod, builtIn), gameAssets: assets.NewManager(builtIn)}
+
+	g.loadDialogue("dialogue/fox.yarn")
+	if g.dialogues["dialogue/fox.yarn"] == nil {
+		t.Errorf("the game's own dialogue wasn't loaded in place of the mod's broken one")
+	}
+	g.loadScript("scripts/burrow.lua")
+	if g.scripts["scripts/burrow.lua"] == nil {
+		t.Errorf("the game's own script wasn't loaded in place of the mod's broken one")
+	}
+	g.loadScript("scripts/added.lua")
+	if _, ok := g.scripts["scripts/added.lua"]; ok {
+		t.Errorf("a broken script the mod added was loaded")
+	}
+}
+
+func TestDropUnsupported(t *testing.T) {
+	dir := t.TempDir()
+	manifest := `{
+		"id": "woods",
+		"adds": {
+			"tilesets": ["woods.json"],
+			"spritesheets": ["woods.png", "map.png"],
+			"animations": ["woods_atlas.json"],
+			"maps": ["maps/woods.json"],
+			"scripts": ["dialogue/owl.yarn", "scripts/owl.lua"]
+		}
+	}`
+	files := map[string]string{
+		mods.ManifestFile: manifest, "woods.json": "{}", "woods.png": "", "map.png": "", "woods_atlas.json": "{}",
+		"maps/woods.json": "{}", "dialogue/owl.yarn": "", "scripts/owl.lua": "",
+	}
+	for name, data := range files {
+		os.MkdirAll(filepath.Join(dir, filepath.Dir(name)), 0755)
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	m, err := mods.Load(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exists := func(name string) bool {
+		_, err := fs.Stat(res.FS, name)
+		return err == nil
+	}
+	if errs := dropUnsupported(m, exists); len(errs) != 4 {
+		t.Errorf("%d additions reported left out, expected 4: %v", len(errs), errs)
+	}
+	for name, kept := range map[string]bool{
+		"woods.json": false, "woods.png": false, "woods_atlas.json": false, "dialogue/owl.yarn": false,
+		"map.png": true, "maps/woods.json": true, "scripts/owl.lua": true,
+	} {
+		if _, err := fs.Stat(m.FS, name); (err == nil) != kept {
+			t.Errorf("%s kept %v, expected %v", name, err == nil, kept)
+		}
+	}
+}
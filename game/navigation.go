@@ -0,0 +1,71 @@
+package game
+
+import (
+	"image"
+
+	"github.com/tauraamui/berrybun/pathfind"
+)
+
+// how expensive each kind of ground is to walk over, ground not listed costs 1
+var terrainCosts = map[string]float64{
+	"grass": 1,
+	"dirt":  1,
+	"stone": 1,
+	"sand":  1.5,
+}
+
+//navGrid lets paths be found across a map, routing around solid tiles and buildings
+type navGrid struct {
+	m *Map
+}
+
+func (g navGrid) Size() (int, int) {
+	return g.m.bgwidth, g.m.bgheight
+}
+
+func (g navGrid) Cost(x, y int) (float64, bool) {
+	m := g.m
+	cost := 1.0
+
+	// later layers are drawn on top, so their ground wins
+	for _, layer := range m.layers {
+		l, ok := layer.(*TileLayer)
+		if !ok {
+			continue
+		}
+		t := m.tileset.Tile(l.Tile(x, y))
+		if t == nil {
+			continue
+		}
+		if t.Solid {
+			return 0, false
+		}
+		if c, ok := terrainCosts[t.Terrain]; ok {
+			cost = c
+		}
+	}
+
+	p := image.Pt(x, y)
+	for _, b := range m.buildings {
+		if !p.In(image.Rect(b.x, b.y, b.x+b.width, b.y+b.height)) {
+			continue
+		}
+		// only the parts of the building's art marked solid block the way, so the ground around its edges stays walkable
+		col, row := int(b.tile)%m.tileset.Columns, int(b.tile)/m.tileset.Columns
+		if t := m.tileset.Tile(m.tileset.ID(col+x-b.x, row+y-b.y)); t != nil && t.Solid {
+			return 0, false
+		}
+	}
+
+	return cost, true
+}
+
+//FindPath queues a search for a path between two tiles of the map, run a little each update
+func (m *Map) FindPath(from, to image.Point) *pathfind.Search {
+	return m.paths.Plan(navGrid{m}, from, to, pathfind.DefaultOptions)
+}
+
+//SmoothPath reduces a path found across the map to the points where it turns
+func (m *Map) SmoothPath(path []image.Point) []image.Point {
+	return pathfind.Smooth(navGrid{m}, path, pathfind.DefaultOptions)
+}
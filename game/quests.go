@@ -0,0 +1,280 @@
+package game
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/inpututil"
+	"github.com/tacusci/logging"
+	"github.com/tauraamui/berrybun/font"
+	"github.com/tauraamui/berrybun/gamepad"
+	"github.com/tauraamui/berrybun/quest"
+	"github.com/tauraamui/berrybun/ui"
+)
+
+const (
+	// updates a quest starting or being completed is announced for
+	questNoticeTime = 240
+	// most active quests the tracker shows at once
+	trackedQuests = 3
+)
+
+//loadQuests loads the quest definitions, the game can't run with them broken. They're rebuilt whenever they're
+//reloaded, keeping the progress made through them.
+func (g *Game) loadQuests() {
+	file := g.loadBytes("quests.json")
+	quests, err := quest.Parse(bytes.NewReader(file.Data()))
+	if err != nil {
+		log.Fatal(err)
+	}
+	g.setQuests(quests)
+
+	g.onReload(file, func() error {
+		quests, err := quest.Parse(bytes.NewReader(file.Data()))
+		if err != nil {
+			return err
+		}
+		g.setQuests(quests)
+		g.quests.Unlock()
+		return nil
+	})
+}
+
+//setQuests replaces the quest log with one for the quests, carrying over the progress made in the last
+func (g *Game) setQuests(quests []*quest.Quest) {
+	l := quest.NewLog(quests)
+	if g.quests != nil {
+		if err := l.Restore(g.quests.Save()); err != nil {
+			logging.Error(err.Error())
+		}
+	}
+	l.OnStart = g.questStarted
+	l.OnComplete = g.questCompleted
+	g.quests = l
+}
+
+//recordQuest notes something the player did towards the objectives of their quests, such as collecting an item
+func (g *Game) recordQuest(kind, target string, n int) {
+	if g.quests != nil && g.quests.Record(kind, target, n) {
+		g.unsaved = true
+	}
+}
+
+func (g *Game) questStarted(q *quest.Quest) {
+	logging.Info(fmt.Sprintf("quest started: %s", q.ID))
+	g.questHUD.announce("[yellow]New quest: [/]" + font.Escape(q.Title))
+	g.unsaved = true
+	g.events.Publish(QuestStarted{Quest: q})
+}
+
+//questCompleted gives the first bunny the quest's rewards
+func (g *Game) questCompleted(q *quest.Quest) {
+	logging.Info(fmt.Sprintf("quest complete: %s", q.ID))
+	g.questHUD.announce("[green]Quest complete: [/]" + font.Escape(q.Title))
+	g.unsaved = true
+
+	if p, err := g.firstPlayer(); err == nil {
+		for item, n := range q.Rewards.Items {
+			if err := p.Give(item, n); err != nil {
+				logging.Error(fmt.Sprintf("unable to reward quest %s: %v", q.ID, err))
+			}
+		}
+	}
+	for name, value := range q.Rewards.Set {
+		g.dialogueVars[name] = value
+	}
+	g.events.Publish(QuestCompleted{Quest: q})
+}
+
+//QuestHUD shows the active quests' objectives in a corner of the screen, and the journal of every quest started so
+//far when it's opened with J or a gamepad's back button
+type QuestHUD struct {
+	game    *Game
+	tracker *ui.UI
+	// the journal, nil while it's closed, the list of quests in it and the details of the one selected
+	journal *ui.UI
+	entries *ui.List
+	details *ui.Label
+	shown   []*quest.Quest
+	// the latest quest started or completed, and how many more updates it's shown for
+	notice     string
+	noticeLeft int
+}
+
+//Init creates the tracker, which is filled in again every update
+func (h *QuestHUD) Init() {
+	h.tracker = ui.New(h.game.theme, &ui.Panel{Framed: true})
+	h.tracker.Anchor = ui.TopRight
+}
+
+func (h *QuestHUD) announce(notice string) {
+	h.notice = notice
+	h.noticeLeft = questNoticeTime
+}
+
+//JournalOpen returns whether the journal's open
+func (h *QuestHUD) JournalOpen() bool {
+	return h.journal != nil
+}
+
+//Update opens and closes the journal, passing it input while it's open, and fills in the tracker
+func (h *QuestHUD) Update(screen *ebiten.Image) {
+	g := h.game
+	sw, sh := screen.Size()
+	if h.noticeLeft > 0 {
+		h.noticeLeft--
+	}
+
+	if h.journal != nil {
+		if inpututil.IsKeyJustPressed(ebiten.KeyJ) || g.backPressed() {
+			h.closeJournal()
+			return
+		}
+		h.journal.Update(sw, sh, g.input.read(g.gamepads))
+		if h.journal != nil {
+			h.details.Text = h.describe(h.selected())
+		}
+		return
+	}
+	if !g.inputCaptured() && (inpututil.IsKeyJustPressed(ebiten.KeyJ) || g.backPressed()) {
+		h.openJournal()
+		return
+	}
+
+	h.fillTracker()
+	h.tracker.Update(sw, sh, ui.Input{})
+}
+
+func (g *Game) backPressed() bool {
+	if g.gamepads == nil {
+		return false
+	}
+	for _, d := range g.gamepads.Connected() {
+		if d.JustPressed(gamepad.ButtonBack) {
+			return true
+		}
+	}
+	return false
+}
+
+//fillTracker lists the objectives of the first few active quests, under any notice of one starting or completing
+func (h *QuestHUD) fillTracker() {
+	var widgets []ui.Widget
+	if h.noticeLeft > 0 {
+		widgets = append(widgets, ui.NewLabel(h.notice))
+	}
+	tracked := 0
+	for _, q := range h.game.quests.Quests() {
+		if h.game.quests.State(q.ID) != quest.Active || tracked == trackedQuests {
+			continue
+		}
+		tracked++
+		widgets = append(widgets, ui.NewLabel("[berry]"+font.Escape(q.Title)))
+		for i := range q.Objectives {
+			widgets = append(widgets, ui.NewLabel(h.objective(q, i)))
+		}
+	}
+	h.tracker.Root.(*ui.Panel).Widgets = widgets
+}
+
+//objective returns an objective of a quest as it's shown, with how far through it the player is
+func (h *QuestHUD) objective(q *quest.Quest, i int) string {
+	o := q.Objectives[i]
+	done := h.game.quests.Count(q.ID, i)
+	text := font.Escape(o.Text)
+	if o.Count > 1 {
+		text += fmt.Sprintf(" %d/%d", done, o.Count)
+	}
+	if done >= o.Count {
+		return "[green]+ [grey]" + text
+	}
+	return "- " + text
+}
+
+//openJournal opens the journal, listing active quests before those already complete
+func (h *QuestHUD) openJournal() {
+	l := h.game.quests
+	h.shown = nil
+	for _, q := range l.Quests() {
+		if s := l.State(q.ID); s == quest.Active || s == quest.Complete {
+			h.shown = append(h.shown, q)
+		}
+	}
+	sort.SliceStable(h.shown, func(i, j int) bool {
+		return l.State(h.shown[i].ID) == quest.Active && l.State(h.shown[j].ID) != quest.Active
+	})
+
+	items := make([]string, len(h.shown))
+	for i, q := range h.shown {
+		items[i] = font.Escape(q.Title)
+		if l.State(q.ID) == quest.Complete {
+			items[i] = "[grey]" + items[i]
+		}
+	}
+	h.entries = ui.NewList(items, 5, nil)
+	h.details = &ui.Label{Wrap: true}
+
+	widgets := []ui.Widget{&ui.Label{Text: "[berry]Journal", Centred: true}}
+	if len(h.shown) == 0 {
+		widgets = append(widgets, &ui.Label{Text: "[grey]No quests yet", Centred: true})
+	} else {
+		widgets = append(widgets, h.entries, h.details)
+		h.details.Text = h.describe(h.selected())
+	}
+	widgets = append(widgets, ui.NewButton("Close", h.closeJournal))
+
+	h.journal = ui.New(h.game.theme, &ui.Panel{Framed: true, MinWidth: 240, Widgets: widgets})
+	h.journal.OnBack = h.closeJournal
+}
+
+func (h *QuestHUD) closeJournal() {
+	h.journal = nil
+	h.entries = nil
+	h.details = nil
+}
+
+func (h *QuestHUD) selected() *quest.Quest {
+	if h.entries == nil || len(h.shown) == 0 {
+		return nil
+	}
+	return h.shown[h.entries.Selected]
+}
+
+//describe returns a quest's description, objectives and rewards as the journal shows them
+func (h *QuestHUD) describe(q *quest.Quest) string {
+	if q == nil {
+		return ""
+	}
+	lines := []string{font.Escape(q.Description), ""}
+	for i := range q.Objectives {
+		lines = append(lines, h.objective(q, i))
+	}
+
+	var rewards []string
+	for item, n := range q.Rewards.Items {
+		rewards = append(rewards, fmt.Sprintf("%d %s", n, item))
+	}
+	if len(rewards) > 0 {
+		sort.Strings(rewards)
+		lines = append(lines, "", "[yellow]Reward: [/]"+strings.Join(rewards, ", "))
+	}
+	if h.game.quests.State(q.ID) == quest.Complete {
+		lines = append(lines, "", "[green]Complete")
+	}
+	return strings.Join(lines, "\n")
+}
+
+//Draw draws the tracker, or the journal while it's open
+func (h *QuestHUD) Draw(screen *ebiten.Image) error {
+	if h.journal != nil {
+		return h.journal.Draw(screen)
+	}
+	if len(h.tracker.Root.(*ui.Panel).Widgets) == 0 {
+		return nil
+	}
+	return h.tracker.Draw(screen)
+}
@@ -0,0 +1,117 @@
+package game
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/tacusci/logging"
+	"github.com/tauraamui/berrybun/dialogue"
+	"github.com/tauraamui/berrybun/quest"
+)
+
+// version of the save file layout written, saves of a newer one aren't read
+const saveVersion = 1
+
+//saveFile is the player's progress as it's saved between runs. The map isn't part of it, it's generated again from
+//its seed or read from its own file.
+type saveFile struct {
+	Version int `json:"version"`
+	// what the first bunny is carrying
+	Items    map[string]int     `json:"items"`
+	Dialogue dialogue.Variables `json:"dialogue"`
+	Quests   quest.Saved        `json:"quests"`
+}
+
+//savePath returns where progress is saved, beside the config file unless set otherwise
+func (g *Game) savePath() string {
+	if g.SaveFile != "" {
+		return g.SaveFile
+	}
+	if g.Path() == "" {
+		return "save.json"
+	}
+	return filepath.Join(filepath.Dir(g.Path()), "save.json")
+}
+
+//SaveProgress writes the player's progress to the save file, going through a temporary file so a failed save never
+//leaves half of one behind
+func (g *Game) SaveProgress() error {
+	if g.Edit {
+		return nil
+	}
+	save := saveFile{Version: saveVersion, Dialogue: g.dialogueVars}
+	if p, err := g.firstPlayer(); err == nil {
+		save.Items = p.items
+	}
+	if g.quests != nil {
+		save.Quests = g.quests.Save()
+	}
+
+	data, err := json.MarshalIndent(save, "", "\t")
+	if err != nil {
+		return fmt.Errorf("unable to save progress: %v", err)
+	}
+	path := g.savePath()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return fmt.Errorf("unable to save progress: %v", err)
+	}
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, append(data, '\n'), 0644); err != nil {
+		return fmt.Errorf("unable to save progress: %v", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		return fmt.Errorf("unable to save progress: %v", err)
+	}
+	g.unsaved = false
+	return nil
+}
+
+//loadProgress restores the player's progress from the save file, starting afresh if there isn't one
+func (g *Game) loadProgress() error {
+	path := g.savePath()
+	data, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("unable to load progress: %v", err)
+	}
+
+	var save saveFile
+	if err := json.Unmarshal(data, &save); err != nil {
+		return fmt.Errorf("unable to load progress from %s: %v", path, err)
+	}
+	if save.Version > saveVersion {
+		return fmt.Errorf("unable to load progress from %s: it was saved by a newer version of the game", path)
+	}
+
+	if p, err := g.firstPlayer(); err == nil {
+		p.items = save.Items
+	}
+	if save.Dialogue != nil {
+		g.dialogueVars = save.Dialogue
+	}
+	if g.quests != nil {
+		if err := g.quests.Restore(save.Quests); err != nil {
+			return fmt.Errorf("unable to load progress from %s: %v", path, err)
+		}
+	}
+	logging.Info(fmt.Sprintf("loaded progress from %s", path))
+	return nil
+}
+
+//updateSave saves progress made since the last update, so it's written once however much happened at once
+func (g *Game) updateSave() {
+	if !g.unsaved {
+		return
+	}
+	if err := g.SaveProgress(); err != nil {
+		logging.Error(err.Error())
+		// not tried again every update, the next progress made tries again
+		g.unsaved = false
+	}
+}
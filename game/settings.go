@@ -0,0 +1,165 @@
+package game
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/inpututil"
+	"github.com/tacusci/logging"
+	"github.com/tauraamui/berrybun/gamepad"
+	"github.com/tauraamui/berrybun/ui"
+)
+
+// settings which only take effect once the game is restarted
+var restartSettings = map[string]bool{
+	"debug":          true,
+	"allow_keyboard": true,
+	"window_width":   true,
+	"window_height":  true,
+	"map_width":      true,
+	"map_height":     true,
+	"assets_dir":     true,
+	"mods_dir":       true,
+	"startup_script": true,
+	"save_file":      true,
+	"connect":        true,
+	"server_address": true,
+}
+
+// window sizes the settings menu offers
+var windowSizes = [][2]int{{800, 600}, {1024, 768}, {1280, 720}, {1600, 900}, {1920, 1080}}
+
+//ChangeSetting changes a setting, applying it straight away if it can be and saving it to the config file for next
+//time. It returns whether the game must be restarted for the change to take effect.
+func (g *Game) ChangeSetting(key, value string) (bool, error) {
+	if err := g.Set(key, value); err != nil {
+		return false, err
+	}
+	g.applySettings()
+	return restartSettings[key], g.Save()
+}
+
+//applySettings brings the running game in line with its settings
+func (g *Game) applySettings() {
+	ebiten.SetFullscreen(g.Fullscreen)
+	g.cameraWidth = g.CameraWidth
+	g.cameraHeight = g.CameraHeight
+	if g.sound != nil {
+		g.applyVolumes()
+	}
+}
+
+//updateSettings opens the settings menu with escape or a gamepad's start button, and passes it input while open
+func (g *Game) updateSettings(screen *ebiten.Image) {
+	in := g.input.read(g.gamepads)
+	if g.settings == nil {
+		if inpututil.IsKeyJustPressed(ebiten.KeyEscape) || g.startPressed() {
+			g.openSettings()
+		}
+		return
+	}
+	sw, sh := screen.Size()
+	g.settings.Update(sw, sh, in)
+}
+
+func (g *Game) startPressed() bool {
+	if g.gamepads == nil {
+		return false
+	}
+	for _, d := range g.gamepads.Connected() {
+		if d.JustPressed(gamepad.ButtonStart) {
+			return true
+		}
+	}
+	return false
+}
+
+//openSettings opens the settings menu, whose changes apply as they're made and are saved once it's closed
+func (g *Game) openSettings() {
+	changed := false
+	status := &ui.Label{Wrap: true, Centred: true}
+	change := func(key, value string) {
+		if err := g.Set(key, value); err != nil {
+			status.Text = "[red]" + err.Error()
+			return
+		}
+		changed = true
+		g.applySettings()
+		status.Text = ""
+		if restartSettings[key] {
+			status.Text = "[yellow]Restart the game for this to take effect"
+		}
+	}
+
+	volume := func(text, key string, value float64) *ui.Slider {
+		return ui.NewSlider(text, value, 0, 1, 0.05, func(v float64) {
+			change(key, strconv.FormatFloat(v, 'f', 2, 64))
+		})
+	}
+
+	onOff := func(on bool) string {
+		if on {
+			return "on"
+		}
+		return "off"
+	}
+	fullscreen := ui.NewButton("", nil)
+	fullscreen.Text = "Fullscreen: " + onOff(g.Fullscreen)
+	fullscreen.OnClick = func() {
+		change("fullscreen", strconv.FormatBool(!g.Fullscreen))
+		fullscreen.Text = "Fullscreen: " + onOff(g.Fullscreen)
+	}
+	mute := ui.NewButton("", nil)
+	mute.Text = "Mute: " + onOff(g.Mute)
+	mute.OnClick = func() {
+		change("mute", strconv.FormatBool(!g.Mute))
+		mute.Text = "Mute: " + onOff(g.Mute)
+	}
+
+	sizes := make([]string, len(windowSizes))
+	current := 0
+	for i, s := range windowSizes {
+		sizes[i] = fmt.Sprintf("%dx%d", s[0], s[1])
+		if s[0] == g.WindowWidth && s[1] == g.WindowHeight {
+			current = i
+		}
+	}
+	windows := ui.NewList(sizes, 3, func(i int) {
+		change("window_width", strconv.Itoa(windowSizes[i][0]))
+		change("window_height", strconv.Itoa(windowSizes[i][1]))
+	})
+	windows.Select(current)
+
+	closeMenu := func() {
+		g.settings = nil
+		if !changed {
+			return
+		}
+		if err := g.Save(); err != nil {
+			logging.Error(err.Error())
+		}
+	}
+
+	title := &ui.Label{Text: "[berry]Settings", Centred: true}
+	root := &ui.Panel{
+		Framed:   true,
+		MinWidth: 200,
+		Widgets: []ui.Widget{
+			title,
+			volume("Volume", "master_volume", g.MasterVolume),
+			volume("Music", "music_volume", g.MusicVolume),
+			volume("Ambience", "ambience_volume", g.AmbienceVolume),
+			volume("Effects", "effects_volume", g.EffectsVolume),
+			mute,
+			fullscreen,
+			ui.NewLabel("Window size"),
+			windows,
+			status,
+			ui.NewButton("Done", closeMenu),
+		},
+	}
+
+	g.settings = ui.New(g.theme, root)
+	g.settings.OnBack = closeMenu
+}
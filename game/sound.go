@@ -0,0 +1,132 @@
+package game
+
+import (
+	"fmt"
+	"image"
+	"log"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/tacusci/logging"
+	"github.com/tauraamui/berrybun/sound"
+)
+
+// updates the music and ambience take to crossfade
+const (
+	musicFade    = 180
+	ambienceFade = 120
+)
+
+// every sound the game plays by name, and the mixer category each belongs to, loaded from the asset of the same name
+var sounds = []struct {
+	name     string
+	category sound.Category
+}{
+	{"hop", sound.Effects},
+	// footsteps, named by the tiles bunnies land on
+	{"grass", sound.Effects},
+	{"sand", sound.Effects},
+	{"dirt", sound.Effects},
+	{"stone", sound.Effects},
+	{"pickup", sound.Effects},
+	{"day", sound.Music},
+	{"night", sound.Music},
+	{"birdsong", sound.Ambience},
+	{"wind", sound.Ambience},
+	{"rain", sound.Ambience},
+	{"storm", sound.Ambience},
+}
+
+//initSound loads the game's sounds, playing them through ebiten unless no audio device can be opened. The device is
+//opened even if muted, muting only turning the mixer down, so the sound can be turned back on.
+func (g *Game) initSound() {
+	var backend sound.Backend = sound.NewNullBackend()
+	if b, err := sound.NewEbitenBackend(); err != nil {
+		logging.Error(fmt.Sprintf("playing without sound: %v", err))
+	} else {
+		backend = b
+	}
+
+	g.sound = sound.NewManager(backend)
+	for _, s := range sounds {
+		name, category := s.name, s.category
+		file := g.loadBytes(name + ".wav")
+		if err := g.sound.Load(name, category, file.Data()); err != nil {
+			log.Fatal(err)
+		}
+		g.onReload(file, func() error {
+			return g.sound.Load(name, category, file.Data())
+		})
+	}
+
+	g.applyVolumes()
+}
+
+//applyVolumes sets the mixer's volumes from the settings, silencing it if muted
+func (g *Game) applyVolumes() {
+	master := g.MasterVolume
+	if g.Mute {
+		master = 0
+	}
+	g.sound.SetMasterVolume(master)
+	g.sound.SetVolume(sound.Music, g.MusicVolume)
+	g.sound.SetVolume(sound.Ambience, g.AmbienceVolume)
+	g.sound.SetVolume(sound.Effects, g.EffectsVolume)
+}
+
+//updateSound hears the world from the middle of the camera's view, with music for the time of day and ambience for the weather
+func (g *Game) updateSound(screen *ebiten.Image) error {
+	view := g.visibleArea(screen)
+	centre := view.Min.Add(view.Max).Div(2)
+	g.sound.SetListener(float64(centre.X), float64(centre.Y), float64(view.Dx()))
+
+	music := "day"
+	if g.world.nightTime {
+		music = "night"
+	}
+	if err := g.sound.PlayMusic(music, musicFade); err != nil {
+		return err
+	}
+
+	ambience := g.world.conditions.Ambience
+	// birds don't sing at night
+	if g.world.nightTime && ambience == "birdsong" {
+		ambience = ""
+	}
+	if err := g.sound.PlayAmbience(ambience, ambienceFade); err != nil {
+		return err
+	}
+
+	return g.sound.Update()
+}
+
+//playAt plays a sound effect from a position in the world, logging rather than failing if it can't
+func (g *Game) playAt(name string, x, y float64) {
+	if err := g.sound.PlayAt(name, x, y); err != nil {
+		logging.Error(fmt.Sprintf("unable to play %s: %v", name, err))
+	}
+}
+
+//footstep returns the sound of landing on the tile at x, y, that of the topmost tile with one, empty if none has
+func (m *Map) footstep(x, y int) string {
+	footstep := ""
+	for _, layer := range m.layers {
+		l, ok := layer.(*TileLayer)
+		if !ok {
+			continue
+		}
+		if t := m.tileset.Tile(l.Tile(x, y)); t != nil && t.Footstep != "" {
+			footstep = t.Footstep
+		}
+	}
+	return footstep
+}
+
+//hopSound returns the sound of a bunny landing at x, y, the footstep of the ground its feet are on or a plain hop
+//where that has none the game knows
+func (g *Game) hopSound(x, y float64) string {
+	at := image.Pt(int(x)/TileSize, int(y+playerFeet)/TileSize)
+	if name := g.world.wMap.footstep(at.X, at.Y); name != "" && g.sound.Has(name) {
+		return name
+	}
+	return "hop"
+}
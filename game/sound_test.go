@@ -0,0 +1,46 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/tauraamui/berrybun/sound"
+	"github.com/tauraamui/berrybun/tileset"
+)
+
+func TestHopSound(t *testing.T) {
+	ground := NewTileLayer("ground", 3, 1)
+	ground.SetTile(0, 0, tile(18, 9))
+	ground.SetTile(1, 0, tile(11, 21))
+	ground.SetTile(2, 0, tile(10, 12))
+	// a path laid over the sand
+	decoration := NewTileLayer("decoration", 3, 1)
+	decoration.SetTile(1, 0, tile(11, 23))
+	decoration.SetTile(2, 0, tileset.NoTile)
+
+	g := &Game{world: &World{wMap: &Map{tileset: mapTiles, layers: []Layer{ground, decoration}}}, sound: sound.NewManager(sound.NewNullBackend())}
+	for _, name := range []string{"hop", "grass", "sand"} {
+		if err := g.sound.Load(name, sound.Effects, []byte(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		x           int
+		expected    string
+		description string
+	}{
+		{0, "grass", "on grass"},
+		{1, "hop", "on a path, with no stone footsteps loaded"},
+		{2, "hop", "in water, which has no footsteps"},
+	}
+	for _, test := range tests {
+		if name := g.hopSound(float64(test.x*TileSize+8), 0); name != test.expected {
+			t.Errorf("%s: landing sounds %q, expected %q", test.description, name, test.expected)
+		}
+	}
+
+	g.sound.Load("stone", sound.Effects, []byte("stone"))
+	if name := g.hopSound(TileSize+8, 0); name != "stone" {
+		t.Errorf("landing on a path sounds %q, expected the stone footstep over the sand beneath", name)
+	}
+}
@@ -0,0 +1,313 @@
+package game
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"log"
+
+	"github.com/tauraamui/berrybun/autotile"
+	"github.com/tauraamui/berrybun/pathfind"
+	"github.com/tauraamui/berrybun/res"
+	"github.com/tauraamui/berrybun/terrain"
+	"github.com/tauraamui/berrybun/tileset"
+	"github.com/tauraamui/berrybun/utils"
+)
+
+// the kinds of ground a map tile can be, used to pick transition tiles between them
+const (
+	terrainGrass = iota
+	terrainDirt
+	terrainSand
+	terrainWater
+	terrainPath
+)
+
+// below this moisture meadows dry out into bare dirt
+const dirtMoisture = 0.3
+
+//mapTiles is the metadata for every tile of the built in map.png, which the tile tables below are laid out by
+var mapTiles = loadTileset()
+
+func loadTileset() *tileset.Tileset {
+	f, err := res.FS.Open("map.json")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	ts, err := tileset.Load(f)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return ts
+}
+
+//parseTileset loads a descriptor for map.png, which has to keep the layout of the built in one the tile tables index
+func parseTileset(descriptor []byte) (*tileset.Tileset, error) {
+	ts, err := tileset.Load(bytes.NewReader(descriptor))
+	if err != nil {
+		return nil, err
+	}
+	if ts.Columns != mapTiles.Columns || ts.Rows != mapTiles.Rows || ts.TileWidth != mapTiles.TileWidth || ts.TileHeight != mapTiles.TileHeight {
+		return nil, fmt.Errorf("tileset is %dx%d tiles of %dx%d, it must be laid out like the built in %dx%d tiles of %dx%d",
+			ts.Columns, ts.Rows, ts.TileWidth, ts.TileHeight, mapTiles.Columns, mapTiles.Rows, mapTiles.TileWidth, mapTiles.TileHeight)
+	}
+	return ts, nil
+}
+
+//tile returns the ID of the tile at column x, row y of map.png
+func tile(x, y int) tileset.TileID {
+	return mapTiles.ID(x, y)
+}
+
+//variants lists tiles for an autotile set, which keys plain ints
+func variants(ids ...tileset.TileID) []int {
+	tiles := make([]int, len(ids))
+	for i, id := range ids {
+		tiles[i] = int(id)
+	}
+	return tiles
+}
+
+// grass drawn over dirt, the spritesheet has the outer edges and inner corners but not the two diagonals
+var grassOverDirt = &autotile.Set{
+	Terrain: terrainGrass,
+	Scheme:  autotile.WangCorner,
+	Tiles: map[int][]int{
+		0:                      variants(tile(22, 9)),
+		int(autotile.CornerBR): variants(tile(17, 7)),
+		int(autotile.CornerBL): variants(tile(20, 7)),
+		int(autotile.CornerTR): variants(tile(17, 10)),
+		int(autotile.CornerTL): variants(tile(20, 10)),
+		int(autotile.CornerBR | autotile.CornerBL):                     variants(tile(18, 7), tile(19, 7)),
+		int(autotile.CornerTL | autotile.CornerTR):                     variants(tile(18, 10), tile(19, 10)),
+		int(autotile.CornerTR | autotile.CornerBR):                     variants(tile(17, 8), tile(17, 9)),
+		int(autotile.CornerTL | autotile.CornerBL):                     variants(tile(20, 8), tile(20, 9)),
+		int(autotile.CornerTL | autotile.CornerTR | autotile.CornerBL): variants(tile(21, 7)),
+		int(autotile.CornerTL | autotile.CornerTR | autotile.CornerBR): variants(tile(22, 7)),
+		int(autotile.CornerTL | autotile.CornerBR | autotile.CornerBL): variants(tile(21, 8)),
+		int(autotile.CornerTR | autotile.CornerBR | autotile.CornerBL): variants(tile(22, 8)),
+	},
+	Fallback: variants(tile(18, 9)),
+}
+
+//blobSet is the transition tiles for drawing a terrain over the ground around it, laid out in blob index order 24 tiles
+//to a row from the start of the row of map.png, cells surrounded by the terrain taking one of the inner tiles instead
+func blobSet(terrain, row int, inner ...tileset.TileID) *autotile.Set {
+	tiles := map[int][]int{}
+	for i := range autotile.BlobMasks() {
+		tiles[i] = variants(tile(i%24, row+i/24))
+	}
+	tiles[autotile.BlobIndex(0xff)] = variants(inner...)
+	return &autotile.Set{Terrain: terrain, Scheme: autotile.Blob, Tiles: tiles}
+}
+
+// water lapping at a sandy bank, still water taking the animated tile
+var waterOverSand = blobSet(terrainWater, 19, tile(10, 12))
+
+// sand and paths fading into grass, the autumn and winter palettes swap in their own grass
+var (
+	sandOverGrass = blobSet(terrainSand, 21, tile(11, 12))
+	pathOverGrass = blobSet(terrainPath, 23, tile(4, 10), tile(6, 10))
+)
+
+var terrainTiler = autotile.NewTiler(grassOverDirt, waterOverSand, sandOverGrass, pathOverGrass)
+
+// terrains each terrain's transition tiles treat as more of itself, water, sand and paths draw their own edges over
+// whatever they meet so grass only needs edges against dirt
+var terrainBlends = map[int][]int{
+	terrainGrass: {terrainWater, terrainSand, terrainPath},
+	terrainSand:  {terrainWater},
+}
+
+// how much more laying a path through water and sand costs than across grass, so paths keep to the grass where they
+// can and only ford rivers they can't go around
+const (
+	pathWaterCost = 20
+	pathSandCost  = 3
+)
+
+//terrainGrid is the terrain type of each cell of a map, for autotiling
+type terrainGrid [][]int
+
+func newTerrainGrid(cells [][]terrain.Cell) terrainGrid {
+	grid := make(terrainGrid, len(cells))
+	for y := range cells {
+		grid[y] = make([]int, len(cells[y]))
+		for x, c := range cells[y] {
+			switch {
+			case c.Water():
+				grid[y][x] = terrainWater
+			case c.Biome == terrain.Sand:
+				grid[y][x] = terrainSand
+			case c.Biome == terrain.Meadow && c.Moisture < dirtMoisture:
+				grid[y][x] = terrainDirt
+			default:
+				grid[y][x] = terrainGrass
+			}
+		}
+	}
+	return grid
+}
+
+func (g terrainGrid) Size() (int, int) {
+	if len(g) == 0 {
+		return 0, 0
+	}
+	return len(g[0]), len(g)
+}
+
+func (g terrainGrid) Terrain(x, y int) int {
+	return g[y][x]
+}
+
+//Cost lets paths be laid across the grid, keeping out of sand and water where there's a way around
+func (g terrainGrid) Cost(x, y int) (float64, bool) {
+	switch g[y][x] {
+	case terrainWater:
+		return pathWaterCost, true
+	case terrainSand:
+		return pathSandCost, true
+	}
+	return 1, true
+}
+
+//layPaths lays a path from each stop to the next, stops off the grid are skipped
+func (g terrainGrid) layPaths(stops []image.Point) {
+	w, h := g.Size()
+	bounds := image.Rect(0, 0, w, h)
+	var from *image.Point
+	for i := range stops {
+		if !stops[i].In(bounds) {
+			continue
+		}
+		if from != nil {
+			// paths only turn corners, a diagonal step would leave them looking broken
+			s := pathfind.NewSearch(g, *from, stops[i], pathfind.Options{Movement: pathfind.FourWay, MinCost: 1})
+			s.Run()
+			for _, p := range s.Path() {
+				g[p.Y][p.X] = terrainPath
+			}
+		}
+		from = &stops[i]
+	}
+}
+
+//tile picks a ground tile from the map spritesheet for a cell, transitioning between terrains where they meet
+func (g terrainGrid) tile(x, y int, random *utils.Rand) tileset.TileID {
+	if g[y][x] == terrainDirt {
+		return tile(22, 9)
+	}
+
+	// anything but grass surrounded by grass takes a tile for how it meets its neighbours
+	if !g.innerGrass(x, y) {
+		t := g[y][x]
+		id, _ := terrainTiler.Tile(blendGrid{g, terrainBlends[t], t}, x, y, random.Next(2))
+		return tileset.TileID(id)
+	}
+
+	switch random.Next(6) {
+	case 0:
+		return tile(18, 8)
+	case 1:
+		return tile(19, 8)
+	}
+	return tile(18, 9)
+}
+
+//innerGrass returns whether the cell is grass with nothing but grass around it
+func (g terrainGrid) innerGrass(x, y int) bool {
+	return g[y][x] == terrainGrass && autotile.BlobMask(g, x, y, terrainGrass) == 0xff
+}
+
+//decoration picks the bush or flower growing on a cell, if any, only grass away from the edges gets decorated
+func (g terrainGrid) decoration(x, y int, c terrain.Cell, random *utils.Rand) tileset.TileID {
+	if !g.innerGrass(x, y) {
+		return tileset.NoTile
+	}
+
+	// berry bushes grow where the density map says, more so in forests
+	if random.Next(1000) < uint32(c.BerryDensity*c.BerryDensity*250) {
+		return tile(1+int(random.Next(2)), 13)
+	}
+
+	if c.Biome == terrain.Meadow && random.Next(40) == 0 {
+		return tile(3+int(random.Next(2)), 13)
+	}
+
+	return tileset.NoTile
+}
+
+//plantTrees scatters trees through the forests, their trunks beneath the players and canopies above
+func (g terrainGrid) plantTrees(cells [][]terrain.Cell, decoration, canopy *TileLayer, random *utils.Rand) {
+	// the tree in the spritesheet, in tiles, the top three rows of which are canopy
+	const (
+		treeX, treeY          = 20, 1
+		treeWidth, treeHeight = 4, 5
+		canopyHeight          = 3
+	)
+
+	w, h := g.Size()
+	occupied := make([][]bool, h)
+	for y := range occupied {
+		occupied[y] = make([]bool, w)
+	}
+
+	for y := 0; y+treeHeight <= h; y++ {
+		for x := 0; x+treeWidth <= w; x++ {
+			if cells[y][x].Biome != terrain.Forest || random.Next(30) != 0 {
+				continue
+			}
+
+			// the whole tree must fit on forest floor clear of other trees
+			clear := true
+			for ty := 0; ty < treeHeight && clear; ty++ {
+				for tx := 0; tx < treeWidth; tx++ {
+					ax, ay := x+tx, y+ty
+					if cells[ay][ax].Biome != terrain.Forest || !g.innerGrass(ax, ay) || occupied[ay][ax] {
+						clear = false
+						break
+					}
+				}
+			}
+			if !clear {
+				continue
+			}
+
+			// any bushes or flowers under the tree make way for it
+			for ty := 0; ty < treeHeight; ty++ {
+				for tx := 0; tx < treeWidth; tx++ {
+					t := tile(treeX+tx, treeY+ty)
+					occupied[y+ty][x+tx] = true
+					if ty < canopyHeight {
+						canopy.SetTile(x+tx, y+ty, t)
+						decoration.SetTile(x+tx, y+ty, tileset.NoTile)
+					} else {
+						decoration.SetTile(x+tx, y+ty, t)
+					}
+				}
+			}
+		}
+	}
+}
+
+//blendGrid views the grid as the transition tiles of one terrain see it, cells of the terrains it blends into being
+//counted as whichever terrain is being tiled
+type blendGrid struct {
+	terrainGrid
+	// terrains counted as the tiled cell's own
+	blends []int
+	// the terrain being tiled
+	terrain int
+}
+
+func (g blendGrid) Terrain(x, y int) int {
+	t := g.terrainGrid[y][x]
+	for _, b := range g.blends {
+		if t == b {
+			return g.terrain
+		}
+	}
+	return t
+}
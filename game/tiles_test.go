@@ -0,0 +1,178 @@
+package game
+
+import (
+	"image"
+	"image/png"
+	"testing"
+
+	"github.com/tauraamui/berrybun/res"
+	"github.com/tauraamui/berrybun/terrain"
+	"github.com/tauraamui/berrybun/tileset"
+	"github.com/tauraamui/berrybun/utils"
+)
+
+//mapSheetBounds returns the size of the built in map.png
+func mapSheetBounds(t *testing.T) image.Rectangle {
+	t.Helper()
+	f, err := res.FS.Open("map.png")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	config, err := png.DecodeConfig(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return image.Rect(0, 0, config.Width, config.Height)
+}
+
+//generateLayers picks the tiles of a generated map's ground, decoration and canopy as Map.generate does
+func generateLayers(seed uint64, width, height int) (ground, decoration, canopy *TileLayer) {
+	random := utils.NewRand(seed)
+	cells := terrain.NewGenerator(seed, width, height).Generate()
+	grid := newTerrainGrid(cells)
+	grid.layPaths(generatedDoorsteps())
+
+	ground = NewTileLayer("ground", width, height)
+	decoration = NewTileLayer("decoration", width, height)
+	canopy = NewTileLayer("canopy", width, height)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			ground.SetTile(x, y, grid.tile(x, y, random))
+			decoration.SetTile(x, y, grid.decoration(x, y, cells[y][x], random))
+		}
+	}
+	grid.plantTrees(cells, decoration, canopy, random)
+	return ground, decoration, canopy
+}
+
+func TestGeneratedTilesInSheet(t *testing.T) {
+	sheet := mapSheetBounds(t)
+	for _, seed := range []uint64{1, 2, 3} {
+		ground, decoration, canopy := generateLayers(seed, 160, 120)
+		for _, layer := range []*TileLayer{ground, decoration, canopy} {
+			for y := 0; y < 120; y++ {
+				for x := 0; x < 160; x++ {
+					id := layer.Tile(x, y)
+					if id == tileset.NoTile {
+						if layer == ground {
+							t.Fatalf("seed %d: no ground tile at %d,%d", seed, x, y)
+						}
+						continue
+					}
+					r := mapTiles.Rect(id)
+					if r.Empty() || !r.In(sheet) {
+						t.Fatalf("seed %d: %s tile %d at %d,%d is drawn from %v, outside map.png's %v", seed, layer.Name, id, x, y, r, sheet)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestGeneratedTilesSameForSeed(t *testing.T) {
+	a, _, _ := generateLayers(9, 64, 48)
+	b, _, _ := generateLayers(9, 64, 48)
+	for y := 0; y < 48; y++ {
+		for x := 0; x < 64; x++ {
+			if a.Tile(x, y) != b.Tile(x, y) {
+				t.Fatalf("ground tile at %d,%d is %d then %d from the same seed", x, y, a.Tile(x, y), b.Tile(x, y))
+			}
+		}
+	}
+}
+
+//grassGrid returns a grid of grass with the cells given in rows of . grass, ~ water, s sand and # path at x, y
+func grassGrid(width, height, x, y int, rows ...string) terrainGrid {
+	g := make(terrainGrid, height)
+	for i := range g {
+		g[i] = make([]int, width)
+	}
+	kinds := map[rune]int{'.': terrainGrass, '~': terrainWater, 's': terrainSand, '#': terrainPath}
+	for dy, row := range rows {
+		for dx, r := range row {
+			g[y+dy][x+dx] = kinds[r]
+		}
+	}
+	return g
+}
+
+func TestShoreTiles(t *testing.T) {
+	g := grassGrid(9, 9, 0, 0,
+		"sssss",
+		"s~~~s",
+		"s~~~s",
+		"s~~~s",
+		"sssss",
+	)
+	random := utils.NewRand(1)
+
+	if id := g.tile(2, 2, random); id != tile(10, 12) {
+		t.Errorf("water surrounded by water is tile %d, expected the animated water tile", id)
+	}
+	if id := g.tile(3, 2, random); id < tile(0, 19) || id >= tile(0, 21) {
+		t.Errorf("water beside sand is tile %d, expected one of the shore tiles", id)
+	}
+	// sand runs on into the water, the water drawing the bank
+	if id := g.tile(0, 2, random); id != tile(11, 12) {
+		t.Errorf("sand with only sand and water around it is tile %d, expected plain sand", id)
+	}
+	if id := g.tile(4, 2, random); id < tile(0, 21) || id >= tile(0, 23) {
+		t.Errorf("sand beside grass is tile %d, expected one of sand's edges", id)
+	}
+	if g.innerGrass(5, 2) || !g.innerGrass(7, 7) {
+		t.Errorf("grass beside sand counts as inner grass, or grass away from it doesn't")
+	}
+	if id := g.tile(5, 2, random); id != tile(18, 9) {
+		t.Errorf("grass beside sand is tile %d, expected plain grass with the sand drawing the edge", id)
+	}
+}
+
+func TestPathsBetweenDoorsteps(t *testing.T) {
+	const width, height = 40, 20
+	g := grassGrid(width, height, 0, 0)
+	// a river across the whole map, paths have to ford
+	for y := 0; y < height; y++ {
+		g[y][20] = terrainWater
+	}
+	// a pond paths should go around
+	for y := 0; y < 8; y++ {
+		g[y][10] = terrainWater
+	}
+	stops := []image.Point{{2, 2}, {30, 2}, {35, 15}, {100, 100}}
+	g.layPaths(stops)
+
+	// flood the path from the first stop
+	reached := map[image.Point]bool{stops[0]: true}
+	queue := []image.Point{stops[0]}
+	for len(queue) > 0 {
+		p := queue[0]
+		queue = queue[1:]
+		for _, d := range []image.Point{{0, 1}, {1, 0}, {0, -1}, {-1, 0}} {
+			n := p.Add(d)
+			if n.X < 0 || n.Y < 0 || n.X >= width || n.Y >= height || reached[n] || g[n.Y][n.X] != terrainPath {
+				continue
+			}
+			reached[n] = true
+			queue = append(queue, n)
+		}
+	}
+	for _, s := range stops[:3] {
+		if !reached[s] {
+			t.Errorf("no path reaches %v", s)
+		}
+	}
+
+	forded := 0
+	for y := 0; y < height; y++ {
+		if g[y][20] == terrainPath {
+			forded++
+		}
+		if y < 8 && g[y][10] == terrainPath {
+			t.Errorf("path goes through the pond at 10,%d rather than around it", y)
+		}
+	}
+	if forded != 1 {
+		t.Errorf("paths ford the river %d times, expected once", forded)
+	}
+}
@@ -0,0 +1,296 @@
+package game
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"strings"
+	"time"
+
+	"github.com/tacusci/logging"
+	"github.com/tauraamui/berrybun/dialogue"
+	"github.com/tauraamui/berrybun/script"
+)
+
+// most Lua instructions a trigger's function runs before it's stopped, in case it's stuck in a loop
+const triggerSteps = 100000
+
+//Trigger is a zone of the map which calls functions of a Lua script as bunnies hop into and out of it
+type Trigger struct {
+	// the zone, in tiles
+	area image.Rectangle
+	// asset name of the script, and the functions called on entering and leaving, none to do nothing
+	script string
+	enter  string
+	exit   string
+	// the bunnies inside the zone
+	inside map[*Player]bool
+}
+
+func newTrigger(area image.Rectangle, script, enter, exit string) *Trigger {
+	return &Trigger{area: area, script: script, enter: enter, exit: exit, inside: map[*Player]bool{}}
+}
+
+//loadTriggerScripts loads the script of every trigger on the map which isn't already, the game can't run with any of its
+//own broken
+func (g *Game) loadTriggerScripts() {
+	for _, t := range g.world.wMap.triggers {
+		if _, ok := g.scripts[t.script]; !ok {
+			g.loadScript(t.script)
+		}
+	}
+}
+
+//loadScript loads a Lua script, compiling it again whenever it's reloaded
+func (g *Game) loadScript(name string) {
+	g.loadModdable(name, func(data []byte) error {
+		s, err := script.Compile(bytes.NewReader(data), name)
+		if err != nil {
+			return err
+		}
+		if g.scripts == nil {
+			g.scripts = map[string]*script.Script{}
+		}
+		g.scripts[name] = s
+		return nil
+	})
+}
+
+//crossTriggers calls the functions of the trigger zones a bunny has just hopped into or out of
+func (g *Game) crossTriggers(p *Player, at image.Point) {
+	for _, t := range g.world.wMap.triggers {
+		inside := at.In(t.area)
+		if inside == t.inside[p] {
+			continue
+		}
+		t.inside[p] = inside
+
+		fn := t.exit
+		if inside {
+			fn = t.enter
+		}
+		if fn != "" {
+			g.runTrigger(p, t, fn)
+		}
+	}
+}
+
+//runTrigger calls a function of a trigger's script for a bunny, showing whatever it says unless the bunny's already
+//talking
+func (g *Game) runTrigger(p *Player, t *Trigger, fn string) {
+	s, ok := g.scripts[t.script]
+	if !ok {
+		logging.Error(fmt.Sprintf("trigger at %d,%d has no script %s loaded", t.area.Min.X, t.area.Min.Y, t.script))
+		return
+	}
+
+	said := &saidLines{}
+	r := script.NewRunner(s)
+	r.Functions = g.scriptFunctions(p, said)
+	r.MaxSteps = triggerSteps
+	_, err := r.Call(fn)
+	// whatever the script changed before it stopped is kept
+	g.unsaved = true
+	if err != nil {
+		logging.Error(fmt.Sprintf("trigger script stopped: %v", err))
+	}
+
+	if len(said.lines) == 0 {
+		return
+	}
+	if g.conversation != nil {
+		logging.Debug(fmt.Sprintf("%s said %d lines while the bunny was talking, skipping them", t.script, len(said.lines)))
+		return
+	}
+	g.show(&Conversation{game: g, player: p, runner: said})
+}
+
+//saidLines are the lines a trigger's script said, shown one after another once it's done
+type saidLines struct {
+	lines []dialogue.Line
+}
+
+func (s *saidLines) Next() (dialogue.Event, error) {
+	if len(s.lines) == 0 {
+		return dialogue.End{}, nil
+	}
+	line := s.lines[0]
+	s.lines = s.lines[1:]
+	return line, nil
+}
+
+func (s *saidLines) Choose(i int) error {
+	return fmt.Errorf("there's nothing to choose between")
+}
+
+func (s *saidLines) Stop() {
+	s.lines = nil
+}
+
+//scriptFunctions are what trigger scripts can ask of and do to the game, for the bunny who set the trigger off
+func (g *Game) scriptFunctions(p *Player, said *saidLines) map[string]script.Function {
+	w := g.world
+	// the middle of the map tile given as x and y arguments, or beside the bunny if there aren't any
+	place := func(args []script.Value) (float64, float64, error) {
+		if len(args) == 0 {
+			return p.x + TileSize, p.y, nil
+		}
+		if len(args) != 2 {
+			return 0, 0, fmt.Errorf("expected a tile's x and y")
+		}
+		var at [2]int
+		for i, arg := range args {
+			n, err := script.Number(arg)
+			if err != nil {
+				return 0, 0, err
+			}
+			at[i] = int(n)
+		}
+		return float64(at[0]*TileSize + TileSize/2), float64(at[1]*TileSize + TileSize/2), nil
+	}
+	count := func(args []script.Value) (int, error) {
+		if len(args) < 2 {
+			return 1, nil
+		}
+		n, err := script.Number(args[1])
+		return int(n), err
+	}
+
+	return map[string]script.Function{
+		"print": func(args []script.Value) ([]script.Value, error) {
+			var text []string
+			for _, arg := range args {
+				text = append(text, script.String(arg))
+			}
+			logging.Info(strings.Join(text, " "))
+			return nil, nil
+		},
+		// the map tile the bunny's on
+		"player": func(args []script.Value) ([]script.Value, error) {
+			return []script.Value{float64(p.tile.X), float64(p.tile.Y)}, nil
+		},
+		"carrying": func(args []script.Value) ([]script.Value, error) {
+			if len(args) != 1 {
+				return nil, fmt.Errorf("expected an item")
+			}
+			return []script.Value{float64(p.Carrying(script.String(args[0])))}, nil
+		},
+		"give": func(args []script.Value) ([]script.Value, error) {
+			if len(args) < 1 {
+				return nil, fmt.Errorf("expected an item and how many")
+			}
+			n, err := count(args)
+			if err != nil {
+				return nil, err
+			}
+			return nil, p.Give(script.String(args[0]), n)
+		},
+		"take": func(args []script.Value) ([]script.Value, error) {
+			if len(args) < 1 {
+				return nil, fmt.Errorf("expected an item and how many")
+			}
+			n, err := count(args)
+			if err != nil {
+				return nil, err
+			}
+			return nil, p.Give(script.String(args[0]), -n)
+		},
+		// shows a line once the script's done, spoken by whoever's given first if anyone
+		"say": func(args []script.Value) ([]script.Value, error) {
+			switch len(args) {
+			case 1:
+				said.lines = append(said.lines, dialogue.Line{Text: script.String(args[0])})
+			case 2:
+				said.lines = append(said.lines, dialogue.Line{Speaker: script.String(args[0]), Text: script.String(args[1])})
+			default:
+				return nil, fmt.Errorf("expected who's speaking and a line, or just a line")
+			}
+			return nil, nil
+		},
+		"play": func(args []script.Value) ([]script.Value, error) {
+			if len(args) != 1 {
+				return nil, fmt.Errorf("expected a sound to play")
+			}
+			g.playAt(script.String(args[0]), p.x, p.y)
+			return nil, nil
+		},
+		// spawns a creature of a species at a map tile, beside the bunny if none is given
+		"spawn": func(args []script.Value) ([]script.Value, error) {
+			if len(args) < 1 {
+				return nil, fmt.Errorf("expected a species and optionally a tile")
+			}
+			s := speciesNamed(script.String(args[0]))
+			if s == nil {
+				return nil, fmt.Errorf("no species called %s", script.String(args[0]))
+			}
+			x, y, err := place(args[1:])
+			if err != nil {
+				return nil, err
+			}
+			_, err = w.Spawn(s, x, y)
+			return nil, err
+		},
+		// plays an animation from the sprite atlas once at a map tile, over the bunny if none is given
+		"animate": func(args []script.Value) ([]script.Value, error) {
+			if len(args) < 1 {
+				return nil, fmt.Errorf("expected an animation and optionally a tile")
+			}
+			x, y, err := place(args[1:])
+			if err != nil {
+				return nil, err
+			}
+			return nil, w.PlayAnimation(script.String(args[0]), x, y)
+		},
+		// the time of day, as the hour and minute
+		"time": func(args []script.Value) ([]script.Value, error) {
+			t := w.Time()
+			return []script.Value{float64(t / time.Hour), float64(t % time.Hour / time.Minute)}, nil
+		},
+		// sets the time of day, as hh:mm or day or night
+		"set_time": func(args []script.Value) ([]script.Value, error) {
+			if len(args) != 1 {
+				return nil, fmt.Errorf("expected a time, hh:mm, day or night")
+			}
+			return nil, w.SetClock(script.String(args[0]))
+		},
+		"night": func(args []script.Value) ([]script.Value, error) {
+			return []script.Value{w.nightTime}, nil
+		},
+		// how far along a quest is, "locked", "available", "active" or "complete"
+		"quest": func(args []script.Value) ([]script.Value, error) {
+			if len(args) != 1 {
+				return nil, fmt.Errorf("expected a quest's id")
+			}
+			id := script.String(args[0])
+			if g.quests.Quest(id) == nil {
+				return nil, fmt.Errorf("no quest called %s", id)
+			}
+			return []script.Value{g.quests.State(id).String()}, nil
+		},
+		"start_quest": func(args []script.Value) ([]script.Value, error) {
+			if len(args) != 1 {
+				return nil, fmt.Errorf("expected a quest to start")
+			}
+			return nil, g.quests.Start(script.String(args[0]))
+		},
+		// the variables dialogue scripts share, kept with the save, by name without the $
+		"get": func(args []script.Value) ([]script.Value, error) {
+			if len(args) != 1 {
+				return nil, fmt.Errorf("expected a variable's name")
+			}
+			return []script.Value{g.dialogueVars[script.String(args[0])]}, nil
+		},
+		"set": func(args []script.Value) ([]script.Value, error) {
+			if len(args) != 2 {
+				return nil, fmt.Errorf("expected a variable's name and value")
+			}
+			if args[1] == nil {
+				delete(g.dialogueVars, script.String(args[0]))
+			} else {
+				g.dialogueVars[script.String(args[0])] = args[1]
+			}
+			return nil, nil
+		},
+	}
+}
+
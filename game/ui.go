@@ -0,0 +1,98 @@
+package game
+
+import (
+	"bytes"
+	"image"
+	"log"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/inpututil"
+	"github.com/tauraamui/berrybun/font"
+	"github.com/tauraamui/berrybun/gamepad"
+	"github.com/tauraamui/berrybun/ui"
+)
+
+// updates a key is held before it starts repeating, and how often it repeats after
+const (
+	keyRepeatDelay = 30
+	keyRepeatEvery = 3
+)
+
+//loadFont loads the game's bitmap font, its image reloaded in place and its glyphs and kerning rebuilt when the
+//descriptor changes
+func (g *Game) loadFont() *font.Font {
+	descriptor := g.loadBytes("font.json")
+	load := func() (*font.Font, error) {
+		return font.Load(bytes.NewReader(descriptor.Data()), func(name string) (*ebiten.Image, error) {
+			img, err := g.assets.Image(name)
+			if err != nil {
+				return nil, err
+			}
+			return img.Image(), nil
+		})
+	}
+
+	f, err := load()
+	if err != nil {
+		log.Fatal(err)
+	}
+	g.onReload(descriptor, func() error {
+		f, err := load()
+		if err != nil {
+			return err
+		}
+		g.theme.Font = f
+		return nil
+	})
+	return f
+}
+
+//uiInput gathers the keyboard, gamepads and mouse into input for menus
+type uiInput struct {
+	cursor image.Point
+}
+
+//read returns what the player did this update in terms a menu understands
+func (u *uiInput) read(gamepads *gamepad.Manager) ui.Input {
+	in := ui.Input{
+		Up:       repeating(ebiten.KeyUp) || repeating(ebiten.KeyW),
+		Down:     repeating(ebiten.KeyDown) || repeating(ebiten.KeyS),
+		Left:     repeating(ebiten.KeyLeft) || repeating(ebiten.KeyA),
+		Right:    repeating(ebiten.KeyRight) || repeating(ebiten.KeyD),
+		Activate: inpututil.IsKeyJustPressed(ebiten.KeyEnter) || inpututil.IsKeyJustPressed(ebiten.KeySpace),
+		Back:     inpututil.IsKeyJustPressed(ebiten.KeyEscape),
+		Click:    inpututil.IsMouseButtonJustPressed(ebiten.MouseButtonLeft),
+		Held:     ebiten.IsMouseButtonPressed(ebiten.MouseButtonLeft),
+	}
+
+	if gamepads != nil {
+		for _, d := range gamepads.Connected() {
+			in.Up = in.Up || d.JustPressed(gamepad.ButtonDPadUp)
+			in.Down = in.Down || d.JustPressed(gamepad.ButtonDPadDown)
+			in.Left = in.Left || d.JustPressed(gamepad.ButtonDPadLeft)
+			in.Right = in.Right || d.JustPressed(gamepad.ButtonDPadRight)
+			in.Activate = in.Activate || d.JustPressed(gamepad.ButtonA)
+			in.Back = in.Back || d.JustPressed(gamepad.ButtonB) || d.JustPressed(gamepad.ButtonStart)
+		}
+	}
+
+	x, y := ebiten.CursorPosition()
+	in.Cursor = image.Pt(x, y)
+	in.CursorMoved = in.Cursor != u.cursor
+	u.cursor = in.Cursor
+
+	_, wheel := ebiten.Wheel()
+	switch {
+	case wheel > 0:
+		in.Scroll = -1
+	case wheel < 0:
+		in.Scroll = 1
+	}
+	return in
+}
+
+//repeating returns whether a key has just been pressed, or has been held long enough to repeat this update
+func repeating(key ebiten.Key) bool {
+	held := inpututil.KeyPressDuration(key)
+	return held == 1 || held >= keyRepeatDelay && (held-keyRepeatDelay)%keyRepeatEvery == 0
+}
@@ -0,0 +1,123 @@
+package game
+
+import (
+	"image/color"
+	"math"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil"
+	"github.com/tauraamui/berrybun/particles"
+	"github.com/tauraamui/berrybun/weather"
+)
+
+// area of the world in pixels the weather's particle rates are tuned for, views bigger or smaller get more or fewer
+const weatherViewArea = 800 * 450
+
+//weatherEmitters are the emitters kept over the view for the weather and season, idle until needed
+type weatherEmitters struct {
+	rain   *particles.Emitter
+	snow   *particles.Emitter
+	petals *particles.Emitter
+	leaves *particles.Emitter
+}
+
+//initWeather starts the weather off with the world's seed and dresses the map for the season
+func (w *World) initWeather() {
+	w.weather = weather.New(w.game.Seed + 7)
+	w.conditions = w.weather.Conditions()
+
+	start := func(e *particles.Effect) *particles.Emitter {
+		em := w.particles.Start(e, 0, 0)
+		em.Scale = 0
+		return em
+	}
+	w.weatherEmitters = weatherEmitters{
+		rain:   start(w.effects.rain),
+		snow:   start(w.effects.snow),
+		petals: start(w.effects.petals),
+		leaves: start(w.effects.leaves),
+	}
+
+	w.wMap.SetPalette(w.weather.Season().String())
+}
+
+//updateWeather moves the weather on a tick, keeping its particles over the view
+func (w *World) updateWeather(screen *ebiten.Image) {
+	if w.weather.Update() {
+		w.wMap.SetPalette(w.weather.Season().String())
+	}
+
+	c := w.weather.Conditions()
+	w.conditions = c
+	w.particles.WindX = c.Wind
+
+	view := w.game.visibleArea(screen)
+	density := float64(view.Dx()*view.Dy()) / weatherViewArea
+
+	// rain and snow falling from upwind of the view still blow into it
+	upwind := math.Abs(c.Wind) * 60
+	x, width := float64(view.Min.X), float64(view.Dx())
+	if c.Wind > 0 {
+		x -= upwind
+	}
+	width += upwind
+
+	// it's calm enough for petals and leaves to drift about when it's neither raining nor snowing
+	calm := math.Max(0, 1-c.Rain-c.Snow)
+	season := c.Season
+
+	for _, e := range []struct {
+		em    *particles.Emitter
+		scale float64
+	}{
+		{w.weatherEmitters.rain, c.Rain},
+		{w.weatherEmitters.snow, c.Snow},
+		{w.weatherEmitters.petals, calm * seasonal(season == weather.Spring)},
+		{w.weatherEmitters.leaves, calm * seasonal(season == weather.Autumn)},
+	} {
+		e.em.X, e.em.Y = x, float64(view.Min.Y)
+		e.em.Width, e.em.Height = width, float64(view.Dy())
+		e.em.Scale = e.scale * density
+	}
+}
+
+func seasonal(in bool) float64 {
+	if in {
+		return 1
+	}
+	return 0
+}
+
+//applyLighting tints the colours of something being drawn for the weather and time of day
+func (w *World) applyLighting(cm *ebiten.ColorM) {
+	c := w.conditions
+	cm.Scale(c.LightR, c.LightG, c.LightB, 1)
+
+	if w.nightTime {
+		cm.ChangeHSV(0.0, 1.0, 0.4)
+	}
+
+	if c.Flash > 0 {
+		cm.Translate(c.Flash*0.6, c.Flash*0.6, c.Flash*0.6, 0)
+	}
+}
+
+//drawFog covers the screen in mist as thick as the fog
+func (w *World) drawFog(screen *ebiten.Image) {
+	if ebiten.IsDrawingSkipped() || w.conditions.Fog <= 0 {
+		return
+	}
+
+	mist := color.NRGBA{0xd8, 0xdc, 0xe0, uint8(w.conditions.Fog * 0xc0)}
+	if w.nightTime {
+		mist.R, mist.G, mist.B = 0x30, 0x34, 0x38
+	}
+
+	sw, sh := screen.Size()
+	ebitenutil.DrawRect(screen, 0, 0, float64(sw), float64(sh), mist)
+}
+
+//GrowthRate returns how fast plants grow in the current weather and season, 1 being a clear spring day
+func (w *World) GrowthRate() float64 {
+	return w.conditions.Growth
+}
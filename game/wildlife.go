@@ -0,0 +1,301 @@
+package game
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"log"
+	"math"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/tauraamui/berrybun/behaviour"
+	"github.com/tauraamui/berrybun/utils"
+)
+
+//species is a kind of creature, how it looks, how it behaves and how many of it live on a map
+type species struct {
+	name string
+	// the asset holding the behaviour tree every creature of the species is built from, and its definition once loaded
+	treeFile string
+	tree     *behaviour.Definition
+	// animations of the species in the sprite atlas, standing and moving facing either way
+	idle, move, idleLeft, moveLeft string
+	tint                           color.RGBA
+	// how many spawn on a map, and the ground they spawn on
+	population int
+	terrain    string
+	// the dialogue script bunnies can talk to the species with, none if it has nothing to say
+	dialogue string
+}
+
+var wildlife = []*species{
+	{
+		name:       "rabbit",
+		treeFile:   "rabbit.json",
+		idle:       "bunny/idle",
+		move:       "bunny/hop_right",
+		idleLeft:   "bunny/idle",
+		moveLeft:   "bunny/hop_left",
+		tint:       color.RGBA{0xb0, 0x90, 0x78, 0xff},
+		population: 60,
+		terrain:    "grass",
+		dialogue:   "dialogue/rabbit.yarn",
+	},
+	{
+		name:       "bird",
+		treeFile:   "bird.json",
+		idle:       "bird/idle",
+		move:       "bird/fly",
+		idleLeft:   "bird/idle_left",
+		moveLeft:   "bird/fly_left",
+		tint:       color.RGBA{0xff, 0xff, 0xff, 0xff},
+		population: 40,
+		terrain:    "grass",
+	},
+	{
+		name:       "fox",
+		treeFile:   "fox.json",
+		idle:       "fox/idle",
+		move:       "fox/run",
+		idleLeft:   "fox/idle_left",
+		moveLeft:   "fox/run_left",
+		tint:       color.RGBA{0xff, 0xff, 0xff, 0xff},
+		population: 8,
+		terrain:    "grass",
+		dialogue:   "dialogue/fox.yarn",
+	},
+}
+
+//loadWildlife loads every species' behaviour tree and dialogue, the game can't run with any broken
+func (w *World) loadWildlife() {
+	for _, s := range wildlife {
+		if err := w.loadTree(s); err != nil {
+			log.Fatal(err)
+		}
+		if s.dialogue != "" {
+			w.game.loadDialogue(s.dialogue)
+		}
+	}
+}
+
+//loadTree loads the species' behaviour tree, rebuilding the tree of every creature of the species if it's reloaded
+func (w *World) loadTree(s *species) error {
+	file := w.game.loadBytes(s.treeFile)
+	d, err := behaviour.Parse(bytes.NewReader(file.Data()))
+	if err != nil {
+		return fmt.Errorf("unable to load %s behaviour: %v", s.name, err)
+	}
+	s.tree = d
+
+	w.game.onReload(file, func() error {
+		d, err := behaviour.Parse(bytes.NewReader(file.Data()))
+		if err != nil {
+			return err
+		}
+
+		// build every tree before swapping any, so a tree that won't build leaves them all as they were
+		trees := map[*Creature]*behaviour.Tree{}
+		for _, c := range w.creatures {
+			if c.species != s {
+				continue
+			}
+			tree, err := wildlifeLeaves.NewTree(d)
+			if err != nil {
+				return err
+			}
+			trees[c] = tree
+		}
+
+		s.tree = d
+		for c, tree := range trees {
+			c.tree.Reset()
+			c.tree = tree
+		}
+		return nil
+	})
+	return nil
+}
+
+//Creature is a wild animal driven by a behaviour tree
+type Creature struct {
+	game    *Game
+	species *species
+	tree    *behaviour.Tree
+	// position of the creature's centre in world pixels
+	x, y float64
+	// how far the tree's actions moved the creature this tick
+	moveX, moveY float64
+	// whether the creature is out of sight this tick, such as a fox in its den by day
+	hidden     bool
+	facingLeft bool
+
+	animation         *Animation
+	idleAnimation     *Animation
+	moveAnimation     *Animation
+	idleLeftAnimation *Animation
+	moveLeftAnimation *Animation
+}
+
+//newCreature creates a creature of the species at world position x, y
+func newCreature(g *Game, s *species, x, y float64) (*Creature, error) {
+	tree, err := wildlifeLeaves.NewTree(s.tree)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &Creature{
+		game:    g,
+		species: s,
+		tree:    tree,
+		x:       x,
+		y:       y,
+	}
+
+	anim := func(id uint, name string, speed int) *Animation {
+		a := &Animation{
+			game:         g,
+			id:           id,
+			name:         name,
+			defaultSpeed: speed,
+			speed:        speed,
+			count:        -1,
+		}
+		if g.world != nil {
+			a.frames = g.world.frames(name)
+		}
+		return a
+	}
+	c.idleAnimation = anim(0, s.idle, 16)
+	c.moveAnimation = anim(1, s.move, 8)
+	c.idleLeftAnimation = anim(2, s.idleLeft, 16)
+	c.moveLeftAnimation = anim(3, s.moveLeft, 8)
+	c.animation = c.idleAnimation
+
+	return c, nil
+}
+
+//Think ticks the creature's behaviour tree against its surroundings and moves it, without drawing anything
+func (c *Creature) Think(h habitat) {
+	c.moveX, c.moveY = 0, 0
+	c.hidden = false
+
+	c.tree.Tick(&critter{Creature: c, habitat: h})
+
+	c.x += c.moveX
+	c.y += c.moveY
+	w, ht := h.Bounds()
+	c.x = math.Max(0, math.Min(c.x, w))
+	c.y = math.Max(0, math.Min(c.y, ht))
+
+	if c.moveX < 0 {
+		c.facingLeft = true
+	} else if c.moveX > 0 {
+		c.facingLeft = false
+	}
+}
+
+//Update thinks then draws the creature
+func (c *Creature) Update(screen *ebiten.Image) error {
+	// a creature stays put while a bunny's talking to it
+	if conv := c.game.conversation; conv == nil || conv.with != c {
+		c.Think(worldHabitat{c.game.world})
+	}
+	c.updateAnimation()
+
+	if c.hidden {
+		return nil
+	}
+
+	// creatures well outside the view aren't worth drawing
+	margin, _ := c.animation.Size()
+	area := c.game.visibleArea(screen).Inset(-margin)
+	if !image.Pt(int(c.x), int(c.y)).In(area) {
+		return nil
+	}
+
+	return c.animation.Update(screen, c.x, c.y, c.species.tint)
+}
+
+func (c *Creature) updateAnimation() {
+	next := c.idleAnimation
+	moving := c.moveX != 0 || c.moveY != 0
+	switch {
+	case moving && c.facingLeft:
+		next = c.moveLeftAnimation
+	case moving:
+		next = c.moveAnimation
+	case c.facingLeft:
+		next = c.idleLeftAnimation
+	}
+
+	if c.animation.id != next.id {
+		c.animation.Reset()
+		next.Reset()
+		c.animation = next
+	}
+}
+
+func (c *Creature) depth() float64 {
+	return c.y
+}
+
+//spawnWildlife scatters each species over the ground it lives on, the same seed always spawning the same creatures
+func (w *World) spawnWildlife() {
+	m := w.wMap
+	random := utils.NewRand(w.game.Seed + 4)
+	nav := navGrid{m}
+
+	for _, s := range wildlife {
+		placed := 0
+		for attempt := 0; placed < s.population && attempt < s.population*50; attempt++ {
+			tx, ty := int(random.Next(uint32(m.bgwidth))), int(random.Next(uint32(m.bgheight)))
+			if _, ok := nav.Cost(tx, ty); !ok || m.groundTerrain(tx, ty) != s.terrain {
+				continue
+			}
+
+			if _, err := w.Spawn(s, float64(tx*TileSize+TileSize/2), float64(ty*TileSize+TileSize/2)); err != nil {
+				log.Fatal(err)
+			}
+			placed++
+		}
+	}
+}
+
+//Spawn adds a creature of the species at world position x, y
+func (w *World) Spawn(s *species, x, y float64) (*Creature, error) {
+	if s.tree == nil {
+		return nil, fmt.Errorf("%s behaviour isn't loaded", s.name)
+	}
+	c, err := newCreature(w.game, s, x, y)
+	if err != nil {
+		return nil, err
+	}
+	w.creatures = append(w.creatures, c)
+	return c, nil
+}
+
+//speciesNamed returns the species with the name, nil if there isn't one
+func speciesNamed(name string) *species {
+	for _, s := range wildlife {
+		if s.name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+//groundTerrain returns the terrain of the topmost tile at x, y which has one
+func (m *Map) groundTerrain(x, y int) string {
+	terrain := ""
+	for _, layer := range m.layers {
+		l, ok := layer.(*TileLayer)
+		if !ok {
+			continue
+		}
+		if t := m.tileset.Tile(l.Tile(x, y)); t != nil && t.Terrain != "" {
+			terrain = t.Terrain
+		}
+	}
+	return terrain
+}
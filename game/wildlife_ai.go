@@ -0,0 +1,328 @@
+package game
+
+import (
+	"image"
+	"math"
+	"strings"
+
+	"github.com/tauraamui/berrybun/behaviour"
+	"github.com/tauraamui/berrybun/pathfind"
+	"github.com/tauraamui/berrybun/utils"
+)
+
+//habitat is everything a creature's behaviour tree can see of the world around it, so trees can be
+//ticked against a made up world without any graphics
+type habitat interface {
+	Night() bool
+	// every animal about, players included as bunnies
+	Animals() []animal
+	// whether a world position can be walked over
+	Walkable(x, y float64) bool
+	FindPath(from, to image.Point) *pathfind.Search
+	// stops a search FindPath queued which is no longer wanted
+	CancelPath(s *pathfind.Search)
+	Random() *utils.Rand
+	// width and height of the world in pixels
+	Bounds() (float64, float64)
+}
+
+//animal is a creature or player as another creature sees it
+type animal struct {
+	x, y    float64
+	species string
+	// the creature itself, nil for players
+	creature *Creature
+}
+
+//critter is the agent wildlife trees are ticked with, the creature along with its surroundings
+type critter struct {
+	*Creature
+	habitat
+}
+
+//nearest returns the closest other animal of one of the comma separated species within radius
+func (c *critter) nearest(of string, radius float64) (animal, bool) {
+	kinds := strings.Split(of, ",")
+	var found animal
+	best := radius * radius
+	ok := false
+	for _, a := range c.Animals() {
+		if a.creature == c.Creature || !hasSpecies(kinds, a.species) {
+			continue
+		}
+		dx, dy := a.x-c.x, a.y-c.y
+		if d := dx*dx + dy*dy; d <= best {
+			found, best, ok = a, d, true
+		}
+	}
+	return found, ok
+}
+
+func hasSpecies(kinds []string, species string) bool {
+	for _, k := range kinds {
+		if strings.TrimSpace(k) == species {
+			return true
+		}
+	}
+	return false
+}
+
+//step moves the creature by dx, dy this tick, sliding along whatever's in the way unless it flies,
+//returning false if it couldn't move at all
+func (c *critter) step(dx, dy float64, fly bool) bool {
+	// anything which has ended up somewhere it can't walk, such as a bird landing in a tree, may walk out of it
+	if fly || !c.Walkable(c.x, c.y) || c.Walkable(c.x+c.moveX+dx, c.y+c.moveY+dy) {
+		c.moveX += dx
+		c.moveY += dy
+		return true
+	}
+	if dx != 0 && c.Walkable(c.x+c.moveX+dx, c.y+c.moveY) {
+		c.moveX += dx
+		return true
+	}
+	if dy != 0 && c.Walkable(c.x+c.moveX, c.y+c.moveY+dy) {
+		c.moveY += dy
+		return true
+	}
+	return false
+}
+
+//towards moves the creature up to speed pixels towards x, y, returning the distance left before it moved
+func (c *critter) towards(x, y, speed float64, fly bool) (float64, bool) {
+	dx, dy := x-c.x-c.moveX, y-c.y-c.moveY
+	d := math.Hypot(dx, dy)
+	if d == 0 {
+		return 0, true
+	}
+	speed = math.Min(speed, d)
+	return d, c.step(dx/d*speed, dy/d*speed, fly)
+}
+
+// the conditions and actions wildlife trees are built from
+var wildlifeLeaves = newWildlifeLeaves()
+
+func newWildlifeLeaves() *behaviour.Registry {
+	r := behaviour.NewRegistry()
+	r.Register("near", near)
+	r.Register("night", night)
+	r.Register("hide", hide)
+	r.Register("idle", idle)
+	r.Register("wander", wander)
+	r.Register("flee", flee)
+	r.Register("stalk", stalk)
+	r.Register("pounce", pounce)
+	return r
+}
+
+//near succeeds when an animal of one of the species in "of" is within "radius", remembering where as the target
+func near(ctx *behaviour.Context, params behaviour.Params, state *interface{}) behaviour.Status {
+	c := ctx.Agent.(*critter)
+	a, ok := c.nearest(params.String("of", ""), params.Float("radius", 64))
+	if !ok {
+		ctx.Blackboard.Delete("target")
+		return behaviour.Failure
+	}
+	ctx.Blackboard.Set("target", image.Pt(int(a.x), int(a.y)))
+	return behaviour.Success
+}
+
+//night succeeds while it's night time
+func night(ctx *behaviour.Context, params behaviour.Params, state *interface{}) behaviour.Status {
+	if ctx.Agent.(*critter).Night() {
+		return behaviour.Success
+	}
+	return behaviour.Failure
+}
+
+//hide keeps the creature out of sight for the tick
+func hide(ctx *behaviour.Context, params behaviour.Params, state *interface{}) behaviour.Status {
+	ctx.Agent.(*critter).hidden = true
+	return behaviour.Success
+}
+
+//idle stands still for a random number of ticks between "min" and "max"
+func idle(ctx *behaviour.Context, params behaviour.Params, state *interface{}) behaviour.Status {
+	c := ctx.Agent.(*critter)
+	if *state == nil {
+		lo, hi := params.Int("min", 60), params.Int("max", 120)
+		*state = lo + int(c.Random().Next(uint32(utils.Max(1, hi-lo+1))))
+	}
+
+	left := (*state).(int) - 1
+	if left <= 0 {
+		return behaviour.Success
+	}
+	*state = left
+	return behaviour.Running
+}
+
+type wandering struct {
+	habitat habitat
+	search  *pathfind.Search
+	path    []image.Point
+	next    int
+}
+
+//Abandon cancels the search for a path if it's still going
+func (w *wandering) Abandon() {
+	if w.path == nil {
+		w.habitat.CancelPath(w.search)
+	}
+}
+
+//wander walks at "speed" to a random tile up to "radius" tiles away, failing if there's no way there
+func wander(ctx *behaviour.Context, params behaviour.Params, state *interface{}) behaviour.Status {
+	c := ctx.Agent.(*critter)
+	if *state == nil {
+		radius := utils.Max(1, params.Int("radius", 6))
+		from := image.Pt(int(c.x)/TileSize, int(c.y)/TileSize)
+		to := from.Add(image.Pt(
+			int(c.Random().Next(uint32(radius*2+1)))-radius,
+			int(c.Random().Next(uint32(radius*2+1)))-radius,
+		))
+		if !c.Walkable(float64(to.X*TileSize+TileSize/2), float64(to.Y*TileSize+TileSize/2)) {
+			return behaviour.Failure
+		}
+		*state = &wandering{habitat: c.habitat, search: c.FindPath(from, to)}
+	}
+
+	w := (*state).(*wandering)
+	if w.path == nil {
+		switch w.search.Status() {
+		case pathfind.Searching:
+			return behaviour.Running
+		case pathfind.NoPath:
+			return behaviour.Failure
+		}
+		w.path = w.search.Path()
+	}
+
+	if w.next == len(w.path) {
+		return behaviour.Success
+	}
+
+	speed := params.Float("speed", 1)
+	p := w.path[w.next]
+	d, ok := c.towards(float64(p.X*TileSize+TileSize/2), float64(p.Y*TileSize+TileSize/2), speed, false)
+	if !ok {
+		return behaviour.Failure
+	}
+	if d <= speed {
+		w.next++
+	}
+	return behaviour.Running
+}
+
+//flee runs from the nearest of "of" at "speed" until it's "distance" away, flying over anything in the way if "fly"
+func flee(ctx *behaviour.Context, params behaviour.Params, state *interface{}) behaviour.Status {
+	c := ctx.Agent.(*critter)
+	a, ok := c.nearest(params.String("of", ""), params.Float("distance", 160))
+	if !ok {
+		ctx.Blackboard.Delete("target")
+		return behaviour.Success
+	}
+
+	dx, dy := c.x-a.x, c.y-a.y
+	d := math.Hypot(dx, dy)
+	if d == 0 {
+		// right on top of each other, any way is away
+		dx, d = 1, 1
+	}
+	speed := params.Float("speed", 2)
+	if !c.step(dx/d*speed, dy/d*speed, params.Bool("fly", false)) {
+		return behaviour.Failure
+	}
+	return behaviour.Running
+}
+
+//stalk creeps at "speed" towards the nearest of "of", succeeding within "reach" and failing once it's "lose" away
+func stalk(ctx *behaviour.Context, params behaviour.Params, state *interface{}) behaviour.Status {
+	c := ctx.Agent.(*critter)
+	a, ok := c.nearest(params.String("of", ""), params.Float("lose", 240))
+	if !ok {
+		ctx.Blackboard.Delete("target")
+		return behaviour.Failure
+	}
+	ctx.Blackboard.Set("target", image.Pt(int(a.x), int(a.y)))
+
+	if math.Hypot(a.x-c.x, a.y-c.y) <= params.Float("reach", 40) {
+		return behaviour.Success
+	}
+	if _, ok := c.towards(a.x, a.y, params.Float("speed", 1), false); !ok {
+		return behaviour.Failure
+	}
+	return behaviour.Running
+}
+
+//pounce leaps at "speed" for "ticks" ticks towards where the target was when it started
+func pounce(ctx *behaviour.Context, params behaviour.Params, state *interface{}) behaviour.Status {
+	c := ctx.Agent.(*critter)
+	if *state == nil {
+		v, ok := ctx.Blackboard.Get("target")
+		if !ok {
+			return behaviour.Failure
+		}
+		target := v.(image.Point)
+		dx, dy := float64(target.X)-c.x, float64(target.Y)-c.y
+		d := math.Hypot(dx, dy)
+		if d == 0 {
+			return behaviour.Success
+		}
+		*state = &leap{dx: dx / d, dy: dy / d, ticks: params.Int("ticks", 20)}
+	}
+
+	l := (*state).(*leap)
+	speed := params.Float("speed", 3)
+	if l.ticks <= 0 || !c.step(l.dx*speed, l.dy*speed, false) {
+		return behaviour.Success
+	}
+	l.ticks--
+	return behaviour.Running
+}
+
+type leap struct {
+	dx, dy float64
+	ticks  int
+}
+
+//worldHabitat is the habitat of creatures living in the game's world
+type worldHabitat struct {
+	w *World
+}
+
+func (h worldHabitat) Night() bool {
+	return h.w.nightTime
+}
+
+func (h worldHabitat) Animals() []animal {
+	return h.w.animals
+}
+
+func (h worldHabitat) Walkable(x, y float64) bool {
+	if x < 0 || y < 0 {
+		return false
+	}
+	m := h.w.wMap
+	tx, ty := int(x)/TileSize, int(y)/TileSize
+	if tx >= m.bgwidth || ty >= m.bgheight {
+		return false
+	}
+	_, ok := navGrid{m}.Cost(tx, ty)
+	return ok
+}
+
+func (h worldHabitat) FindPath(from, to image.Point) *pathfind.Search {
+	return h.w.wMap.FindPath(from, to)
+}
+
+func (h worldHabitat) CancelPath(s *pathfind.Search) {
+	h.w.wMap.paths.Cancel(s)
+}
+
+func (h worldHabitat) Random() *utils.Rand {
+	return h.w.random
+}
+
+func (h worldHabitat) Bounds() (float64, float64) {
+	return float64(h.w.wMap.bgwidth * TileSize), float64(h.w.wMap.bgheight * TileSize)
+}
@@ -0,0 +1,252 @@
+package game
+
+import (
+	"image"
+	"math"
+	"testing"
+
+	"github.com/tauraamui/berrybun/behaviour"
+	"github.com/tauraamui/berrybun/pathfind"
+	"github.com/tauraamui/berrybun/res"
+	"github.com/tauraamui/berrybun/utils"
+)
+
+//fakeHabitat is a made up world of open tiles, apart from any blocked ones, for ticking creatures in
+type fakeHabitat struct {
+	night   bool
+	animals []animal
+	blocked map[image.Point]bool
+	// size in tiles
+	width, height int
+	random        *utils.Rand
+	// queues searches for paths to be run a little each update if set, otherwise they're found straight away
+	planner *pathfind.Planner
+}
+
+func newFakeHabitat(width, height int) *fakeHabitat {
+	return &fakeHabitat{blocked: map[image.Point]bool{}, width: width, height: height, random: utils.NewRand(1)}
+}
+
+func (h *fakeHabitat) Night() bool {
+	return h.night
+}
+
+func (h *fakeHabitat) Animals() []animal {
+	return h.animals
+}
+
+func (h *fakeHabitat) Walkable(x, y float64) bool {
+	if x < 0 || y < 0 {
+		return false
+	}
+	_, ok := h.Cost(int(x)/TileSize, int(y)/TileSize)
+	return ok
+}
+
+func (h *fakeHabitat) FindPath(from, to image.Point) *pathfind.Search {
+	if h.planner != nil {
+		return h.planner.Plan(h, from, to, pathfind.DefaultOptions)
+	}
+	s := pathfind.NewSearch(h, from, to, pathfind.DefaultOptions)
+	s.Run()
+	return s
+}
+
+func (h *fakeHabitat) CancelPath(s *pathfind.Search) {
+	if h.planner != nil {
+		h.planner.Cancel(s)
+	}
+}
+
+func (h *fakeHabitat) Random() *utils.Rand {
+	return h.random
+}
+
+func (h *fakeHabitat) Bounds() (float64, float64) {
+	return float64(h.width * TileSize), float64(h.height * TileSize)
+}
+
+func (h *fakeHabitat) Size() (int, int) {
+	return h.width, h.height
+}
+
+func (h *fakeHabitat) Cost(x, y int) (float64, bool) {
+	if x >= h.width || y >= h.height || h.blocked[image.Pt(x, y)] {
+		return 0, false
+	}
+	return 1, true
+}
+
+//wall blocks the column of tiles at x
+func (h *fakeHabitat) wall(x int) {
+	for y := 0; y < h.height; y++ {
+		h.blocked[image.Pt(x, y)] = true
+	}
+}
+
+//spawn creates a creature of the named species with the behaviour tree from its asset, as the game loads it
+func spawn(t *testing.T, name string, x, y float64) *Creature {
+	t.Helper()
+	s := *speciesNamed(name)
+	f, err := res.FS.Open(s.treeFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if s.tree, err = behaviour.Parse(f); err != nil {
+		t.Fatal(err)
+	}
+	c, err := newCreature(&Game{}, &s, x, y)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+//think ticks the creature, returning how far it moved
+func think(c *Creature, h habitat) (float64, float64) {
+	x, y := c.x, c.y
+	c.Think(h)
+	return c.x - x, c.y - y
+}
+
+func TestRabbitFleesBunny(t *testing.T) {
+	h := newFakeHabitat(40, 40)
+	r := spawn(t, "rabbit", 320, 320)
+	h.animals = []animal{{x: 340, y: 320, species: "bunny"}, {x: r.x, y: r.y, species: "rabbit", creature: r}}
+
+	for i := 0; i < 20; i++ {
+		if dx, dy := think(r, h); dx != -2.5 || dy != 0 {
+			t.Fatalf("tick %d: rabbit moved %v,%v, expected straight away from the bunny at 2.5", i, dx, dy)
+		}
+	}
+	if !r.facingLeft {
+		t.Errorf("rabbit running left isn't facing left")
+	}
+}
+
+func TestRabbitIdlesThenWanders(t *testing.T) {
+	h := newFakeHabitat(40, 40)
+	h.wall(18)
+	r := spawn(t, "rabbit", 20*TileSize+8, 20*TileSize+8)
+
+	moved := -1
+	for i := 0; i < 2000; i++ {
+		if dx, dy := think(r, h); moved < 0 && (dx != 0 || dy != 0) {
+			moved = i
+		}
+		if !h.Walkable(r.x, r.y) {
+			t.Fatalf("tick %d: rabbit wandered into a blocked tile at %v,%v", i, r.x, r.y)
+		}
+	}
+	switch {
+	case moved < 0:
+		t.Errorf("rabbit alone never wandered")
+	case moved < 59:
+		t.Errorf("rabbit moved on tick %d, expected it to idle at least 60 ticks first", moved)
+	}
+}
+
+func TestRabbitStopsWanderingWhenStartled(t *testing.T) {
+	h := newFakeHabitat(40, 40)
+	h.planner = pathfind.NewPlanner()
+	r := spawn(t, "rabbit", 20*TileSize+8, 20*TileSize+8)
+
+	for i := 0; h.planner.Pending() == 0; i++ {
+		if i == 2000 {
+			t.Fatalf("rabbit never went looking for somewhere to wander")
+		}
+		think(r, h)
+	}
+	// a bunny turning up before the path's been found
+	h.animals = []animal{{x: r.x + 20, y: r.y, species: "bunny"}}
+	think(r, h)
+	if h.planner.Pending() != 0 {
+		t.Errorf("the rabbit fled but its search for a path is still queued")
+	}
+}
+
+func TestBirdFliesOverWhatRabbitsCant(t *testing.T) {
+	h := newFakeHabitat(40, 40)
+	h.wall(18)
+	start := 19*TileSize + 4.0
+	b := spawn(t, "bird", start, 320)
+	r := spawn(t, "rabbit", start, 320)
+	h.animals = []animal{{x: start + 20, y: 320, species: "bunny"}}
+
+	for i := 0; i < 10; i++ {
+		if dx, _ := think(b, h); dx != -4 {
+			t.Fatalf("tick %d: bird moved %v, expected to fly away from the bunny at 4", i, dx)
+		}
+		think(r, h)
+	}
+	if b.x >= 18*TileSize {
+		t.Errorf("bird at %v didn't fly over the wall", b.x)
+	}
+	if r.x < 19*TileSize {
+		t.Errorf("rabbit at %v ran through the wall", r.x)
+	}
+}
+
+func TestFoxHidesByDay(t *testing.T) {
+	h := newFakeHabitat(40, 40)
+	f := spawn(t, "fox", 320, 320)
+	h.animals = []animal{{x: 360, y: 320, species: "bunny"}}
+
+	for i := 0; i < 300; i++ {
+		if dx, dy := think(f, h); dx != 0 || dy != 0 || !f.hidden {
+			t.Fatalf("tick %d: fox moved %v,%v or came out of hiding by day", i, dx, dy)
+		}
+	}
+
+	h.night = true
+	think(f, h)
+	if f.hidden {
+		t.Errorf("fox is still hiding at night")
+	}
+}
+
+func TestFoxStalksAndPounces(t *testing.T) {
+	h := newFakeHabitat(40, 40)
+	h.night = true
+	f := spawn(t, "fox", 200, 320)
+	// a rabbit sat still, too far off to notice the fox
+	h.animals = []animal{{x: 320, y: 320, species: "rabbit"}}
+
+	stalking := func(dx, dy float64) bool {
+		return math.Abs(dx-0.7) < 1e-9 && dy == 0
+	}
+
+	// creeps up until it's within reach
+	ticks := 0
+	for f.x < 280 {
+		if dx, dy := think(f, h); !stalking(dx, dy) {
+			t.Fatalf("tick %d: fox moved %v,%v at %v, expected to creep towards the rabbit at 0.7", ticks, dx, dy, f.x)
+		}
+		if ticks++; ticks > 200 {
+			t.Fatalf("fox never got within reach")
+		}
+	}
+
+	// then leaps at where it was for 20 ticks
+	for i := 0; i < 20; i++ {
+		if dx, dy := think(f, h); dx != 3.5 || dy != 0 {
+			t.Fatalf("pounce tick %d: fox moved %v,%v, expected to leap at 3.5", i, dx, dy)
+		}
+	}
+
+	// and won't stalk again until it's cooled down, though the rabbit's still in sight
+	for i := 0; i < 120; i++ {
+		if dx, dy := think(f, h); stalking(dx, dy) || dx == 3.5 {
+			t.Fatalf("tick %d after pouncing: fox went after the rabbit again before cooling down", i)
+		}
+	}
+	for i := 0; ; i++ {
+		if i == 300 {
+			t.Fatalf("fox never went after the rabbit again once cooled down")
+		}
+		if dx, dy := think(f, h); math.Hypot(dx, dy) > 0.69 && math.Hypot(dx, dy) < 0.71 {
+			break
+		}
+	}
+}
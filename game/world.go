@@ -2,22 +2,80 @@ package game
 
 import (
 	"bytes"
+	"fmt"
 	"image"
 	"image/color"
 	"log"
 	"math"
-	"runtime"
+	"os"
+	"sort"
 	"time"
 
 	"github.com/hajimehoshi/ebiten"
-	"github.com/tauraamui/berrybun/res"
+	"github.com/tacusci/logging"
+	"github.com/tauraamui/berrybun/atlas"
+	"github.com/tauraamui/berrybun/gamepad"
+	"github.com/tauraamui/berrybun/netplay"
+	"github.com/tauraamui/berrybun/particles"
+	"github.com/tauraamui/berrybun/pathfind"
+	"github.com/tauraamui/berrybun/terrain"
+	"github.com/tauraamui/berrybun/tileset"
 	"github.com/tauraamui/berrybun/utils"
+	"github.com/tauraamui/berrybun/weather"
 )
 
+//TileSize is the width and height of a single map tile in pixels
+const TileSize = 16
+
+// colour tints given to each local player's bunny, in order of joining
+var playerTints = []color.RGBA{
+	{0xff, 0xff, 0xff, 0xff},
+	{0xff, 0xb0, 0xc8, 0xff},
+	{0xa8, 0xd8, 0xff, 0xff},
+	{0xff, 0xe8, 0x90, 0xff},
+}
+
+// names of the buildings on a generated map, west to east, and the tile at the top left of each
+var (
+	generatedBuildings     = []string{"burrow", "larder", "lodge"}
+	generatedBuildingSites = []image.Point{{16, 16}, {44, 16}, {72, 16}}
+)
+
+// size of the generated buildings in tiles, and where on their bottom edge their doors are
+const (
+	generatedBuildingSize = 6
+	generatedBuildingDoor = 2
+)
+
+//generatedDoorsteps returns the tile outside the door of each generated building, which paths run between
+func generatedDoorsteps() []image.Point {
+	steps := make([]image.Point, len(generatedBuildingSites))
+	for i, site := range generatedBuildingSites {
+		steps[i] = site.Add(image.Pt(generatedBuildingDoor, generatedBuildingSize))
+	}
+	return steps
+}
+
 type World struct {
-	game            *Game
-	wMap            *Map
-	player          *Player
+	game      *Game
+	wMap      *Map
+	players   []*Player
+	remotes   map[uint16]*Player
+	sprites   *atlas.Atlas
+	creatures []*Creature
+	// animations playing through once, such as those scripts play
+	oneShots []*oneShot
+	// where every animal is this tick, as the creatures see them
+	animals   []animal
+	random    *utils.Rand
+	particles *particles.System
+	effects   *effects
+	weather   *weather.Simulation
+	// the weather this tick, and the emitters giving off its particles
+	conditions      weather.Conditions
+	weatherEmitters weatherEmitters
+	// time of day since midnight, which stands still, and whether it's night at that time
+	timeOfDay       time.Duration
 	nightTime       bool
 	spotLightImage  *ebiten.Image
 	bgImage         *ebiten.Image
@@ -29,11 +87,188 @@ type World struct {
 func (w *World) Init() {
 	w.wMap = &Map{
 		game:     w.game,
-		bgwidth:  500,
-		bgheight: 500,
+		bgwidth:  w.game.MapWidth,
+		bgheight: w.game.MapHeight,
+	}
+	if err := w.wMap.Init(); err != nil {
+		log.Fatal(err)
+	}
+
+	w.loadSprites()
+
+	// debug mode starts at midnight to show off the night
+	if w.game.Debug {
+		w.SetTime(midnight)
+	} else {
+		w.SetTime(midday)
+	}
+
+	w.random = utils.NewRand(w.game.Seed + 5)
+	w.effects = newEffects(w, w.wMap.bgSpriteSheet)
+	w.particles = particles.NewSystem(maxParticles, w.game.Seed+6)
+	w.initWeather()
+
+	// the editor's map is kept clear of anything wandering over it
+	if !w.game.Edit {
+		w.loadWildlife()
+		w.spawnWildlife()
+	}
+}
+
+//loadSprites loads the sprite atlas, whose pages are reloaded in place so keep being drawn without anything needing
+// to know, though the sprites only move if the game is restarted after repacking it
+func (w *World) loadSprites() {
+	index := w.game.loadBytes("atlas.json")
+	sprites, err := atlas.Load(bytes.NewReader(index.Data()), func(name string) (*ebiten.Image, error) {
+		img, err := w.game.assets.Image(name)
+		if err != nil {
+			return nil, err
+		}
+		return img.Image(), nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	w.sprites = sprites
+}
+
+//frames returns the frames of the named animation in the sprite atlas, the game can't run with any missing
+func (w *World) frames(name string) []atlas.Sprite {
+	frames, err := w.sprites.Frames(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	return frames
+}
+
+//sprite returns the named sprite in the sprite atlas, the game can't run with it missing
+func (w *World) sprite(name string) atlas.Sprite {
+	s, ok := w.sprites.Sprite(name)
+	if !ok {
+		log.Fatalf("sprite atlas has no %s", name)
+	}
+	return s
+}
+
+//AddPlayer joins a new bunny to the world next to the existing players, returns nil if all player slots are taken
+func (w *World) AddPlayer(keyboard bool) *Player {
+	if len(w.players) >= len(playerTints) {
+		return nil
+	}
+
+	p := &Player{
+		game:     w.game,
+		keyboard: keyboard,
+		tint:     playerTints[len(w.players)],
+		x:        400,
+		y:        300,
+	}
+
+	if len(w.players) > 0 {
+		last := w.players[len(w.players)-1]
+		p.x, p.y = last.x+40, last.y
+	}
+
+	if w.game.net != nil {
+		spawn := w.game.net.Predicted()
+		p.x, p.y = float64(spawn.X), float64(spawn.Y)
+	}
+
+	p.Init()
+	w.players = append(w.players, p)
+	return p
+}
+
+//AssignGamepad hands a connected gamepad to the first player without a working one, adding a player if none are free
+func (w *World) AssignGamepad(d *gamepad.Device) {
+	// a reconnecting gamepad goes straight back to the player who had it
+	for _, p := range w.players {
+		if p.gamepad == d {
+			return
+		}
+	}
+
+	for _, p := range w.players {
+		if p.gamepad == nil {
+			p.gamepad = d
+			return
+		}
+	}
+
+	for _, p := range w.players {
+		if !p.gamepad.Connected() {
+			p.gamepad = d
+			return
+		}
+	}
+
+	// each client only has the one bunny on the server
+	if w.game.net != nil {
+		return
+	}
+
+	if p := w.AddPlayer(false); p != nil {
+		p.gamepad = d
+	}
+}
+
+//SyncRemotes updates the bunnies of players on other machines to match the latest snapshot
+func (w *World) SyncRemotes(bunnies []netplay.Bunny) {
+	if w.remotes == nil {
+		w.remotes = map[uint16]*Player{}
+	}
+
+	seen := make(map[uint16]bool, len(bunnies))
+	for _, b := range bunnies {
+		seen[b.ID] = true
+
+		p, ok := w.remotes[b.ID]
+		if !ok {
+			p = &Player{
+				game:   w.game,
+				remote: true,
+				tint:   playerTints[int(b.ID)%len(playerTints)],
+			}
+			p.Init()
+			w.remotes[b.ID] = p
+		}
+
+		p.x, p.y = float64(b.X), float64(b.Y)
+		p.remoteMoveX, p.remoteMoveY = float64(b.MoveX)/100, float64(b.MoveY)/100
+	}
+
+	for id := range w.remotes {
+		if !seen[id] {
+			delete(w.remotes, id)
+		}
+	}
+}
+
+//RemoveRemotes removes every other player's bunny, for when the connection to the server is lost
+func (w *World) RemoveRemotes() {
+	w.remotes = nil
+}
+
+//entity is anything drawn among the players, in order of depth
+type entity interface {
+	Update(screen *ebiten.Image) error
+	depth() float64
+}
+
+//updateAnimals notes where every player and visible creature is, for the creatures to react to
+func (w *World) updateAnimals() {
+	w.animals = w.animals[:0]
+	for _, p := range w.players {
+		w.animals = append(w.animals, animal{x: p.x, y: p.y, species: "bunny"})
+	}
+	for _, p := range w.remotes {
+		w.animals = append(w.animals, animal{x: p.x, y: p.y, species: "bunny"})
+	}
+	for _, c := range w.creatures {
+		if !c.hidden {
+			w.animals = append(w.animals, animal{x: c.x, y: c.y, species: c.species.name, creature: c})
+		}
 	}
-	w.wMap.Init()
-	w.player.Init()
 }
 
 func (w *World) resetMaskImages(screen *ebiten.Image) {
@@ -61,8 +296,56 @@ func (w *World) resetMaskImages(screen *ebiten.Image) {
 }
 
 func (w *World) Update(screen *ebiten.Image) error {
+	// the editor keeps to a clear day so the map looks as it was drawn
+	if !w.game.Edit {
+		w.updateWeather(screen)
+		w.regrowBushes()
+	}
+
 	w.wMap.Update(screen)
-	w.player.Update(screen)
+
+	w.updateAnimals()
+
+	// draw players and creatures further down the screen last so they overlap those behind them
+	drawOrder := make([]entity, 0, len(w.players)+len(w.remotes)+len(w.creatures)+len(w.oneShots))
+	for _, p := range w.players {
+		drawOrder = append(drawOrder, p)
+	}
+	for _, p := range w.remotes {
+		drawOrder = append(drawOrder, p)
+	}
+	for _, c := range w.creatures {
+		drawOrder = append(drawOrder, c)
+	}
+	for _, o := range w.oneShots {
+		drawOrder = append(drawOrder, o)
+	}
+	sort.SliceStable(drawOrder, func(i, j int) bool {
+		return drawOrder[i].depth() < drawOrder[j].depth()
+	})
+
+	for _, p := range drawOrder {
+		if err := p.Update(screen); err != nil {
+			return err
+		}
+	}
+
+	playing := w.oneShots[:0]
+	for _, o := range w.oneShots {
+		if !o.done() {
+			playing = append(playing, o)
+		}
+	}
+	w.oneShots = playing
+
+	w.particles.Update()
+	w.drawParticles(screen)
+
+	if err := w.wMap.DrawAbove(screen); err != nil {
+		return err
+	}
+
+	w.drawFog(screen)
 
 	// if !ebiten.IsDrawingSkipped() && w.nightTime {
 
@@ -91,176 +374,213 @@ func (w *World) Update(screen *ebiten.Image) error {
 }
 
 type Map struct {
-	game                      *Game
-	bgSpriteSheet             *ebiten.Image
-	bglayer                   [][]int
+	game          *Game
+	bgSpriteSheet *ebiten.Image
+	tileset       *tileset.Tileset
+	// tiles swapped for others while drawing, such as for the season, and the name of the palette they're from
+	palette                   tileset.Palette
+	paletteName               string
+	paths                     *pathfind.Planner
+	layers                    []Layer
 	bgwidth                   int
 	bgheight                  int
 	buildings                 []Building
+	triggers                  []*Trigger
 	skippedTileLastOutputTime time.Time
+	// when the map was created and how long since, animated tiles all run from this one clock
+	started time.Time
+	clock   time.Duration
+	// berry bushes picked bare, growing back
+	regrowing []regrowth
 }
 
 func (m *Map) Init() error {
 
-	img, _, err := image.Decode(bytes.NewReader(res.Map_png))
+	sheet := m.game.loadImage("map.png")
+	m.bgSpriteSheet = sheet.Image()
+	// the pixels are replaced in place, but every chunk drawn from them is out of date
+	m.game.onReload(sheet, func() error {
+		m.invalidate()
+		return nil
+	})
 
+	descriptor := m.game.loadBytes("map.json")
+	ts, err := parseTileset(descriptor.Data())
 	if err != nil {
-		log.Fatal(err)
+		return err
 	}
+	m.tileset = ts
+	m.game.onReload(descriptor, func() error {
+		ts, err := parseTileset(descriptor.Data())
+		if err != nil {
+			return err
+		}
+		m.setTileset(ts)
+		return nil
+	})
 
-	m.bgSpriteSheet, err = ebiten.NewImageFromImage(img, ebiten.FilterDefault)
+	m.started = time.Now()
+	m.paths = pathfind.NewPlanner()
 
-	if err != nil {
-		panic(err)
-	}
-
-	m.bglayer = make([][]int, m.bgheight)
-
-	random := &utils.Rand{}
-
-	// initial values of slices will be zero, so set random indexes to be grass or flowers or something else
-	for y := 0; y < len(m.bglayer); y++ {
-		newRow := make([]int, m.bgwidth)
-		for x := 0; x < len(m.bglayer); x++ {
-			newRow[x] = utils.CombineNumbers(float64(18), float64(9))
-			if y%(int(random.Next(uint32(y+4))+1)) == 0 {
-				var grassOnRow = 0
-				if x > 2 && int(random.Next(2)) == 1 && grassOnRow < int(float64(m.bgwidth)*0.75) {
-					grass := int(random.Next(3))
-					if grass == 1 {
-						newRow[x] = utils.CombineNumbers(float64(18), float64(8))
-					} else if grass == 2 {
-						newRow[x] = utils.CombineNumbers(float64(19), float64(8))
-					}
-					if grass == 1 || grass == 2 {
-						grassOnRow++
-					}
-				}
-			}
+	if m.game.MapPath != "" {
+		err := m.loadFile(m.game.MapPath)
+		// maps mods add are played by their asset name
+		if os.IsNotExist(err) && m.game.assets.Has(m.game.MapPath) {
+			err = m.loadAsset(m.game.MapPath)
 		}
-		m.bglayer[y] = newRow
+		if err == nil || !os.IsNotExist(err) {
+			return err
+		}
+		logging.Info(fmt.Sprintf("no map at %s yet, generating one", m.game.MapPath))
 	}
 
-	m.buildings = append(m.buildings, Building{
-		game:        m.game,
-		spritesheet: m.bgSpriteSheet,
-		x:           15,
-		y:           15,
-		width:       7,
-		height:      7,
-		tileXY:      utils.CombineNumbers(float64(1), float64(1)),
-	})
-
-	m.buildings = append(m.buildings, Building{
-		game:        m.game,
-		spritesheet: m.bgSpriteSheet,
-		x:           239,
-		y:           15,
-		width:       7,
-		height:      7,
-		tileXY:      utils.CombineNumbers(float64(1), float64(1)),
-	})
-
-	m.buildings = append(m.buildings, Building{
-		game:        m.game,
-		spritesheet: m.bgSpriteSheet,
-		x:           463,
-		y:           15,
-		width:       7,
-		height:      7,
-		tileXY:      utils.CombineNumbers(float64(1), float64(1)),
-	})
+	m.generate()
 
 	return nil
 }
 
-func (m *Map) Update(screen *ebiten.Image) error {
-
-	const (
-		spriteSize = 16
-	)
+//Regenerate builds a new map from the seed, scattering fresh wildlife over it
+func (w *World) Regenerate(seed uint64) {
+	w.game.Seed = seed
+	m := w.wMap
+	m.paths = pathfind.NewPlanner()
+	m.generate()
+	m.SetPalette(m.paletteName)
+	w.repopulate()
+}
 
-	if ebiten.IsDrawingSkipped() {
-		return nil
+//repopulate scatters fresh wildlife over a new map and loads its triggers' scripts, unless it's being edited
+func (w *World) repopulate() {
+	w.creatures = nil
+	if !w.game.Edit {
+		w.spawnWildlife()
+		w.game.loadTriggerScripts()
 	}
+}
 
-	scale := ebiten.DeviceScaleFactor()
+//generate builds the map from the game's seed
+func (m *Map) generate() {
+	logging.Info(fmt.Sprintf("generating map from seed %d", m.game.Seed))
 
-	sw, sh := screen.Size()
-	// work out width/height scale factor based on percentage of screen size
-	swf := float64(sw / m.game.cameraWidth)
-	shf := float64(sh / m.game.cameraHeight)
+	random := utils.NewRand(m.game.Seed)
+	cells := terrain.NewGenerator(m.game.Seed, m.bgwidth, m.bgheight).Generate()
 
-	// for each y row of map tiles
-	for y := 0; y < len(m.bglayer); y++ {
-		xTiles := len(m.bglayer[y])
-		// for each tile in row
-		for x := 0; x < xTiles; x++ {
+	grid := newTerrainGrid(cells)
+	grid.layPaths(generatedDoorsteps())
 
-			// set zeroed tile position
-			tileXPos, tileYPos := 0.0, 0.0
+	ground := NewTileLayer("ground", m.bgwidth, m.bgheight)
+	decoration := NewTileLayer("decoration", m.bgwidth, m.bgheight)
+	canopy := NewTileLayer("canopy", m.bgwidth, m.bgheight)
+	canopy.AboveEntities = true
 
-			// transform tile positon based on tile matrix index
-			tileXPos += float64((x % xTiles) * spriteSize)
-			tileYPos += float64(y * spriteSize)
+	for y := 0; y < m.bgheight; y++ {
+		for x := 0; x < m.bgwidth; x++ {
+			ground.SetTile(x, y, grid.tile(x, y, random))
+			decoration.SetTile(x, y, grid.decoration(x, y, cells[y][x], random))
+		}
+	}
 
-			// calculate tile width/height after scaling
-			var tileWidth, tileHeight float64 = 16, 16
-			tileWidth *= scale * swf
-			tileHeight *= scale * shf
+	grid.plantTrees(cells, decoration, canopy, random)
 
-			// put the least intensive and most indicitive bound checks first for speed purposes
+	m.layers = []Layer{ground, decoration, canopy}
+	m.regrowing = nil
 
-			// if the tile's x axis pos is further than the right edge of the screen, skip rendering tile
-			if int(tileXPos) > m.game.cameraX+sw {
-				continue
-			}
+	m.buildings = nil
+	for i, site := range generatedBuildingSites {
+		b := m.newBuilding(site.X, site.Y, generatedBuildingSize, generatedBuildingSize, tile(1, 1))
+		b.name = generatedBuildings[i]
+		m.buildings = append(m.buildings, b)
+	}
 
-			// if the tile's x axis pos is between the left and right edges of the screen, skip rendering tile
-			if int(tileXPos) < m.game.cameraX && int(tileXPos+tileWidth) < m.game.cameraX {
-				continue
-			}
+	// the burrow has something to say to bunnies hopping up to it
+	burrow := m.buildings[0]
+	m.triggers = []*Trigger{
+		newTrigger(image.Rect(burrow.x, burrow.y, burrow.x+burrow.width, burrow.y+burrow.height).Inset(-1), "scripts/burrow.lua", "enter", "leave"),
+	}
+}
 
-			// if the tile's y position is further than the bottom edge of the screen, (the *-1 is to invert the camera Y pos) skip rendering tile
-			if int(tileYPos) > (m.game.cameraY*-1)+sh {
-				continue
-			}
+//SetPalette swaps the tiles drawn for those of the tileset's palette with the name, the map's own tiles if it has none
+func (m *Map) SetPalette(name string) {
+	m.paletteName = name
+	m.palette = m.tileset.Palette(name)
+	m.invalidate()
+}
 
-			// if the tile is completely out above the top edge of the screen, skip rendering tile
-			if int(tileYPos+tileHeight) < m.game.cameraY*-1 {
-				continue
-			}
+//setTileset swaps the map's tileset for another of the same layout, such as when its descriptor is reloaded
+func (m *Map) setTileset(ts *tileset.Tileset) {
+	m.tileset = ts
+	for i := range m.buildings {
+		m.buildings[i].tileset = ts
+	}
+	m.SetPalette(m.paletteName)
+}
+
+//invalidate renders every chunk of the map's tile layers again, for when the tiles they were drawn from have changed
+func (m *Map) invalidate() {
+	for _, layer := range m.layers {
+		if l, ok := layer.(*TileLayer); ok {
+			l.invalidateAll()
+		}
+	}
+}
 
-			if !ebiten.IsDrawingSkipped() {
-				// set rendering location on screen
-				op := &ebiten.DrawImageOptions{}
-				op.GeoM.Translate(float64((x%xTiles)*spriteSize), float64(y*spriteSize))
-				op.GeoM.Translate(float64(m.game.cameraX*-1), float64(m.game.cameraY))
-				op.GeoM.Scale(scale*swf, scale*shf)
+//newBuilding creates a building drawn from the map's spritesheet, position and size in tiles
+func (m *Map) newBuilding(x, y, width, height int, t tileset.TileID) Building {
+	return Building{
+		game:        m.game,
+		spritesheet: m.bgSpriteSheet,
+		tileset:     m.tileset,
+		x:           x,
+		y:           y,
+		width:       width,
+		height:      height,
+		tile:        t,
+	}
+}
 
-				// crop/select sprite from the spritesheet
-				tileX, tileY := utils.SplitNumbers(m.bglayer[y][x])
+//Update draws the layers beneath the players and buildings, then the buildings
+func (m *Map) Update(screen *ebiten.Image) error {
 
-				r := image.Rect(tileX*spriteSize, tileY*spriteSize, (tileX*spriteSize)+spriteSize, (tileY*spriteSize)+spriteSize)
+	m.clock = time.Since(m.started)
+	m.paths.Update()
 
-				// r := image.Rect(m.bglayer[y][x]*spriteSize, 0, (m.bglayer[y][x]+1)*spriteSize, (m.bglayer[y][x]+1)*spriteSize)
-				op.SourceRect = &r
+	if ebiten.IsDrawingSkipped() {
+		return nil
+	}
 
-				if m.game.world.nightTime {
-					op.ColorM.ChangeHSV(0.0, 1.0, 0.4)
-				}
+	if err := m.drawLayers(screen, false); err != nil {
+		return err
+	}
 
-				if err := screen.DrawImage(m.bgSpriteSheet, op); err != nil {
-					return err
-				}
-			}
+	for i := 0; i < len(m.buildings); i++ {
+		if err := m.buildings[i].Update(screen); err != nil {
+			return err
 		}
 	}
 
-	for i := 0; i < len(m.buildings); i++ {
-		m.buildings[i].Update(screen)
+	return nil
+}
+
+//DrawAbove draws the layers which go over the players and buildings
+func (m *Map) DrawAbove(screen *ebiten.Image) error {
+
+	if ebiten.IsDrawingSkipped() {
+		return nil
 	}
 
+	return m.drawLayers(screen, true)
+}
+
+func (m *Map) drawLayers(screen *ebiten.Image, aboveEntities bool) error {
+	for _, l := range m.layers {
+		props := l.Props()
+		if !props.Visible || props.AboveEntities != aboveEntities || props.Opacity <= 0 {
+			continue
+		}
+		if err := l.Draw(screen, m); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -276,36 +596,39 @@ type Player struct {
 	hopForwardLeftAnimation  *Animation
 	hopForwardRightAnimation *Animation
 
+	// position of the bunny's centre in world pixels
+	x, y float64
+	// colour the bunny's sprite is multiplied by to tell players apart
+	tint color.RGBA
+	// gamepad controlling this player, nil if it has none
+	gamepad *gamepad.Device
+	// whether the keyboard may control this player
+	keyboard bool
+	// whether this bunny belongs to a player on another machine, and the direction they're moving it in
+	remote                   bool
+	remoteMoveX, remoteMoveY float64
+	// frame of the animation last drawn, to tell when the bunny lands a hop
+	lastFrame int
+	// how many of each item the bunny is carrying
+	items map[string]int
+	// map tile the bunny's feet were last on
+	tile image.Point
+
 	speed int
 }
 
 //Init initialise player's animations, load spritesheet etc.,
 func (p *Player) Init() {
 
-	img, _, err := image.Decode(bytes.NewReader(res.Bunny_png))
-
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	animSpriteSheet, err := ebiten.NewImageFromImage(img, ebiten.FilterDefault)
-
-	if err != nil {
-		panic(err)
-	}
+	world := p.game.world
 
 	p.idleAnimation = &Animation{
 		game:               p.game,
 		id:                 0,
-		spritesheet:        animSpriteSheet,
+		name:               "bunny/idle",
 		repeatLoopStart:    0,
 		repeatLoopEnd:      1,
 		maxRepeatLoopCount: 200,
-		frameWidth:         32,
-		frameHeight:        32,
-		frame0X:            0,
-		frame0Y:            0,
-		frameNum:           6,
 		defaultSpeed:       2,
 		speed:              2,
 		count:              -1,
@@ -314,12 +637,7 @@ func (p *Player) Init() {
 	p.hopRightAnimation = &Animation{
 		game:         p.game,
 		id:           2,
-		spritesheet:  animSpriteSheet,
-		frameWidth:   32,
-		frameHeight:  32,
-		frame0X:      0,
-		frame0Y:      32,
-		frameNum:     6,
+		name:         "bunny/hop_right",
 		defaultSpeed: 8,
 		speed:        8,
 		count:        -1,
@@ -328,12 +646,7 @@ func (p *Player) Init() {
 	p.hopLeftAnimation = &Animation{
 		game:         p.game,
 		id:           3,
-		spritesheet:  animSpriteSheet,
-		frameWidth:   32,
-		frameHeight:  32,
-		frame0X:      0,
-		frame0Y:      64,
-		frameNum:     6,
+		name:         "bunny/hop_left",
 		defaultSpeed: 8,
 		speed:        8,
 		count:        -1,
@@ -342,12 +655,7 @@ func (p *Player) Init() {
 	p.hopForwardAnimation = &Animation{
 		game:         p.game,
 		id:           4,
-		spritesheet:  animSpriteSheet,
-		frameWidth:   32,
-		frameHeight:  32,
-		frame0X:      0,
-		frame0Y:      96,
-		frameNum:     6,
+		name:         "bunny/hop_up",
 		defaultSpeed: 8,
 		speed:        8,
 		count:        -1,
@@ -356,12 +664,7 @@ func (p *Player) Init() {
 	p.hopDownAnimation = &Animation{
 		game:         p.game,
 		id:           5,
-		spritesheet:  animSpriteSheet,
-		frameWidth:   32,
-		frameHeight:  32,
-		frame0X:      0,
-		frame0Y:      128,
-		frameNum:     6,
+		name:         "bunny/hop_down",
 		defaultSpeed: 8,
 		speed:        8,
 		count:        -1,
@@ -370,12 +673,7 @@ func (p *Player) Init() {
 	p.hopForwardLeftAnimation = &Animation{
 		game:         p.game,
 		id:           6,
-		spritesheet:  animSpriteSheet,
-		frameWidth:   32,
-		frameHeight:  32,
-		frame0X:      0,
-		frame0Y:      160,
-		frameNum:     6,
+		name:         "bunny/hop_up_left",
 		defaultSpeed: 8,
 		speed:        8,
 		count:        -1,
@@ -384,46 +682,86 @@ func (p *Player) Init() {
 	p.hopForwardRightAnimation = &Animation{
 		game:         p.game,
 		id:           6,
-		spritesheet:  animSpriteSheet,
-		frameWidth:   32,
-		frameHeight:  32,
-		frame0X:      0,
-		frame0Y:      192,
-		frameNum:     6,
+		name:         "bunny/hop_up_right",
 		defaultSpeed: 8,
 		speed:        8,
 		count:        -1,
 	}
 
+	for _, a := range []*Animation{
+		p.idleAnimation, p.hopRightAnimation, p.hopLeftAnimation, p.hopForwardAnimation,
+		p.hopDownAnimation, p.hopForwardLeftAnimation, p.hopForwardRightAnimation,
+	} {
+		a.frames = world.frames(a.name)
+	}
+
 	p.animation = p.idleAnimation
 }
 
 func (p *Player) Update(screen *ebiten.Image) error {
 
 	p.Move()
-	p.animation.Update(screen)
+	if !p.remote {
+		p.enterTile()
+	}
+
+	if err := p.animation.Update(screen, p.x, p.y, p.tint); err != nil {
+		return err
+	}
+
+	// every hop lands back on the first frame, which kicks up a little dust with a thump
+	frame := p.animation.Frame()
+	if frame != p.lastFrame && frame == 0 && p.animation != p.idleAnimation {
+		p.game.events.Defer(PlayerHopped{Player: p, X: p.x, Y: p.y})
+	}
+	p.lastFrame = frame
 
 	return nil
 }
 
+func (p *Player) depth() float64 {
+	return p.y
+}
+
 func (p *Player) Move() {
+	// bunnies on other machines are positioned from snapshots, only their animation needs updating
+	if p.remote {
+		p.UpdateAnimation()
+		return
+	}
+
+	// while networked the server decides where the bunny goes, we just predict it
+	if p.game.net != nil {
+		x, y := p.stick()
+		if err := p.game.net.Send(x, y); err == nil {
+			b := p.game.net.Predicted()
+			p.x, p.y = float64(b.X), float64(b.Y)
+		}
+		p.UpdateAnimation()
+		return
+	}
 
 	if p.MovingUp() {
-		p.game.cameraY += 9 - p.animation.speed
+		p.y -= float64(9 - p.animation.speed)
 	}
 
 	if p.MovingDown() {
-		p.game.cameraY -= 9 - p.animation.speed
+		p.y += float64(9 - p.animation.speed)
 	}
 
 	if p.MovingRight() {
-		p.game.cameraX += 9 - p.animation.speed
+		p.x += float64(9 - p.animation.speed)
 	}
 
 	if p.MovingLeft() {
-		p.game.cameraX -= 9 - p.animation.speed
+		p.x -= float64(9 - p.animation.speed)
 	}
 
+	// keep the bunny from hopping off the edge of the map
+	m := p.game.world.wMap
+	p.x = math.Max(0, math.Min(p.x, float64(m.bgwidth*TileSize)))
+	p.y = math.Max(0, math.Min(p.y, float64(m.bgheight*TileSize)))
+
 	p.UpdateAnimation()
 }
 
@@ -546,108 +884,92 @@ func (p *Player) UpdateAnimation() {
 	}
 }
 
-func (p *Player) MovingRight() bool {
-	if len(p.game.gamepads) > 0 {
-		return p.game.gamepads[0].axes[0] >= 0.30
+//stick returns the direction the player is being moved in, -1 to 1 on each axis with up and left negative
+func (p *Player) stick() (float64, float64) {
+	if p.remote {
+		return p.remoteMoveX, p.remoteMoveY
 	}
-	if p.game.AllowKeyboard {
-		return ebiten.IsKeyPressed(ebiten.KeyD)
+
+	// keys typed into the developer console, or used in a menu, don't move the bunny
+	if p.game.inputCaptured() {
+		return 0, 0
 	}
-	return false
-}
 
-func (p *Player) MovingRightMore() bool {
-	if len(p.game.gamepads) > 0 {
-		return p.game.gamepads[0].axes[0] >= 0.80
+	if p.gamepad != nil && p.gamepad.Connected() {
+		return p.gamepad.Axis(gamepad.AxisLeftX), p.gamepad.Axis(gamepad.AxisLeftY)
 	}
-	if p.game.AllowKeyboard {
-		return ebiten.IsKeyPressed(ebiten.KeyD)
+
+	x, y := 0.0, 0.0
+	if p.keyboard {
+		if ebiten.IsKeyPressed(ebiten.KeyD) {
+			x++
+		}
+		if ebiten.IsKeyPressed(ebiten.KeyA) {
+			x--
+		}
+		if ebiten.IsKeyPressed(ebiten.KeyS) {
+			y++
+		}
+		if ebiten.IsKeyPressed(ebiten.KeyW) {
+			y--
+		}
 	}
-	return false
+	return x, y
+}
+
+func (p *Player) MovingRight() bool {
+	x, _ := p.stick()
+	return x >= 0.30
+}
+
+func (p *Player) MovingRightMore() bool {
+	x, _ := p.stick()
+	return x >= 0.80
 }
 
 func (p *Player) MovingLeft() bool {
-	if len(p.game.gamepads) > 0 {
-		return p.game.gamepads[0].axes[0] <= -0.30
-	}
-	if p.game.AllowKeyboard {
-		return ebiten.IsKeyPressed(ebiten.KeyA)
-	}
-	return false
+	x, _ := p.stick()
+	return x <= -0.30
 }
 
 func (p *Player) MovingLeftMore() bool {
-	if len(p.game.gamepads) > 0 {
-		return p.game.gamepads[0].axes[0] <= -0.80
-	}
-	if p.game.AllowKeyboard {
-		return ebiten.IsKeyPressed(ebiten.KeyA)
-	}
-	return false
+	x, _ := p.stick()
+	return x <= -0.80
 }
 
 func (p *Player) MovingUp() bool {
-	if len(p.game.gamepads) > 0 {
-		if runtime.GOOS != "windows" {
-			return p.game.gamepads[0].axes[1] <= -0.30
-		}
-		return p.game.gamepads[0].axes[1] >= 0.30
-	}
-
-	if p.game.AllowKeyboard {
-		return ebiten.IsKeyPressed(ebiten.KeyW)
-	}
-	return false
+	_, y := p.stick()
+	return y <= -0.30
 }
 
 func (p *Player) MovingUpMore() bool {
-	if len(p.game.gamepads) > 0 {
-		if runtime.GOOS != "windows" {
-			return p.game.gamepads[0].axes[1] <= -0.80
-		}
-		return p.game.gamepads[0].axes[1] >= 0.80
-	}
-	if p.game.AllowKeyboard {
-		return ebiten.IsKeyPressed(ebiten.KeyW)
-	}
-	return false
+	_, y := p.stick()
+	return y <= -0.80
 }
 
 func (p *Player) MovingDown() bool {
-	if len(p.game.gamepads) > 0 {
-		if runtime.GOOS != "windows" {
-			return p.game.gamepads[0].axes[1] >= 0.30
-		}
-		return p.game.gamepads[0].axes[1] <= -0.30
-	}
-	if p.game.AllowKeyboard {
-		return ebiten.IsKeyPressed(ebiten.KeyS)
-	}
-	return false
+	_, y := p.stick()
+	return y >= 0.30
 }
 
 func (p *Player) MovingDownMore() bool {
-	if len(p.game.gamepads) > 0 {
-		if runtime.GOOS != "windows" {
-			return p.game.gamepads[0].axes[1] >= 0.80
-		}
-		return p.game.gamepads[0].axes[1] <= -0.80
-	}
-	if p.game.AllowKeyboard {
-		return ebiten.IsKeyPressed(ebiten.KeyS)
-	}
-
-	return false
+	_, y := p.stick()
+	return y >= 0.80
 }
 
 type Building struct {
 	game        *Game
 	spritesheet *ebiten.Image
-	x           int
-	y           int
-	width       int
-	height      int
-	tileXY      int
+	tileset     *tileset.Tileset
+	// position and size in tiles
+	x      int
+	y      int
+	width  int
+	height int
+	// top left tile of the building's art within the spritesheet
+	tile tileset.TileID
+	// what quests and scripts call the building, none if nothing needs to
+	name string
 }
 
 func (b *Building) Update(screen *ebiten.Image) error {
@@ -657,33 +979,20 @@ func (b *Building) Update(screen *ebiten.Image) error {
 	)
 
 	if !ebiten.IsDrawingSkipped() {
-		scale := ebiten.DeviceScaleFactor()
-
-		sw, sh := screen.Size()
-		// work out width/height scale factor based on percentage of screen size
-		swf := float64(sw / b.game.cameraWidth)
-		shf := float64(sh / b.game.cameraHeight)
-
 		op := &ebiten.DrawImageOptions{}
-		op.GeoM.Translate(float64(b.x+(b.width*spriteSize)), float64(b.y+(b.height*spriteSize)))
-		op.GeoM.Translate(float64(b.game.cameraX*-1)/2, float64(b.game.cameraY)/2)
-		op.GeoM.Scale(scale*swf, scale*shf)
-		op.GeoM.Scale(2, 2)
+		op.GeoM.Translate(float64(b.x*spriteSize), float64(b.y*spriteSize))
+		b.game.applyCamera(op, screen)
 
 		// crop/select sprite from the spritesheet
-		tileX, tileY := utils.SplitNumbers(b.tileXY)
-
-		r := image.Rect(tileX, tileY, tileX+(spriteSize*b.width), tileY+(spriteSize*b.height))
-
+		r := b.tileset.Region(b.tile, b.width, b.height)
 		op.SourceRect = &r
 
-		if b.game.world.nightTime {
-			op.ColorM.ChangeHSV(0.0, 1.0, 0.4)
-		}
+		b.game.world.applyLighting(&op.ColorM)
 
 		if err := screen.DrawImage(b.spritesheet, op); err != nil {
 			return err
 		}
+		b.game.draws[drawBuilding]++
 	}
 
 	return nil
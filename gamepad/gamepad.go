@@ -0,0 +1,135 @@
+package gamepad
+
+//Source provides the raw state of the gamepads currently connected to the machine
+type Source interface {
+	IDs() []int
+	GUID(id int) string
+	Name(id int) string
+	AxisNum(id int) int
+	Axis(id, axis int) float64
+	ButtonNum(id int) int
+	ButtonPressed(id, button int) bool
+}
+
+//Button is a button on the standard gamepad layout
+type Button int
+
+const (
+	ButtonA Button = iota
+	ButtonB
+	ButtonX
+	ButtonY
+	ButtonBack
+	ButtonGuide
+	ButtonStart
+	ButtonLeftStick
+	ButtonRightStick
+	ButtonLeftShoulder
+	ButtonRightShoulder
+	ButtonDPadUp
+	ButtonDPadDown
+	ButtonDPadLeft
+	ButtonDPadRight
+	ButtonNum
+)
+
+//Axis is an axis on the standard gamepad layout, sticks range -1 to 1 with up and left negative, triggers range 0 to 1
+type Axis int
+
+const (
+	AxisLeftX Axis = iota
+	AxisLeftY
+	AxisRightX
+	AxisRightY
+	AxisTriggerLeft
+	AxisTriggerRight
+	AxisNum
+)
+
+//Device is a gamepad which has been connected at some point, kept around after disconnecting so it can be picked back up on reconnect
+type Device struct {
+	guid      string
+	name      string
+	id        int
+	slot      int
+	connected bool
+	mapping   *Mapping
+	rawAxes   []float64
+	rawBtns   []bool
+	axes      [AxisNum]float64
+	buttons   [ButtonNum]bool
+	// buttons held as of the poll before last, to tell when one is first pressed
+	previous [ButtonNum]bool
+}
+
+//GUID returns the SDL compatible GUID of the device
+func (d *Device) GUID() string {
+	return d.guid
+}
+
+//Name returns the name the device reports, or the name from its mapping if it has one
+func (d *Device) Name() string {
+	if d.mapping != nil && d.mapping.Name != "" {
+		return d.mapping.Name
+	}
+	return d.name
+}
+
+//Slot returns the index the device was given when first connected, kept the same across reconnects
+func (d *Device) Slot() int {
+	return d.slot
+}
+
+//Connected returns whether the device is currently connected
+func (d *Device) Connected() bool {
+	return d.connected
+}
+
+//Axis returns the value of an axis on the standard layout, always 0 while disconnected
+func (d *Device) Axis(a Axis) float64 {
+	if a < 0 || a >= AxisNum {
+		return 0
+	}
+	return d.axes[a]
+}
+
+//Pressed returns whether a button on the standard layout is held, always false while disconnected
+func (d *Device) Pressed(b Button) bool {
+	if b < 0 || b >= ButtonNum {
+		return false
+	}
+	return d.buttons[b]
+}
+
+//JustPressed returns whether a button on the standard layout was pressed since the last update
+func (d *Device) JustPressed(b Button) bool {
+	if b < 0 || b >= ButtonNum {
+		return false
+	}
+	return d.buttons[b] && !d.previous[b]
+}
+
+func (d *Device) poll(src Source) {
+	if len(d.rawAxes) != src.AxisNum(d.id) {
+		d.rawAxes = make([]float64, src.AxisNum(d.id))
+	}
+	if len(d.rawBtns) != src.ButtonNum(d.id) {
+		d.rawBtns = make([]bool, src.ButtonNum(d.id))
+	}
+
+	for a := 0; a < len(d.rawAxes); a++ {
+		d.rawAxes[a] = src.Axis(d.id, a)
+	}
+	for b := 0; b < len(d.rawBtns); b++ {
+		d.rawBtns[b] = src.ButtonPressed(d.id, b)
+	}
+
+	d.previous = d.buttons
+	d.mapping.apply(d.rawAxes, d.rawBtns, &d.axes, &d.buttons)
+}
+
+func (d *Device) clear() {
+	d.axes = [AxisNum]float64{}
+	d.buttons = [ButtonNum]bool{}
+	d.previous = [ButtonNum]bool{}
+}
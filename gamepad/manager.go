@@ -0,0 +1,155 @@
+package gamepad
+
+import (
+	"io"
+	"sync"
+)
+
+//Manager keeps track of gamepads across connects and disconnects, presenting each through the standard layout
+type Manager struct {
+	mu      sync.Mutex
+	source  Source
+	db      *DB
+	devices []*Device
+
+	// OnConnect is called when a device connects, including when a previously seen device reconnects
+	OnConnect func(d *Device)
+	// OnDisconnect is called when a device disconnects, the device remains valid and may reconnect later
+	OnDisconnect func(d *Device)
+}
+
+//NewManager creates a manager reading devices from the provided source
+func NewManager(source Source) *Manager {
+	return &Manager{
+		source: source,
+		db:     NewDB(),
+	}
+}
+
+//LoadMappings adds mappings in SDL GameControllerDB format, remapping any devices they apply to
+func (m *Manager) LoadMappings(r io.Reader) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// the mappings which did load apply even if some lines were skipped
+	err := m.db.Load(r)
+	for _, d := range m.devices {
+		d.mapping = m.mappingFor(d.guid)
+	}
+	return err
+}
+
+//Devices returns every device seen so far, in the order they first connected
+func (m *Manager) Devices() []*Device {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	devices := make([]*Device, len(m.devices))
+	copy(devices, m.devices)
+	return devices
+}
+
+//Connected returns the devices currently connected, in the order they first connected
+func (m *Manager) Connected() []*Device {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var devices []*Device
+	for _, d := range m.devices {
+		if d.connected {
+			devices = append(devices, d)
+		}
+	}
+	return devices
+}
+
+//Update picks up any connected or disconnected devices then polls the state of all connected ones
+func (m *Manager) Update() {
+	m.mu.Lock()
+
+	ids := m.source.IDs()
+	present := make(map[int]bool, len(ids))
+	for _, id := range ids {
+		present[id] = true
+	}
+
+	var disconnected, connected []*Device
+
+	for _, d := range m.devices {
+		if d.connected && !present[d.id] {
+			d.connected = false
+			d.clear()
+			disconnected = append(disconnected, d)
+		}
+	}
+
+	for _, id := range ids {
+		if m.connectedDevice(id) != nil {
+			continue
+		}
+		connected = append(connected, m.connect(id))
+	}
+
+	for _, d := range m.devices {
+		if d.connected {
+			d.poll(m.source)
+		}
+	}
+
+	onConnect, onDisconnect := m.OnConnect, m.OnDisconnect
+	m.mu.Unlock()
+
+	// callbacks are made without holding the lock so they're free to call back into the manager
+	if onDisconnect != nil {
+		for _, d := range disconnected {
+			onDisconnect(d)
+		}
+	}
+	if onConnect != nil {
+		for _, d := range connected {
+			onConnect(d)
+		}
+	}
+}
+
+func (m *Manager) connectedDevice(id int) *Device {
+	for _, d := range m.devices {
+		if d.connected && d.id == id {
+			return d
+		}
+	}
+	return nil
+}
+
+//connect marks the device of provided id as connected, reusing the first disconnected device with the same GUID if there is one
+func (m *Manager) connect(id int) *Device {
+	guid := m.source.GUID(id)
+
+	for _, d := range m.devices {
+		if !d.connected && d.guid == guid {
+			d.id = id
+			d.name = m.source.Name(id)
+			d.connected = true
+			return d
+		}
+	}
+
+	d := &Device{
+		guid:      guid,
+		name:      m.source.Name(id),
+		id:        id,
+		slot:      len(m.devices),
+		connected: true,
+		mapping:   m.mappingFor(guid),
+	}
+	m.devices = append(m.devices, d)
+	return d
+}
+
+func (m *Manager) mappingFor(guid string) *Mapping {
+	if mapping := m.db.Lookup(guid); mapping != nil {
+		return mapping
+	}
+	mapping, _ := ParseMapping(defaultMapping)
+	return mapping
+}
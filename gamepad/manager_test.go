@@ -0,0 +1,366 @@
+package gamepad
+
+import (
+	"math"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+//pad is a fake gamepad plugged into a fakeSource
+type pad struct {
+	guid    string
+	name    string
+	axes    []float64
+	buttons []bool
+}
+
+//fakeSource is a Source whose gamepads the test plugs in, unplugs and presses
+type fakeSource struct {
+	pads map[int]*pad
+	// ids in the order they were plugged in, as a real source lists them
+	ids []int
+}
+
+func newFakeSource() *fakeSource {
+	return &fakeSource{pads: map[int]*pad{}}
+}
+
+func (s *fakeSource) plug(id int, guid, name string, axes, buttons int) *pad {
+	p := &pad{guid: guid, name: name, axes: make([]float64, axes), buttons: make([]bool, buttons)}
+	s.pads[id] = p
+	s.ids = append(s.ids, id)
+	return p
+}
+
+func (s *fakeSource) unplug(id int) {
+	delete(s.pads, id)
+	for i, other := range s.ids {
+		if other == id {
+			s.ids = append(s.ids[:i], s.ids[i+1:]...)
+			return
+		}
+	}
+}
+
+func (s *fakeSource) IDs() []int                { return append([]int(nil), s.ids...) }
+func (s *fakeSource) GUID(id int) string        { return s.pads[id].guid }
+func (s *fakeSource) Name(id int) string        { return s.pads[id].name }
+func (s *fakeSource) AxisNum(id int) int        { return len(s.pads[id].axes) }
+func (s *fakeSource) Axis(id, axis int) float64 { return s.pads[id].axes[axis] }
+func (s *fakeSource) ButtonNum(id int) int      { return len(s.pads[id].buttons) }
+func (s *fakeSource) ButtonPressed(id, button int) bool {
+	return s.pads[id].buttons[button]
+}
+
+//recorder keeps the devices a manager reports connecting and disconnecting
+type recorder struct {
+	connected, disconnected []*Device
+}
+
+func record(m *Manager) *recorder {
+	r := &recorder{}
+	m.OnConnect = func(d *Device) { r.connected = append(r.connected, d) }
+	m.OnDisconnect = func(d *Device) { r.disconnected = append(r.disconnected, d) }
+	return r
+}
+
+const (
+	guidA = "03000000aaaa00000000000000000000"
+	guidB = "03000000bbbb00000000000000000000"
+)
+
+func TestConnect(t *testing.T) {
+	src := newFakeSource()
+	m := NewManager(src)
+	r := record(m)
+
+	m.Update()
+	if len(r.connected) != 0 || len(m.Devices()) != 0 {
+		t.Fatalf("devices connected with nothing plugged in")
+	}
+
+	p := src.plug(3, guidA, "Pad A", 4, 11)
+	m.Update()
+	if len(r.connected) != 1 {
+		t.Fatalf("%d connects reported, expected 1", len(r.connected))
+	}
+	d := r.connected[0]
+	if d.GUID() != guidA || d.Name() != "Generic Gamepad" || d.Slot() != 0 || !d.Connected() {
+		t.Errorf("connected device is %s %q in slot %d, connected %v", d.GUID(), d.Name(), d.Slot(), d.Connected())
+	}
+	if c := m.Connected(); len(c) != 1 || c[0] != d {
+		t.Errorf("connected devices are %v, expected just the one plugged in", c)
+	}
+
+	// without a mapping of its own the default one is used, with a on button 0 and the left stick on axes 0 and 1
+	p.buttons[0] = true
+	p.axes[1] = -0.75
+	m.Update()
+	if !d.Pressed(ButtonA) || !d.JustPressed(ButtonA) {
+		t.Errorf("a isn't pressed, or wasn't just pressed")
+	}
+	if d.Axis(AxisLeftY) != -0.75 {
+		t.Errorf("left stick y is %v, expected -0.75", d.Axis(AxisLeftY))
+	}
+	m.Update()
+	if !d.Pressed(ButtonA) || d.JustPressed(ButtonA) {
+		t.Errorf("a held a second update counts as just pressed")
+	}
+	if len(r.connected) != 1 {
+		t.Errorf("a device staying connected was reported connecting again")
+	}
+}
+
+func TestDisconnect(t *testing.T) {
+	src := newFakeSource()
+	m := NewManager(src)
+	r := record(m)
+
+	p := src.plug(0, guidA, "Pad A", 4, 11)
+	m.Update()
+	d := r.connected[0]
+	p.buttons[0] = true
+	p.axes[0] = 1
+	m.Update()
+
+	src.unplug(0)
+	m.Update()
+	if len(r.disconnected) != 1 || r.disconnected[0] != d {
+		t.Fatalf("disconnects reported are %v, expected just the unplugged device", r.disconnected)
+	}
+	if d.Connected() {
+		t.Errorf("unplugged device is still connected")
+	}
+	if d.Pressed(ButtonA) || d.Axis(AxisLeftX) != 0 {
+		t.Errorf("unplugged device still has a held and the stick pushed")
+	}
+	if len(m.Connected()) != 0 || len(m.Devices()) != 1 {
+		t.Errorf("%d connected and %d seen after unplugging, expected 0 and 1", len(m.Connected()), len(m.Devices()))
+	}
+
+	m.Update()
+	if len(r.disconnected) != 1 {
+		t.Errorf("a device staying unplugged was reported disconnecting again")
+	}
+}
+
+func TestReconnectKeepsSlot(t *testing.T) {
+	src := newFakeSource()
+	m := NewManager(src)
+	r := record(m)
+
+	src.plug(0, guidA, "Pad A", 4, 11)
+	src.plug(1, guidB, "Pad B", 4, 11)
+	m.Update()
+	a, b := r.connected[0], r.connected[1]
+	if a.Slot() != 0 || b.Slot() != 1 {
+		t.Fatalf("pads are in slots %d and %d, expected 0 and 1", a.Slot(), b.Slot())
+	}
+
+	src.unplug(0)
+	m.Update()
+
+	// the system gives it a new id plugging back in, it's still the same pad
+	src.plug(7, guidA, "Pad A", 4, 11)
+	m.Update()
+	if len(r.connected) != 3 || r.connected[2] != a {
+		t.Fatalf("reconnecting pad A wasn't reported as the same device")
+	}
+	if !a.Connected() || a.Slot() != 0 {
+		t.Errorf("reconnected pad A is connected %v in slot %d, expected connected in slot 0", a.Connected(), a.Slot())
+	}
+	if len(m.Devices()) != 2 {
+		t.Errorf("%d devices seen, expected reconnecting not to add one", len(m.Devices()))
+	}
+
+	// a different pad takes the next free slot, not A's
+	src.plug(8, "03000000cccc00000000000000000000", "Pad C", 4, 11)
+	m.Update()
+	if c := r.connected[3]; c.Slot() != 2 {
+		t.Errorf("new pad C is in slot %d, expected 2", c.Slot())
+	}
+}
+
+func TestReconnectTwoOfAKind(t *testing.T) {
+	src := newFakeSource()
+	m := NewManager(src)
+	r := record(m)
+
+	// two of the same model share a GUID
+	src.plug(0, guidA, "Pad A", 4, 11)
+	src.plug(1, guidA, "Pad A", 4, 11)
+	m.Update()
+	first, second := r.connected[0], r.connected[1]
+	if first == second || first.Slot() == second.Slot() {
+		t.Fatalf("two pads of the same model were given the same device")
+	}
+
+	src.unplug(0)
+	src.unplug(1)
+	m.Update()
+	src.plug(2, guidA, "Pad A", 4, 11)
+	m.Update()
+	if r.connected[2] != first {
+		t.Errorf("a pad of the model reconnecting didn't take the first slot it had")
+	}
+}
+
+func TestCallbacksCanUseTheManager(t *testing.T) {
+	src := newFakeSource()
+	m := NewManager(src)
+	seen := 0
+	m.OnConnect = func(d *Device) {
+		// would deadlock if the manager held its lock making callbacks
+		seen = len(m.Connected())
+	}
+	src.plug(0, guidA, "Pad A", 4, 11)
+	m.Update()
+	if seen != 1 {
+		t.Errorf("saw %d connected from the callback, expected 1", seen)
+	}
+}
+
+func TestSDLMapping(t *testing.T) {
+	src := newFakeSource()
+	m := NewManager(src)
+	r := record(m)
+
+	// 2 real buttons then one hat's 4 buttons, as the source reports them
+	p := src.plug(0, guidA, "Pad A", 4, 6)
+	m.Update()
+	d := r.connected[0]
+
+	mappings := strings.Join([]string{
+		"# a comment",
+		guidA + ",Mapped Pad,a:b1,b:b0,leftx:a1,lefty:a0~,righttrigger:a2,-rightx:-a3,+rightx:+a3,dpup:h0.1,dpleft:h0.8,",
+		// for another platform, so never used
+		guidB + ",Other Platform Pad,a:b0,platform:Nowhere,",
+	}, "\n")
+	if err := m.LoadMappings(strings.NewReader(mappings)); err != nil {
+		t.Fatal(err)
+	}
+	if d.Name() != "Mapped Pad" {
+		t.Errorf("already connected device is called %q, expected its mapping's name", d.Name())
+	}
+
+	p.buttons[1] = true
+	p.axes[0] = 0.5
+	p.axes[1] = -0.25
+	p.axes[2] = -1
+	p.axes[3] = -0.5
+	// the hat's up
+	p.buttons[2] = true
+	m.Update()
+
+	if !d.Pressed(ButtonA) || d.Pressed(ButtonB) {
+		t.Errorf("a and b aren't swapped")
+	}
+	if d.Axis(AxisLeftX) != -0.25 {
+		t.Errorf("left x is %v, expected axis 1's -0.25", d.Axis(AxisLeftX))
+	}
+	if d.Axis(AxisLeftY) != -0.5 {
+		t.Errorf("left y is %v, expected axis 0's 0.5 inverted", d.Axis(AxisLeftY))
+	}
+	if d.Axis(AxisTriggerRight) != 0 {
+		t.Errorf("right trigger is %v, expected a whole axis at -1 to be released", d.Axis(AxisTriggerRight))
+	}
+	if math.Abs(d.Axis(AxisRightX)+0.5) > 1e-9 {
+		t.Errorf("right x is %v, expected -0.5 from the negative half", d.Axis(AxisRightX))
+	}
+	if !d.Pressed(ButtonDPadUp) || d.Pressed(ButtonDPadLeft) {
+		t.Errorf("the hat pressing up isn't just d-pad up")
+	}
+
+	p.axes[2] = 1
+	p.axes[3] = 0.5
+	p.buttons[2], p.buttons[5] = false, true
+	m.Update()
+	if d.Axis(AxisTriggerRight) != 1 {
+		t.Errorf("right trigger is %v, expected fully pressed", d.Axis(AxisTriggerRight))
+	}
+	if math.Abs(d.Axis(AxisRightX)-0.5) > 1e-9 {
+		t.Errorf("right x is %v, expected 0.5 from the positive half", d.Axis(AxisRightX))
+	}
+	if d.Pressed(ButtonDPadUp) || !d.Pressed(ButtonDPadLeft) {
+		t.Errorf("the hat pressing left isn't just d-pad left")
+	}
+
+	// a pad the other platform's mapping is for falls back to the default
+	src.plug(1, guidB, "Pad B", 4, 11)
+	m.Update()
+	if b := r.connected[1]; b.Name() != "Generic Gamepad" {
+		t.Errorf("pad B is called %q, expected the default mapping rather than another platform's", b.Name())
+	}
+}
+
+func TestPlatformMapping(t *testing.T) {
+	platform := platformNames[runtime.GOOS]
+	if platform == "" {
+		t.Skipf("SDL has no name for %s", runtime.GOOS)
+	}
+	db := NewDB()
+	if err := db.Load(strings.NewReader(strings.ToUpper(guidA) + ",Ours,a:b0,platform:" + platform + ",")); err != nil {
+		t.Fatal(err)
+	}
+	if m := db.Lookup(guidA); m == nil || m.Name != "Ours" {
+		t.Errorf("mapping for this platform wasn't found by its lower case GUID")
+	}
+}
+
+func TestBadMappings(t *testing.T) {
+	for _, line := range []string{
+		"",
+		guidA,
+		guidA + ",Pad,a",
+		guidA + ",Pad,a:q0",
+		guidA + ",Pad,dpup:h0",
+		guidA + ",Pad,a:bx",
+	} {
+		if _, err := ParseMapping(line); err == nil {
+			t.Errorf("parsed broken mapping %q", line)
+		}
+	}
+	if err := NewManager(newFakeSource()).LoadMappings(strings.NewReader("ok,Pad,a:b0\nbroken")); err == nil || !strings.Contains(err.Error(), "line 2") {
+		t.Errorf("expected an error for line 2, got %v", err)
+	}
+}
+
+func TestUpstreamMappingFields(t *testing.T) {
+	// as in the upstream gamecontrollerdb.txt, with a hint and a checksum whose values aren't bindings
+	line := "050000007e0500000920000001000000,Nintendo Switch Pro Controller,a:b0,b:b1,back:b9,crc:8a3f,dpdown:h0.4," +
+		"dpleft:h0.8,dpright:h0.2,dpup:h0.1,guide:b11,leftshoulder:b5,leftstick:b12,lefttrigger:b7,leftx:a0,lefty:a1," +
+		"rightshoulder:b6,rightstick:b13,righttrigger:b8,rightx:a2,righty:a3,start:b10,x:b3,y:b2," +
+		"hint:!SDL_GAMECONTROLLER_USE_BUTTON_LABELS:=1,misc1:b14,platform:Linux,"
+	m, err := ParseMapping(line)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Name != "Nintendo Switch Pro Controller" || m.Platform != "Linux" {
+		t.Errorf("mapping is %q for %q", m.Name, m.Platform)
+	}
+	if len(m.buttons[ButtonStart]) != 1 || m.buttons[ButtonStart][0].index != 10 {
+		t.Errorf("start isn't bound to button 10 past the checksum")
+	}
+	if len(m.axes[AxisRightY]) != 1 || m.axes[AxisRightY][0].index != 3 {
+		t.Errorf("right y isn't bound to axis 3")
+	}
+}
+
+func TestLoadSkipsBadLines(t *testing.T) {
+	db := NewDB()
+	err := db.Load(strings.NewReader(strings.Join([]string{
+		guidA + ",First,a:q0,",
+		"# a comment",
+		guidB + ",Second,a:b1,hint:!SDL_GAMECONTROLLER_USE_BUTTON_LABELS:=1,",
+	}, "\n")))
+	if err == nil || !strings.Contains(err.Error(), "line 1") {
+		t.Errorf("expected an error for line 1, got %v", err)
+	}
+	if db.Lookup(guidA) != nil {
+		t.Errorf("the broken mapping was kept")
+	}
+	if m := db.Lookup(guidB); m == nil || m.Name != "Second" {
+		t.Errorf("the mapping after a broken line wasn't loaded")
+	}
+}
@@ -0,0 +1,330 @@
+package gamepad
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"runtime"
+	"strconv"
+	"strings"
+)
+
+// used for devices missing from the mapping database, lays out buttons and axes in the order most pads report them
+const defaultMapping = "default,Generic Gamepad,a:b0,b:b1,x:b2,y:b3,leftshoulder:b4,rightshoulder:b5,back:b6,start:b7,guide:b8,leftstick:b9,rightstick:b10,leftx:a0,lefty:a1,rightx:a2,righty:a3,"
+
+var buttonNames = map[string]Button{
+	"a":             ButtonA,
+	"b":             ButtonB,
+	"x":             ButtonX,
+	"y":             ButtonY,
+	"back":          ButtonBack,
+	"guide":         ButtonGuide,
+	"start":         ButtonStart,
+	"leftstick":     ButtonLeftStick,
+	"rightstick":    ButtonRightStick,
+	"leftshoulder":  ButtonLeftShoulder,
+	"rightshoulder": ButtonRightShoulder,
+	"dpup":          ButtonDPadUp,
+	"dpdown":        ButtonDPadDown,
+	"dpleft":        ButtonDPadLeft,
+	"dpright":       ButtonDPadRight,
+}
+
+var axisNames = map[string]Axis{
+	"leftx":        AxisLeftX,
+	"lefty":        AxisLeftY,
+	"rightx":       AxisRightX,
+	"righty":       AxisRightY,
+	"lefttrigger":  AxisTriggerLeft,
+	"righttrigger": AxisTriggerRight,
+}
+
+// SDL's names for the platforms a mapping can be restricted to
+var platformNames = map[string]string{
+	"windows": "Windows",
+	"darwin":  "Mac OS X",
+	"linux":   "Linux",
+	"android": "Android",
+	"ios":     "iOS",
+}
+
+type inputKind int
+
+const (
+	inputNone inputKind = iota
+	inputButton
+	inputAxis
+	inputHat
+)
+
+//binding is the raw input a single element of the standard layout reads from
+type binding struct {
+	kind    inputKind
+	index   int
+	hatMask int
+	// which half of a raw axis is read, 0 for the whole axis
+	half   int
+	invert bool
+}
+
+//axisBinding is a binding onto a standard axis, which may only drive one half of it
+type axisBinding struct {
+	binding
+	outHalf int
+}
+
+//Mapping translates the raw buttons and axes of a device onto the standard layout, in SDL GameControllerDB format
+type Mapping struct {
+	GUID     string
+	Name     string
+	Platform string
+	buttons  [ButtonNum][]binding
+	axes     [AxisNum][]axisBinding
+	hatCount int
+}
+
+//ParseMapping parses a single line of an SDL GameControllerDB
+func ParseMapping(line string) (*Mapping, error) {
+	fields := strings.Split(strings.TrimSpace(line), ",")
+	if len(fields) < 2 || fields[0] == "" {
+		return nil, fmt.Errorf("mapping needs at least a GUID and name")
+	}
+
+	m := &Mapping{
+		GUID: strings.ToLower(fields[0]),
+		Name: fields[1],
+	}
+
+	for _, field := range fields[2:] {
+		if field == "" {
+			continue
+		}
+
+		kv := strings.SplitN(field, ":", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("mapping %s: malformed element %q", m.GUID, field)
+		}
+		key, value := kv[0], kv[1]
+
+		if key == "platform" {
+			m.Platform = value
+			continue
+		}
+
+		outHalf := 0
+		if strings.HasPrefix(key, "+") {
+			outHalf, key = 1, key[1:]
+		} else if strings.HasPrefix(key, "-") {
+			outHalf, key = -1, key[1:]
+		}
+
+		// anything else is a hint, a checksum or a newer addition to the layout (paddles, touchpads) which we don't
+		// use, and whose value needn't be a binding at all
+		button, isButton := buttonNames[key]
+		axis, isAxis := axisNames[key]
+		if !isButton && !isAxis {
+			continue
+		}
+
+		b, err := parseBinding(value)
+		if err != nil {
+			return nil, fmt.Errorf("mapping %s: element %q: %v", m.GUID, field, err)
+		}
+
+		if b.kind == inputHat && b.index+1 > m.hatCount {
+			m.hatCount = b.index + 1
+		}
+
+		if isButton {
+			m.buttons[button] = append(m.buttons[button], b)
+		} else {
+			m.axes[axis] = append(m.axes[axis], axisBinding{binding: b, outHalf: outHalf})
+		}
+	}
+
+	return m, nil
+}
+
+func parseBinding(value string) (binding, error) {
+	b := binding{}
+
+	if strings.HasPrefix(value, "+") {
+		b.half, value = 1, value[1:]
+	} else if strings.HasPrefix(value, "-") {
+		b.half, value = -1, value[1:]
+	}
+
+	if strings.HasSuffix(value, "~") {
+		b.invert, value = true, value[:len(value)-1]
+	}
+
+	if len(value) < 2 {
+		return b, fmt.Errorf("input too short")
+	}
+
+	switch value[0] {
+	case 'b':
+		b.kind = inputButton
+	case 'a':
+		b.kind = inputAxis
+	case 'h':
+		b.kind = inputHat
+		parts := strings.SplitN(value[1:], ".", 2)
+		if len(parts) != 2 {
+			return b, fmt.Errorf("hat input missing mask")
+		}
+		index, err := strconv.Atoi(parts[0])
+		if err != nil {
+			return b, err
+		}
+		mask, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return b, err
+		}
+		b.index, b.hatMask = index, mask
+		return b, nil
+	default:
+		return b, fmt.Errorf("unknown input type %q", value[0])
+	}
+
+	index, err := strconv.Atoi(value[1:])
+	if err != nil {
+		return b, err
+	}
+	b.index = index
+
+	return b, nil
+}
+
+//forThisPlatform returns whether the mapping applies to the platform the game is running on
+func (m *Mapping) forThisPlatform() bool {
+	return m.Platform == "" || m.Platform == platformNames[runtime.GOOS]
+}
+
+//value reads the binding from raw device state, 0 to 1 for buttons, hats and half axes, -1 to 1 for whole axes
+func (b binding) value(rawAxes []float64, rawBtns []bool, hatBase int) float64 {
+	switch b.kind {
+	case inputButton:
+		if b.index < len(rawBtns) && rawBtns[b.index] {
+			return 1
+		}
+	case inputHat:
+		// hats come through as four extra buttons each (up, right, down, left) after the device's real buttons
+		bit := 0
+		for mask := b.hatMask; mask > 1; mask >>= 1 {
+			bit++
+		}
+		i := hatBase + b.index*4 + bit
+		if i >= 0 && i < len(rawBtns) && rawBtns[i] {
+			return 1
+		}
+	case inputAxis:
+		if b.index >= len(rawAxes) {
+			return 0
+		}
+		v := rawAxes[b.index]
+		if b.invert {
+			v = -v
+		}
+		switch {
+		case b.half > 0 && v < 0:
+			v = 0
+		case b.half < 0:
+			if v > 0 {
+				v = 0
+			}
+			v = -v
+		}
+		return v
+	}
+	return 0
+}
+
+//wholeAxis returns whether the binding reads the full -1 to 1 range of an axis
+func (b binding) wholeAxis() bool {
+	return b.kind == inputAxis && b.half == 0
+}
+
+func (m *Mapping) apply(rawAxes []float64, rawBtns []bool, axes *[AxisNum]float64, buttons *[ButtonNum]bool) {
+	hatBase := len(rawBtns) - m.hatCount*4
+
+	for button, bindings := range m.buttons {
+		pressed := false
+		for _, b := range bindings {
+			if b.value(rawAxes, rawBtns, hatBase) > 0.5 {
+				pressed = true
+				break
+			}
+		}
+		buttons[button] = pressed
+	}
+
+	for axis, bindings := range m.axes {
+		trigger := Axis(axis) == AxisTriggerLeft || Axis(axis) == AxisTriggerRight
+		v := 0.0
+		for _, b := range bindings {
+			bv := b.value(rawAxes, rawBtns, hatBase)
+			// squash whole axes into 0 to 1 when they only drive half an output, or a trigger
+			if b.wholeAxis() && (b.outHalf != 0 || trigger) {
+				bv = (bv + 1) / 2
+			}
+			if b.outHalf < 0 {
+				bv = -bv
+			}
+			v += bv
+		}
+		if v > 1 {
+			v = 1
+		} else if v < -1 {
+			v = -1
+		}
+		axes[axis] = v
+	}
+}
+
+//DB is a collection of mappings looked up by device GUID
+type DB struct {
+	mappings map[string]*Mapping
+}
+
+//NewDB creates an empty mapping database
+func NewDB() *DB {
+	return &DB{mappings: map[string]*Mapping{}}
+}
+
+//Load reads mappings in SDL GameControllerDB format, skipping those for other platforms. A line which won't parse
+//is skipped rather than losing the rest of the file, the lines skipped being returned together as the error
+func (db *DB) Load(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	lineNum := 0
+	var skipped []string
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		m, err := ParseMapping(line)
+		if err != nil {
+			skipped = append(skipped, fmt.Sprintf("line %d: %v", lineNum, err))
+			continue
+		}
+
+		if m.forThisPlatform() {
+			db.mappings[m.GUID] = m
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if len(skipped) > 0 {
+		return fmt.Errorf("skipped %d mappings: %s", len(skipped), strings.Join(skipped, "; "))
+	}
+	return nil
+}
+
+//Lookup returns the mapping for the device of provided GUID, nil if there isn't one
+func (db *DB) Lookup(guid string) *Mapping {
+	return db.mappings[strings.ToLower(guid)]
+}
@@ -2,31 +2,75 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	_ "image/png"
+	"os"
+	"time"
 
 	"github.com/tacusci/logging"
 
 	"github.com/hajimehoshi/ebiten"
+	"github.com/tauraamui/berrybun/config"
 	"github.com/tauraamui/berrybun/game"
+	"github.com/tauraamui/berrybun/netplay"
 )
 
-func parseOptionFlags(g *game.Game) {
-	flag.BoolVar(&g.Debug, "dbg", false, "Enable game's debug mode")
-	flag.BoolVar(&g.Fullscreen, "fs", false, "Set game to be fullscreen")
-	flag.BoolVar(&g.AllowKeyboard, "allowkbrd", false, "Turns on accepting keyboard based controls")
+//serve runs the multiplayer server without opening a window, until the process is killed
+func serve(cfg *config.Config) {
+	server := netplay.NewServer(netplay.Bounds{
+		Width:  float32(cfg.MapWidth * game.TileSize),
+		Height: float32(cfg.MapHeight * game.TileSize),
+	}, cfg.Seed)
 
-	flag.Parse()
+	logging.Info(fmt.Sprintf("multiplayer server listening on %s with map seed %d", cfg.ServerAddress, cfg.Seed))
+
+	if err := server.ListenAndServe(cfg.ServerAddress); err != nil {
+		panic(err)
+	}
 }
 
 func main() {
-	var game = game.Game{}
+	if len(os.Args) > 1 && os.Args[1] == "atlas" {
+		if err := runAtlas(os.Args[2:]); err != nil {
+			logging.Error(err.Error())
+			os.Exit(1)
+		}
+		return
+	}
 
-	parseOptionFlags(&game)
+	if len(os.Args) > 1 && os.Args[1] == "dialogue" {
+		if err := runDialogue(os.Args[2:]); err != nil {
+			logging.Error(err.Error())
+			os.Exit(1)
+		}
+		return
+	}
+
+	cfg, err := config.Load(os.Args[1:])
+	if err == flag.ErrHelp {
+		return
+	}
+	if err != nil {
+		logging.Error(err.Error())
+		os.Exit(1)
+	}
 
-	if game.Debug {
+	if cfg.Debug {
 		logging.SetLevel(logging.DebugLevel)
+		logging.Debug(fmt.Sprintf("using config file %s", cfg.Path()))
 	}
 
+	if cfg.Seed == 0 {
+		cfg.Seed = uint64(time.Now().UnixNano())
+	}
+
+	if cfg.Server {
+		serve(cfg)
+		return
+	}
+
+	var game = game.Game{Config: cfg}
+
 	game.Init()
 
 	w, h := ebiten.MonitorSize()
@@ -41,7 +85,7 @@ func main() {
 
 	s := ebiten.DeviceScaleFactor()
 
-	var sw, sh int = 800, 600
+	var sw, sh int = game.WindowWidth, game.WindowHeight
 
 	if game.Fullscreen {
 		sw, sh = int(float64(w)*s), int(float64(h)*s)
@@ -50,4 +94,8 @@ func main() {
 	if err := ebiten.Run(game.Update, sw, sh, 1/s, "Berrybun Game"); err != nil {
 		panic(err)
 	}
+
+	if err := game.SaveProgress(); err != nil {
+		logging.Error(err.Error())
+	}
 }
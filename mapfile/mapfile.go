@@ -0,0 +1,289 @@
+package mapfile
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"image/color"
+	"io"
+	"io/ioutil"
+
+	"github.com/tauraamui/berrybun/tileset"
+)
+
+//Version is the version of the format written by Save, older versions are upgraded as they're loaded
+const Version = 1
+
+//File is a map as stored on disk
+type File struct {
+	Width  int
+	Height int
+	// seed the map was first generated from, kept so anything else seeded from it still matches
+	Seed uint64
+	// name of the tileset descriptor the tile IDs index into
+	Tileset   string
+	Layers    []Layer
+	Buildings []Building
+	Triggers  []Trigger
+}
+
+//Layer is one tile layer of a map, its tiles indexed [y*width+x]
+type Layer struct {
+	Name          string
+	Opacity       float64
+	ParallaxX     float64
+	ParallaxY     float64
+	Tint          color.RGBA
+	Visible       bool
+	AboveEntities bool
+	Tiles         []tileset.TileID
+}
+
+//Building is a building placed on a map, its position and size in tiles
+type Building struct {
+	X      int
+	Y      int
+	Width  int
+	Height int
+	// top left tile of the building's art
+	Tile tileset.TileID
+	// what quests and scripts call the building, none if nothing needs to
+	Name string
+}
+
+//Trigger is a zone of a map, its position and size in tiles, which calls functions of a Lua script as bunnies enter
+//and leave
+type Trigger struct {
+	X      int
+	Y      int
+	Width  int
+	Height int
+	// asset name of the script, and the functions of it called on entering and leaving, none to do nothing
+	Script string
+	Enter  string
+	Exit   string
+}
+
+// the JSON layout of a map file
+type fileJSON struct {
+	Version   int            `json:"version"`
+	Width     int            `json:"width"`
+	Height    int            `json:"height"`
+	Seed      uint64         `json:"seed"`
+	Tileset   string         `json:"tileset"`
+	Layers    []layerJSON    `json:"layers"`
+	Buildings []buildingJSON `json:"buildings"`
+	Triggers  []triggerJSON  `json:"triggers,omitempty"`
+}
+
+type layerJSON struct {
+	Name          string   `json:"name"`
+	Opacity       float64  `json:"opacity"`
+	ParallaxX     float64  `json:"parallaxX"`
+	ParallaxY     float64  `json:"parallaxY"`
+	Tint          [4]uint8 `json:"tint"`
+	Visible       bool     `json:"visible"`
+	AboveEntities bool     `json:"aboveEntities"`
+	// tile IDs as little endian int16s, zlib compressed then base64 encoded
+	Data string `json:"data"`
+}
+
+type buildingJSON struct {
+	X      int    `json:"x"`
+	Y      int    `json:"y"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	Tile   int    `json:"tile"`
+	Name   string `json:"name,omitempty"`
+}
+
+type triggerJSON struct {
+	X      int    `json:"x"`
+	Y      int    `json:"y"`
+	Width  int    `json:"width"`
+	Height int    `json:"height"`
+	Script string `json:"script"`
+	Enter  string `json:"enter,omitempty"`
+	Exit   string `json:"exit,omitempty"`
+}
+
+//Load reads a map file
+func Load(r io.Reader) (*File, error) {
+	var fj fileJSON
+	if err := json.NewDecoder(r).Decode(&fj); err != nil {
+		return nil, fmt.Errorf("unable to decode map: %v", err)
+	}
+
+	if fj.Version < 1 || fj.Version > Version {
+		return nil, fmt.Errorf("unsupported map version %d", fj.Version)
+	}
+	if fj.Width <= 0 || fj.Height <= 0 {
+		return nil, fmt.Errorf("map size %dx%d must be positive", fj.Width, fj.Height)
+	}
+
+	f := &File{
+		Width:   fj.Width,
+		Height:  fj.Height,
+		Seed:    fj.Seed,
+		Tileset: fj.Tileset,
+	}
+
+	for _, lj := range fj.Layers {
+		tiles, err := decodeTiles(lj.Data, fj.Width*fj.Height)
+		if err != nil {
+			return nil, fmt.Errorf("layer %s: %v", lj.Name, err)
+		}
+		f.Layers = append(f.Layers, Layer{
+			Name:          lj.Name,
+			Opacity:       lj.Opacity,
+			ParallaxX:     lj.ParallaxX,
+			ParallaxY:     lj.ParallaxY,
+			Tint:          color.RGBA{lj.Tint[0], lj.Tint[1], lj.Tint[2], lj.Tint[3]},
+			Visible:       lj.Visible,
+			AboveEntities: lj.AboveEntities,
+			Tiles:         tiles,
+		})
+	}
+
+	for _, bj := range fj.Buildings {
+		f.Buildings = append(f.Buildings, Building{
+			X:      bj.X,
+			Y:      bj.Y,
+			Width:  bj.Width,
+			Height: bj.Height,
+			Tile:   tileset.TileID(bj.Tile),
+			Name:   bj.Name,
+		})
+	}
+
+	for i, tj := range fj.Triggers {
+		if tj.Width <= 0 || tj.Height <= 0 {
+			return nil, fmt.Errorf("trigger %d size %dx%d must be positive", i, tj.Width, tj.Height)
+		}
+		if tj.Script == "" {
+			return nil, fmt.Errorf("trigger %d has no script", i)
+		}
+		if tj.Enter == "" && tj.Exit == "" {
+			return nil, fmt.Errorf("trigger %d runs nothing on entering or leaving", i)
+		}
+		f.Triggers = append(f.Triggers, Trigger{
+			X:      tj.X,
+			Y:      tj.Y,
+			Width:  tj.Width,
+			Height: tj.Height,
+			Script: tj.Script,
+			Enter:  tj.Enter,
+			Exit:   tj.Exit,
+		})
+	}
+
+	return f, nil
+}
+
+//Save writes the map in the current version of the format
+func (f *File) Save(w io.Writer) error {
+	fj := fileJSON{
+		Version:   Version,
+		Width:     f.Width,
+		Height:    f.Height,
+		Seed:      f.Seed,
+		Tileset:   f.Tileset,
+		Layers:    []layerJSON{},
+		Buildings: []buildingJSON{},
+	}
+
+	for _, l := range f.Layers {
+		if len(l.Tiles) != f.Width*f.Height {
+			return fmt.Errorf("layer %s has %d tiles, expected %d", l.Name, len(l.Tiles), f.Width*f.Height)
+		}
+		data, err := encodeTiles(l.Tiles)
+		if err != nil {
+			return fmt.Errorf("layer %s: %v", l.Name, err)
+		}
+		fj.Layers = append(fj.Layers, layerJSON{
+			Name:          l.Name,
+			Opacity:       l.Opacity,
+			ParallaxX:     l.ParallaxX,
+			ParallaxY:     l.ParallaxY,
+			Tint:          [4]uint8{l.Tint.R, l.Tint.G, l.Tint.B, l.Tint.A},
+			Visible:       l.Visible,
+			AboveEntities: l.AboveEntities,
+			Data:          data,
+		})
+	}
+
+	for _, b := range f.Buildings {
+		fj.Buildings = append(fj.Buildings, buildingJSON{
+			X:      b.X,
+			Y:      b.Y,
+			Width:  b.Width,
+			Height: b.Height,
+			Tile:   int(b.Tile),
+			Name:   b.Name,
+		})
+	}
+
+	for _, t := range f.Triggers {
+		fj.Triggers = append(fj.Triggers, triggerJSON{
+			X:      t.X,
+			Y:      t.Y,
+			Width:  t.Width,
+			Height: t.Height,
+			Script: t.Script,
+			Enter:  t.Enter,
+			Exit:   t.Exit,
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(fj)
+}
+
+func encodeTiles(tiles []tileset.TileID) (string, error) {
+	var buf bytes.Buffer
+	zw := zlib.NewWriter(&buf)
+
+	raw := make([]byte, len(tiles)*2)
+	for i, t := range tiles {
+		binary.LittleEndian.PutUint16(raw[i*2:], uint16(int16(t)))
+	}
+	if _, err := zw.Write(raw); err != nil {
+		return "", err
+	}
+	if err := zw.Close(); err != nil {
+		return "", err
+	}
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+func decodeTiles(data string, count int) ([]tileset.TileID, error) {
+	compressed, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode tile data: %v", err)
+	}
+
+	zr, err := zlib.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decompress tile data: %v", err)
+	}
+	defer zr.Close()
+
+	raw, err := ioutil.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decompress tile data: %v", err)
+	}
+	if len(raw) != count*2 {
+		return nil, fmt.Errorf("has %d tiles, expected %d", len(raw)/2, count)
+	}
+
+	tiles := make([]tileset.TileID, count)
+	for i := range tiles {
+		tiles[i] = tileset.TileID(int16(binary.LittleEndian.Uint16(raw[i*2:])))
+	}
+	return tiles, nil
+}
@@ -0,0 +1,244 @@
+//Package mods finds mods in a directory, each a directory of asset files with a manifest declaring which of the
+//game's assets it overrides and which it adds, and works out the order to load them in from what each requires.
+//
+//A mod's manifest, mod.json, looks like:
+//
+//	{
+//		"id": "spooky",
+//		"name": "Spooky Woods",
+//		"version": "1.0",
+//		"requires": ["more_foxes"],
+//		"overrides": {"spritesheets": ["map.png"]},
+//		"adds": {"maps": ["maps/woods.json"], "scripts": ["scripts/haunted.lua"]}
+//	}
+package mods
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+//ManifestFile is the name of the manifest at the top of every mod's directory
+const ManifestFile = "mod.json"
+
+//Files are asset files by kind, named as the game loads them, such as scripts/burrow.lua
+type Files struct {
+	// tileset descriptors, such as map.json
+	Tilesets []string `json:"tilesets"`
+	// images sprites and tiles are cut from, such as map.png or atlas_0.png
+	Spritesheets []string `json:"spritesheets"`
+	// map files, which can be played with -map
+	Maps []string `json:"maps"`
+	// sprite atlas descriptors naming each animation's frames, such as atlas.json
+	Animations []string `json:"animations"`
+	// dialogue scripts, and the Lua scripts trigger zones run
+	Scripts []string `json:"scripts"`
+}
+
+// the file extensions each kind of file may have
+var extensions = []struct {
+	kind string
+	exts []string
+	list func(f Files) []string
+}{
+	{"tileset", []string{".json"}, func(f Files) []string { return f.Tilesets }},
+	{"spritesheet", []string{".png"}, func(f Files) []string { return f.Spritesheets }},
+	{"map", []string{".json"}, func(f Files) []string { return f.Maps }},
+	{"animation", []string{".json"}, func(f Files) []string { return f.Animations }},
+	{"script", []string{".yarn", ".lua"}, func(f Files) []string { return f.Scripts }},
+}
+
+//All returns every file, sorted
+func (f Files) All() []string {
+	var all []string
+	for _, e := range extensions {
+		all = append(all, e.list(f)...)
+	}
+	sort.Strings(all)
+	return all
+}
+
+//Manifest describes a mod, what it needs and what it changes
+type Manifest struct {
+	// what other mods require the mod by, unique among mods
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Version     string `json:"version"`
+	Description string `json:"description"`
+	// ids of mods which must be loaded before this one
+	Requires []string `json:"requires"`
+	// the game's assets the mod replaces, and new ones it brings
+	Overrides Files `json:"overrides"`
+	Adds      Files `json:"adds"`
+}
+
+//ParseManifest reads a mod's manifest, checking every file it declares is of the right kind and declared just once
+func ParseManifest(r io.Reader) (*Manifest, error) {
+	var m Manifest
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&m); err != nil {
+		return nil, fmt.Errorf("unable to parse manifest: %v", err)
+	}
+
+	if m.ID == "" {
+		return nil, fmt.Errorf("manifest has no id")
+	}
+	if strings.ContainsAny(m.ID, " /\\") {
+		return nil, fmt.Errorf("mod id %q can't have spaces or slashes", m.ID)
+	}
+	if m.Name == "" {
+		m.Name = m.ID
+	}
+
+	declared := map[string]bool{}
+	for _, files := range []Files{m.Overrides, m.Adds} {
+		for _, e := range extensions {
+			for _, name := range e.list(files) {
+				if !fs.ValidPath(name) || name == "." {
+					return nil, fmt.Errorf("%s %q must be a path within the mod, using forward slashes", e.kind, name)
+				}
+				if !hasExtension(name, e.exts) {
+					return nil, fmt.Errorf("%s %s must be a %s file", e.kind, name, strings.Join(e.exts, " or "))
+				}
+				if name == ManifestFile {
+					return nil, fmt.Errorf("the manifest can't be one of the mod's assets")
+				}
+				if declared[name] {
+					return nil, fmt.Errorf("%s is declared more than once", name)
+				}
+				declared[name] = true
+			}
+		}
+	}
+	return &m, nil
+}
+
+func hasExtension(name string, exts []string) bool {
+	for _, ext := range exts {
+		if path.Ext(name) == ext {
+			return true
+		}
+	}
+	return false
+}
+
+//Mod is a mod found on disk
+type Mod struct {
+	Manifest
+	// directory the mod was found in
+	Dir string
+	// the files the manifest declares, and nothing else in the mod's directory
+	FS fs.FS
+}
+
+//String returns the mod's id and version, such as spooky 1.2
+func (m *Mod) String() string {
+	if m.Version == "" {
+		return m.ID
+	}
+	return m.ID + " " + m.Version
+}
+
+//declaredFS is a mod's directory, only opening the files its manifest declares
+type declaredFS struct {
+	fsys  fs.FS
+	files map[string]bool
+}
+
+func (d declaredFS) Open(name string) (fs.File, error) {
+	if !d.files[name] {
+		return nil, &fs.PathError{Op: "open", Path: name, Err: fs.ErrNotExist}
+	}
+	return d.fsys.Open(name)
+}
+
+//Drop leaves a file out of the mod, as if its manifest didn't declare it
+func (m *Mod) Drop(name string) {
+	if d, ok := m.FS.(declaredFS); ok {
+		delete(d.files, name)
+	}
+}
+
+//Load reads the mod in a directory, checking every file its manifest declares is there
+func Load(dir string) (*Mod, error) {
+	f, err := os.Open(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	manifest, err := ParseManifest(f)
+	if err != nil {
+		return nil, fmt.Errorf("mod in %s: %v", dir, err)
+	}
+
+	fsys := os.DirFS(dir)
+	files := map[string]bool{}
+	for _, name := range append(manifest.Overrides.All(), manifest.Adds.All()...) {
+		if _, err := fs.Stat(fsys, name); err != nil {
+			return nil, fmt.Errorf("mod %s declares %s, which it doesn't have", manifest.ID, name)
+		}
+		files[name] = true
+	}
+	return &Mod{Manifest: *manifest, Dir: dir, FS: declaredFS{fsys: fsys, files: files}}, nil
+}
+
+//Discover loads every mod in the directories within dir, sorted by id. A mod which can't be loaded is left out,
+//with why among the errors returned, and there are no mods if dir doesn't exist.
+func Discover(dir string) ([]*Mod, []error) {
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, []error{fmt.Errorf("unable to look for mods: %v", err)}
+	}
+
+	var found []*Mod
+	var errs []error
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		m, err := Load(filepath.Join(dir, e.Name()))
+		if os.IsNotExist(err) {
+			errs = append(errs, fmt.Errorf("%s has no %s, it isn't a mod", filepath.Join(dir, e.Name()), ManifestFile))
+			continue
+		}
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		found = append(found, m)
+	}
+	sort.Slice(found, func(i, j int) bool {
+		return found[i].ID < found[j].ID
+	})
+	return found, errs
+}
+
+//Check returns everything wrong with what a mod overrides and adds, given whether each of the game's own assets
+//exists. Nothing's wrong enough to stop the mod loading, but an override of an asset the game doesn't have does
+//nothing and an addition of one it does have overrides it.
+func Check(m *Mod, exists func(name string) bool) []error {
+	var errs []error
+	for _, name := range m.Overrides.All() {
+		if !exists(name) {
+			errs = append(errs, fmt.Errorf("mod %s overrides %s, which the game doesn't have", m.ID, name))
+		}
+	}
+	for _, name := range m.Adds.All() {
+		if exists(name) {
+			errs = append(errs, fmt.Errorf("mod %s adds %s, which the game already has, so overrides it", m.ID, name))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,126 @@
+package mods
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+//Order returns the mods in the order to load them, every mod after those it requires and otherwise by id. Mods
+//sharing an id, requiring a mod which isn't there or requiring each other are left out, with why among the errors
+//returned, as are mods requiring those left out.
+func Order(found []*Mod) ([]*Mod, []error) {
+	var errs []error
+	byID := map[string]*Mod{}
+	dropped := map[string]bool{}
+	for _, m := range found {
+		if other, ok := byID[m.ID]; ok {
+			errs = append(errs, fmt.Errorf("mods in %s and %s are both called %s, leaving both out", other.Dir, m.Dir, m.ID))
+			dropped[m.ID] = true
+			continue
+		}
+		byID[m.ID] = m
+	}
+
+	// drop mods whose requirements are missing, then those requiring them, until nothing more is dropped
+	for changed := true; changed; {
+		changed = false
+		for _, m := range found {
+			if dropped[m.ID] {
+				continue
+			}
+			for _, id := range m.Requires {
+				if _, ok := byID[id]; !ok {
+					errs = append(errs, fmt.Errorf("mod %s requires %s, which isn't installed", m.ID, id))
+				} else if dropped[id] {
+					errs = append(errs, fmt.Errorf("mod %s requires %s, which can't be loaded", m.ID, id))
+				} else {
+					continue
+				}
+				dropped[m.ID] = true
+				changed = true
+				break
+			}
+		}
+	}
+
+	// depth first, visiting requirements in the order they're listed and mods by id, which found already is
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[string]int{}
+	var order []*Mod
+	var visit func(m *Mod, path []string) bool
+	visit = func(m *Mod, path []string) bool {
+		switch state[m.ID] {
+		case visited:
+			return !dropped[m.ID]
+		case visiting:
+			// only the mods from the first time round the loop are part of it
+			for i, id := range path {
+				if id == m.ID {
+					path = path[i:]
+					break
+				}
+			}
+			errs = append(errs, fmt.Errorf("mods %s require each other, leaving them out", strings.Join(append(path, m.ID), " -> ")))
+			return false
+		}
+		state[m.ID] = visiting
+		for _, id := range m.Requires {
+			if !visit(byID[id], append(path, m.ID)) {
+				errs = append(errs, fmt.Errorf("mod %s requires %s, which can't be loaded", m.ID, id))
+				dropped[m.ID] = true
+				state[m.ID] = visited
+				return false
+			}
+		}
+		state[m.ID] = visited
+		order = append(order, m)
+		return true
+	}
+	for _, m := range found {
+		if !dropped[m.ID] && state[m.ID] == unvisited {
+			visit(m, nil)
+		}
+	}
+	return order, errs
+}
+
+//Conflict is a file more than one mod overrides or adds, the last in load order winning
+type Conflict struct {
+	File string
+	// ids of the mods changing the file, in load order
+	Mods []string
+}
+
+func (c Conflict) String() string {
+	return fmt.Sprintf("mods %s each change %s, %s's is used", strings.Join(c.Mods, ", "), c.File, c.Mods[len(c.Mods)-1])
+}
+
+//Conflicts returns every file more than one of the mods changes, given in load order, sorted by file
+func Conflicts(ordered []*Mod) []Conflict {
+	changedBy := map[string][]string{}
+	var files []string
+	for _, m := range ordered {
+		for _, name := range append(m.Overrides.All(), m.Adds.All()...) {
+			if len(changedBy[name]) == 0 {
+				files = append(files, name)
+			}
+			changedBy[name] = append(changedBy[name], m.ID)
+		}
+	}
+
+	var conflicts []Conflict
+	for _, name := range files {
+		if len(changedBy[name]) > 1 {
+			conflicts = append(conflicts, Conflict{File: name, Mods: changedBy[name]})
+		}
+	}
+	sort.Slice(conflicts, func(i, j int) bool {
+		return conflicts[i].File < conflicts[j].File
+	})
+	return conflicts
+}
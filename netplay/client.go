@@ -0,0 +1,210 @@
+package netplay
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"sort"
+	"sync"
+	"time"
+)
+
+//Client connects to a server, predicting its own bunny locally and reconciling with the snapshots it receives
+type Client struct {
+	mu     sync.Mutex
+	conn   net.Conn
+	writer *bufio.Writer
+	id     uint16
+	bounds Bounds
+	seed   uint64
+
+	// received states by tick, kept as baselines for the deltas that follow
+	states map[uint32]state
+	latest uint32
+
+	seq uint32
+	// inputs sent but not yet applied by the server, replayed on top of each snapshot
+	pending   []Input
+	predicted Bunny
+
+	err error
+}
+
+//Dial connects to the server at the TCP address, waiting for it to assign a bunny
+func Dial(addr string) (*Client, error) {
+	conn, err := net.DialTimeout("tcp", addr, 5*time.Second)
+	if err != nil {
+		return nil, err
+	}
+
+	r := bufio.NewReader(conn)
+	if err := readMessageType(r, msgWelcome); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	msg, err := readWelcome(r)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	c := &Client{
+		conn:      conn,
+		writer:    bufio.NewWriter(conn),
+		id:        msg.ID,
+		bounds:    msg.Bounds,
+		seed:      msg.Seed,
+		states:    map[uint32]state{},
+		predicted: msg.Spawn,
+	}
+
+	go c.read(r)
+
+	return c, nil
+}
+
+//ID returns the ID of the bunny the server gave this client
+func (c *Client) ID() uint16 {
+	return c.id
+}
+
+//Bounds returns the size of the world the server is running
+func (c *Client) Bounds() Bounds {
+	return c.bounds
+}
+
+//Seed returns the seed the server's map was generated from
+func (c *Client) Seed() uint64 {
+	return c.seed
+}
+
+//Err returns the error which ended the connection, nil while still connected
+func (c *Client) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+//Close disconnects from the server
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+//Send applies a tick of movement to the predicted bunny then sends it to the server
+func (c *Client) Send(moveX, moveY float64) error {
+	c.mu.Lock()
+	if c.err != nil {
+		c.mu.Unlock()
+		return c.err
+	}
+
+	c.seq++
+	in := Input{Seq: c.seq, MoveX: QuantizeAxis(moveX), MoveY: QuantizeAxis(moveY)}
+	c.pending = append(c.pending, in)
+	Step(&c.predicted, in, c.bounds)
+	ack := c.latest
+	c.mu.Unlock()
+
+	c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+	if err := writeInput(c.writer, inputMessage{Input: in, Ack: ack}); err != nil {
+		c.fail(err)
+		return err
+	}
+	return nil
+}
+
+//Predicted returns where this client's bunny is expected to be after every input sent so far
+func (c *Client) Predicted() Bunny {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.predicted
+}
+
+//Others returns every other bunny as of the newest snapshot, ordered by ID
+func (c *Client) Others() []Bunny {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var others []Bunny
+	for id, b := range c.states[c.latest] {
+		if id != c.id {
+			others = append(others, b)
+		}
+	}
+	sort.Slice(others, func(i, j int) bool {
+		return others[i].ID < others[j].ID
+	})
+	return others
+}
+
+func (c *Client) fail(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.err == nil {
+		c.err = err
+		c.conn.Close()
+	}
+}
+
+func (c *Client) read(r *bufio.Reader) {
+	for {
+		if err := readMessageType(r, msgSnapshot); err != nil {
+			c.fail(err)
+			return
+		}
+		snap, err := readSnapshot(r)
+		if err != nil {
+			c.fail(err)
+			return
+		}
+		if err := c.receive(snap); err != nil {
+			c.fail(err)
+			return
+		}
+	}
+}
+
+//receive rebuilds the snapshot's state then reconciles the predicted bunny against it
+func (c *Client) receive(snap *snapshot) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	var base state
+	if snap.base != 0 {
+		base = c.states[snap.base]
+		if base == nil {
+			return fmt.Errorf("snapshot %d is based on unknown snapshot %d", snap.tick, snap.base)
+		}
+	}
+
+	current := snap.apply(base)
+	c.states[snap.tick] = current
+	c.latest = snap.tick
+	for tick := range c.states {
+		if tick+historyLength*snapshotInterval < snap.tick {
+			delete(c.states, tick)
+		}
+	}
+
+	// the server's word is final up to the last input it applied, replay the rest on top
+	authoritative, ok := current[c.id]
+	if !ok {
+		return nil
+	}
+
+	unapplied := c.pending[:0]
+	for _, in := range c.pending {
+		if in.Seq > snap.ackSeq {
+			unapplied = append(unapplied, in)
+		}
+	}
+	c.pending = unapplied
+
+	c.predicted = authoritative
+	for _, in := range c.pending {
+		Step(&c.predicted, in, c.bounds)
+	}
+
+	return nil
+}
@@ -0,0 +1,236 @@
+package netplay
+
+import (
+	"bufio"
+	"bytes"
+	"net"
+	"testing"
+	"time"
+)
+
+var testBounds = Bounds{Width: 1000, Height: 1000}
+
+//serve starts a server on a loopback port, closed when the test ends
+func serve(t *testing.T) *Server {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewServer(testBounds, 42)
+	go s.Serve(l)
+	t.Cleanup(func() { s.Close() })
+	eventually(t, "the server to listen", func() bool {
+		return s.Addr() != nil
+	})
+	return s
+}
+
+func dial(t *testing.T, s *Server) *Client {
+	t.Helper()
+	c, err := Dial(s.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+//eventually fails the test if ok doesn't become true within a couple of seconds
+func eventually(t *testing.T, what string, ok func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !ok() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestSnapshotDelta(t *testing.T) {
+	base := state{
+		1: {ID: 1, X: 10, Y: 20},
+		2: {ID: 2, X: 30, Y: 40},
+		3: {ID: 3, X: 50, Y: 60},
+	}
+	current := state{
+		1: {ID: 1, X: 10, Y: 20},
+		2: {ID: 2, X: 31, Y: 40, MoveX: 50},
+		4: {ID: 4, X: 70, Y: 80},
+	}
+
+	var buf bytes.Buffer
+	if err := writeSnapshot(bufio.NewWriter(&buf), 9, 6, 5, base, current); err != nil {
+		t.Fatal(err)
+	}
+	r := bufio.NewReader(&buf)
+	if err := readMessageType(r, msgSnapshot); err != nil {
+		t.Fatal(err)
+	}
+	snap, err := readSnapshot(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if snap.tick != 9 || snap.base != 6 || snap.ackSeq != 5 {
+		t.Errorf("header is tick %d base %d ack %d, expected 9 6 5", snap.tick, snap.base, snap.ackSeq)
+	}
+	masks := map[uint16]uint8{}
+	for _, c := range snap.changed {
+		masks[c.bunny.ID] = c.mask
+	}
+	if _, ok := masks[1]; ok {
+		t.Errorf("unchanged bunny 1 was sent")
+	}
+	if masks[2] != fieldX|fieldMoveX {
+		t.Errorf("bunny 2 sent fields %b, expected only x and move x", masks[2])
+	}
+	if masks[4] != fieldX|fieldY|fieldMoveX|fieldMoveY {
+		t.Errorf("new bunny 4 sent fields %b, expected all of them", masks[4])
+	}
+	if len(snap.removed) != 1 || snap.removed[0] != 3 {
+		t.Errorf("removed %v, expected [3]", snap.removed)
+	}
+
+	got := snap.apply(base)
+	if len(got) != len(current) {
+		t.Fatalf("applied snapshot has %d bunnies, expected %d", len(got), len(current))
+	}
+	for id, b := range current {
+		if got[id] != b {
+			t.Errorf("bunny %d is %+v, expected %+v", id, got[id], b)
+		}
+	}
+}
+
+func TestSnapshotWithoutBase(t *testing.T) {
+	current := state{1: {ID: 1, X: 10, Y: 20}}
+	var buf bytes.Buffer
+	if err := writeSnapshot(bufio.NewWriter(&buf), 3, 0, 0, nil, current); err != nil {
+		t.Fatal(err)
+	}
+	r := bufio.NewReader(&buf)
+	if err := readMessageType(r, msgSnapshot); err != nil {
+		t.Fatal(err)
+	}
+	snap, err := readSnapshot(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := snap.apply(nil); got[1] != current[1] {
+		t.Errorf("bunny 1 is %+v, expected %+v", got[1], current[1])
+	}
+}
+
+func TestLoopbackSnapshots(t *testing.T) {
+	s := serve(t)
+	a := dial(t, s)
+	b := dial(t, s)
+
+	if a.ID() == b.ID() {
+		t.Fatalf("both clients were given bunny %d", a.ID())
+	}
+	if a.Seed() != 42 || a.Bounds() != testBounds {
+		t.Errorf("welcome gave seed %d and bounds %+v, expected 42 and %+v", a.Seed(), a.Bounds(), testBounds)
+	}
+
+	sees := func(c *Client, id uint16) bool {
+		for _, o := range c.Others() {
+			if o.ID == id {
+				return true
+			}
+		}
+		return false
+	}
+	eventually(t, "clients to see each other", func() bool {
+		return sees(a, b.ID()) && sees(b, a.ID())
+	})
+
+	// snapshots after the first are deltas against one the client acknowledged
+	for i := 0; i < 10; i++ {
+		if err := a.Send(1, 0); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(time.Second / TickRate)
+	}
+	eventually(t, "b to see a move", func() bool {
+		for _, o := range b.Others() {
+			if o.ID == a.ID() {
+				return o.X == a.Predicted().X
+			}
+		}
+		return false
+	})
+
+	b.Close()
+	eventually(t, "a to see b leave", func() bool {
+		return !sees(a, b.ID())
+	})
+	if err := a.Err(); err != nil {
+		t.Errorf("a was disconnected: %v", err)
+	}
+}
+
+func TestLoopbackReconciliation(t *testing.T) {
+	s := serve(t)
+	c := dial(t, s)
+	start := c.Predicted()
+
+	const sent = 30
+	for i := 0; i < sent; i++ {
+		if err := c.Send(1, -0.5); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(time.Second / TickRate)
+	}
+
+	// predicted straight away, without waiting for the server
+	expected := start
+	for i := 0; i < sent; i++ {
+		Step(&expected, Input{MoveX: QuantizeAxis(1), MoveY: QuantizeAxis(-0.5)}, testBounds)
+	}
+	if p := c.Predicted(); p.X != expected.X || p.Y != expected.Y {
+		t.Errorf("predicted %v,%v, expected %v,%v", p.X, p.Y, expected.X, expected.Y)
+	}
+
+	// once the server's applied every input its snapshots agree with the prediction, with nothing left to replay
+	eventually(t, "the server to apply every input", func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return len(c.pending) == 0
+	})
+	c.mu.Lock()
+	authoritative := c.states[c.latest][c.id]
+	c.mu.Unlock()
+	if authoritative.X != expected.X || authoritative.Y != expected.Y {
+		t.Errorf("server has the bunny at %v,%v, expected %v,%v", authoritative.X, authoritative.Y, expected.X, expected.Y)
+	}
+	if p := c.Predicted(); p != authoritative {
+		t.Errorf("predicted %+v after reconciling, expected the server's %+v", p, authoritative)
+	}
+}
+
+func TestFloodedInputsAreRateLimited(t *testing.T) {
+	s := serve(t)
+	c := dial(t, s)
+	start := c.Predicted()
+
+	for i := 0; i < 600; i++ {
+		if err := c.Send(1, 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	time.Sleep(300 * time.Millisecond)
+
+	s.mu.Lock()
+	moved := s.world[c.ID()].X - start.X
+	s.mu.Unlock()
+	// only the inputs which fit in the queue are applied, one a tick
+	if limit := float32(maxQueuedInputs * fastHopSpeed); moved > limit {
+		t.Errorf("flooding inputs moved the bunny %vpx, expected at most %vpx", moved, limit)
+	}
+	if moved <= 0 {
+		t.Errorf("the bunny didn't move at all")
+	}
+}
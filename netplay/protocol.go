@@ -0,0 +1,280 @@
+package netplay
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+const (
+	msgWelcome uint8 = iota + 1
+	msgInput
+	msgSnapshot
+)
+
+// bits marking which of a bunny's fields changed from the baseline snapshot
+const (
+	fieldX uint8 = 1 << iota
+	fieldY
+	fieldMoveX
+	fieldMoveY
+)
+
+// how many ticks of snapshots either end keeps around to delta against
+const historyLength = 64
+
+//welcome is the first message a server sends to a newly connected client
+type welcome struct {
+	ID       uint16
+	Tick     uint32
+	TickRate uint16
+	Bounds   Bounds
+	Spawn    Bunny
+	// the seed the server's map was generated from, so every client generates the same one
+	Seed uint64
+}
+
+//inputMessage carries a client's input along with the newest snapshot it has received
+type inputMessage struct {
+	Input
+	Ack uint32
+}
+
+//state is every bunny in the world at a tick, by ID
+type state map[uint16]Bunny
+
+func writeWelcome(w *bufio.Writer, msg welcome) error {
+	if err := w.WriteByte(msgWelcome); err != nil {
+		return err
+	}
+	if err := binary.Write(w, binary.LittleEndian, msg); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+func writeInput(w *bufio.Writer, msg inputMessage) error {
+	if err := w.WriteByte(msgInput); err != nil {
+		return err
+	}
+	if err := binary.Write(w, binary.LittleEndian, msg); err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+//writeSnapshot writes the changes between base and current, a base tick of 0 means base is empty and everything is sent
+func writeSnapshot(w *bufio.Writer, tick, baseTick, ackSeq uint32, base, current state) error {
+	header := struct {
+		Tick    uint32
+		Base    uint32
+		AckSeq  uint32
+		Changed uint16
+	}{tick, baseTick, ackSeq, 0}
+
+	var changes []bunnyChange
+
+	for id, b := range current {
+		old, existed := base[id]
+		var mask uint8
+		if !existed || old.X != b.X {
+			mask |= fieldX
+		}
+		if !existed || old.Y != b.Y {
+			mask |= fieldY
+		}
+		if !existed || old.MoveX != b.MoveX {
+			mask |= fieldMoveX
+		}
+		if !existed || old.MoveY != b.MoveY {
+			mask |= fieldMoveY
+		}
+		if mask != 0 {
+			changes = append(changes, bunnyChange{b, mask})
+		}
+	}
+
+	var removed []uint16
+	for id := range base {
+		if _, ok := current[id]; !ok {
+			removed = append(removed, id)
+		}
+	}
+
+	header.Changed = uint16(len(changes))
+
+	if err := w.WriteByte(msgSnapshot); err != nil {
+		return err
+	}
+	if err := binary.Write(w, binary.LittleEndian, header); err != nil {
+		return err
+	}
+
+	for _, c := range changes {
+		if err := binary.Write(w, binary.LittleEndian, c.bunny.ID); err != nil {
+			return err
+		}
+		if err := w.WriteByte(c.mask); err != nil {
+			return err
+		}
+		if c.mask&fieldX != 0 {
+			if err := binary.Write(w, binary.LittleEndian, c.bunny.X); err != nil {
+				return err
+			}
+		}
+		if c.mask&fieldY != 0 {
+			if err := binary.Write(w, binary.LittleEndian, c.bunny.Y); err != nil {
+				return err
+			}
+		}
+		if c.mask&fieldMoveX != 0 {
+			if err := w.WriteByte(byte(c.bunny.MoveX)); err != nil {
+				return err
+			}
+		}
+		if c.mask&fieldMoveY != 0 {
+			if err := w.WriteByte(byte(c.bunny.MoveY)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := binary.Write(w, binary.LittleEndian, uint16(len(removed))); err != nil {
+		return err
+	}
+	if err := binary.Write(w, binary.LittleEndian, removed); err != nil {
+		return err
+	}
+
+	return w.Flush()
+}
+
+//bunnyChange is a bunny along with which of its fields differ from the baseline
+type bunnyChange struct {
+	bunny Bunny
+	mask  uint8
+}
+
+//snapshot is a decoded snapshot message, applied on top of the state at its base tick
+type snapshot struct {
+	tick    uint32
+	base    uint32
+	ackSeq  uint32
+	changed []bunnyChange
+	removed []uint16
+}
+
+func readSnapshot(r *bufio.Reader) (*snapshot, error) {
+	header := struct {
+		Tick    uint32
+		Base    uint32
+		AckSeq  uint32
+		Changed uint16
+	}{}
+	if err := binary.Read(r, binary.LittleEndian, &header); err != nil {
+		return nil, err
+	}
+
+	s := &snapshot{tick: header.Tick, base: header.Base, ackSeq: header.AckSeq}
+	s.changed = make([]bunnyChange, header.Changed)
+
+	for i := range s.changed {
+		c := &s.changed[i]
+		if err := binary.Read(r, binary.LittleEndian, &c.bunny.ID); err != nil {
+			return nil, err
+		}
+		mask, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		c.mask = mask
+		if mask&fieldX != 0 {
+			if err := binary.Read(r, binary.LittleEndian, &c.bunny.X); err != nil {
+				return nil, err
+			}
+		}
+		if mask&fieldY != 0 {
+			if err := binary.Read(r, binary.LittleEndian, &c.bunny.Y); err != nil {
+				return nil, err
+			}
+		}
+		if mask&fieldMoveX != 0 {
+			v, err := r.ReadByte()
+			if err != nil {
+				return nil, err
+			}
+			c.bunny.MoveX = int8(v)
+		}
+		if mask&fieldMoveY != 0 {
+			v, err := r.ReadByte()
+			if err != nil {
+				return nil, err
+			}
+			c.bunny.MoveY = int8(v)
+		}
+	}
+
+	var removedNum uint16
+	if err := binary.Read(r, binary.LittleEndian, &removedNum); err != nil {
+		return nil, err
+	}
+	s.removed = make([]uint16, removedNum)
+	if err := binary.Read(r, binary.LittleEndian, s.removed); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+//apply builds the full state the snapshot describes from the state at its base tick
+func (s *snapshot) apply(base state) state {
+	result := make(state, len(base)+len(s.changed))
+	for id, b := range base {
+		result[id] = b
+	}
+	for _, c := range s.changed {
+		b := result[c.bunny.ID]
+		b.ID = c.bunny.ID
+		if c.mask&fieldX != 0 {
+			b.X = c.bunny.X
+		}
+		if c.mask&fieldY != 0 {
+			b.Y = c.bunny.Y
+		}
+		if c.mask&fieldMoveX != 0 {
+			b.MoveX = c.bunny.MoveX
+		}
+		if c.mask&fieldMoveY != 0 {
+			b.MoveY = c.bunny.MoveY
+		}
+		result[c.bunny.ID] = b
+	}
+	for _, id := range s.removed {
+		delete(result, id)
+	}
+	return result
+}
+
+func readMessageType(r *bufio.Reader, want uint8) error {
+	t, err := r.ReadByte()
+	if err != nil {
+		return err
+	}
+	if t != want {
+		return fmt.Errorf("expected message type %d, got %d", want, t)
+	}
+	return nil
+}
+
+func readWelcome(r io.Reader) (welcome, error) {
+	msg := welcome{}
+	err := binary.Read(r, binary.LittleEndian, &msg)
+	return msg, err
+}
+
+func readInput(r io.Reader) (inputMessage, error) {
+	msg := inputMessage{}
+	err := binary.Read(r, binary.LittleEndian, &msg)
+	return msg, err
+}
@@ -0,0 +1,281 @@
+package netplay
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/tacusci/logging"
+)
+
+const (
+	// ticks per second the server simulates at, matching the game's update rate
+	TickRate = 60
+	// ticks between each snapshot sent to clients
+	snapshotInterval = 3
+	// longest a client can take to accept a snapshot before being dropped
+	writeTimeout = time.Second
+	// most inputs queued for a client, enough to ride out network jitter, any more arriving are dropped. Only one is
+	// applied each tick, so a client sending inputs faster than the tick rate can't move any faster.
+	maxQueuedInputs = 8
+	// snapshots waiting to be written to a client, any more are skipped as the next is a delta against what it has
+	outboxSize = 4
+)
+
+//DefaultPort is the port servers listen on when none is given
+const DefaultPort = 4740
+
+//serverClient is the server's view of a connected client
+type serverClient struct {
+	conn net.Conn
+	id   uint16
+	// encoded snapshots waiting to be written, closed once the client's dropped
+	outbox chan []byte
+	// inputs received but not yet applied, in the order they were sent
+	inputs []Input
+	// sequence number of the last input applied to the client's bunny
+	lastSeq uint32
+	// newest snapshot tick the client has confirmed receiving
+	ack uint32
+}
+
+//Server owns the world state, applying client inputs and sending snapshots back
+type Server struct {
+	mu       sync.Mutex
+	bounds   Bounds
+	seed     uint64
+	listener net.Listener
+	clients  map[uint16]*serverClient
+	world    state
+	history  map[uint32]state
+	tick     uint32
+	nextID   uint16
+	done     chan struct{}
+}
+
+//NewServer creates a server for a world of the provided size and map seed
+func NewServer(bounds Bounds, seed uint64) *Server {
+	return &Server{
+		bounds:  bounds,
+		seed:    seed,
+		clients: map[uint16]*serverClient{},
+		world:   state{},
+		history: map[uint32]state{},
+		tick:    1,
+		nextID:  1,
+		done:    make(chan struct{}),
+	}
+}
+
+//ListenAndServe listens on the TCP address then serves clients until closed
+func (s *Server) ListenAndServe(addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(l)
+}
+
+//Serve accepts clients on the listener and runs the simulation until closed
+func (s *Server) Serve(l net.Listener) error {
+	s.mu.Lock()
+	s.listener = l
+	s.mu.Unlock()
+
+	go s.run()
+
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			select {
+			case <-s.done:
+				return nil
+			default:
+				return err
+			}
+		}
+		go s.handle(conn)
+	}
+}
+
+//Addr returns the address the server is listening on, nil if it isn't yet
+func (s *Server) Addr() net.Addr {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.listener == nil {
+		return nil
+	}
+	return s.listener.Addr()
+}
+
+//Close stops the server and disconnects every client
+func (s *Server) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	select {
+	case <-s.done:
+		return nil
+	default:
+	}
+	close(s.done)
+
+	for _, c := range s.clients {
+		c.conn.Close()
+	}
+
+	if s.listener != nil {
+		return s.listener.Close()
+	}
+	return nil
+}
+
+func (s *Server) handle(conn net.Conn) {
+	s.mu.Lock()
+	c := &serverClient{
+		conn:   conn,
+		id:     s.nextID,
+		outbox: make(chan []byte, outboxSize),
+	}
+	s.nextID++
+
+	// spawn each new bunny a little along from the last so they don't start on top of each other
+	bunny := Bunny{ID: c.id, X: 400 + float32(len(s.world)*40), Y: 300}
+	s.world[c.id] = bunny
+	s.clients[c.id] = c
+
+	var hello bytes.Buffer
+	err := writeWelcome(bufio.NewWriter(&hello), welcome{ID: c.id, Tick: s.tick, TickRate: TickRate, Bounds: s.bounds, Spawn: bunny, Seed: s.seed})
+	s.mu.Unlock()
+
+	// the welcome goes first, only then are snapshots written after it
+	if err == nil {
+		conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+		_, err = conn.Write(hello.Bytes())
+	}
+	if err != nil {
+		s.drop(c, err)
+		return
+	}
+	go s.send(c)
+
+	logging.Info(fmt.Sprintf("client %d joined from %s", c.id, conn.RemoteAddr()))
+
+	r := bufio.NewReader(conn)
+	for {
+		if err := readMessageType(r, msgInput); err != nil {
+			s.drop(c, err)
+			return
+		}
+		msg, err := readInput(r)
+		if err != nil {
+			s.drop(c, err)
+			return
+		}
+
+		s.mu.Lock()
+		if msg.Seq > c.lastSeq && len(c.inputs) < maxQueuedInputs {
+			c.inputs = append(c.inputs, msg.Input)
+		}
+		if msg.Ack > c.ack {
+			c.ack = msg.Ack
+		}
+		s.mu.Unlock()
+	}
+}
+
+func (s *Server) drop(c *serverClient, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.clients[c.id]; !ok {
+		return
+	}
+
+	c.conn.Close()
+	close(c.outbox)
+	delete(s.clients, c.id)
+	delete(s.world, c.id)
+
+	logging.Info(fmt.Sprintf("client %d left: %v", c.id, err))
+}
+
+func (s *Server) run() {
+	ticker := time.NewTicker(time.Second / TickRate)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.done:
+			return
+		case <-ticker.C:
+			s.step()
+		}
+	}
+}
+
+//send writes the client's snapshots as the server queues them, so a slow client only holds up itself
+func (s *Server) send(c *serverClient) {
+	for msg := range c.outbox {
+		c.conn.SetWriteDeadline(time.Now().Add(writeTimeout))
+		if _, err := c.conn.Write(msg); err != nil {
+			s.drop(c, fmt.Errorf("unable to send snapshot: %v", err))
+			return
+		}
+	}
+}
+
+//step applies the next of each client's queued inputs then, on snapshot ticks, queues each client its snapshot
+func (s *Server) step() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, c := range s.clients {
+		for len(c.inputs) > 0 {
+			in := c.inputs[0]
+			c.inputs = c.inputs[1:]
+			if in.Seq <= c.lastSeq {
+				continue
+			}
+			bunny := s.world[id]
+			Step(&bunny, in, s.bounds)
+			s.world[id] = bunny
+			c.lastSeq = in.Seq
+			break
+		}
+	}
+
+	if s.tick%snapshotInterval == 0 {
+		current := make(state, len(s.world))
+		for id, b := range s.world {
+			current[id] = b
+		}
+		s.history[s.tick] = current
+		delete(s.history, s.tick-historyLength*snapshotInterval)
+
+		for _, c := range s.clients {
+			// delta against the newest snapshot the client has, or send everything if we've forgotten it
+			base, baseTick := s.history[c.ack], c.ack
+			if base == nil {
+				baseTick = 0
+			}
+
+			var msg bytes.Buffer
+			if err := writeSnapshot(bufio.NewWriter(&msg), s.tick, baseTick, c.lastSeq, base, current); err != nil {
+				logging.Error(fmt.Sprintf("unable to encode snapshot for client %d: %v", c.id, err))
+				continue
+			}
+			select {
+			case c.outbox <- msg.Bytes():
+			default:
+				// the client's behind, it'll catch up with a delta against whatever it last acknowledged
+			}
+		}
+	}
+
+	s.tick++
+}
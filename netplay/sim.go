@@ -0,0 +1,75 @@
+package netplay
+
+//Bunny is the networked state of a single player's bunny
+type Bunny struct {
+	ID uint16
+	X  float32
+	Y  float32
+	// the last stick direction the bunny was moved with, scaled to -100 to 100, used to pick its animation
+	MoveX int8
+	MoveY int8
+}
+
+//Input is a single tick's worth of movement sent from a client to the server
+type Input struct {
+	Seq   uint32
+	MoveX int8
+	MoveY int8
+}
+
+//Bounds is the size of the world in pixels which bunnies are kept inside
+type Bounds struct {
+	Width  float32
+	Height float32
+}
+
+const (
+	// stick deflection (out of 100) needed before a bunny will hop, and before it'll hop faster
+	hopThreshold     = 30
+	fastHopThreshold = 80
+
+	hopSpeed     = 1
+	fastHopSpeed = 3
+)
+
+//Step advances a bunny by one tick of input, shared by the server and clients so predictions agree
+func Step(b *Bunny, in Input, bounds Bounds) {
+	b.X += axisSpeed(in.MoveX)
+	b.Y += axisSpeed(in.MoveY)
+	b.MoveX, b.MoveY = in.MoveX, in.MoveY
+
+	if b.X < 0 {
+		b.X = 0
+	} else if b.X > bounds.Width {
+		b.X = bounds.Width
+	}
+	if b.Y < 0 {
+		b.Y = 0
+	} else if b.Y > bounds.Height {
+		b.Y = bounds.Height
+	}
+}
+
+func axisSpeed(v int8) float32 {
+	switch {
+	case v >= fastHopThreshold:
+		return fastHopSpeed
+	case v >= hopThreshold:
+		return hopSpeed
+	case v <= -fastHopThreshold:
+		return -fastHopSpeed
+	case v <= -hopThreshold:
+		return -hopSpeed
+	}
+	return 0
+}
+
+//QuantizeAxis converts a -1 to 1 stick value to the range sent over the network
+func QuantizeAxis(v float64) int8 {
+	if v > 1 {
+		v = 1
+	} else if v < -1 {
+		v = -1
+	}
+	return int8(v * 100)
+}
@@ -0,0 +1,104 @@
+package particles
+
+import "image/color"
+
+//Key is a value a curve passes through at time T, 0 being when a particle is born and 1 when it dies
+type Key struct {
+	T float64
+	V float64
+}
+
+//Curve is a value changing over a particle's lifetime, straight lines between its keys
+type Curve []Key
+
+//Constant creates a curve which stays at v
+func Constant(v float64) Curve {
+	return Curve{{T: 0, V: v}}
+}
+
+//Linear creates a curve going from one value at birth to another at death
+func Linear(from, to float64) Curve {
+	return Curve{{T: 0, V: from}, {T: 1, V: to}}
+}
+
+//Points creates a curve through pairs of times and values, such as Points(0, 1, 0.5, 2, 1, 0)
+func Points(pairs ...float64) Curve {
+	c := make(Curve, 0, len(pairs)/2)
+	for i := 0; i+1 < len(pairs); i += 2 {
+		c = append(c, Key{T: pairs[i], V: pairs[i+1]})
+	}
+	return c
+}
+
+//At returns the curve's value at time t, holding its first and last values beyond its keys
+func (c Curve) At(t float64) float64 {
+	if len(c) == 0 {
+		return 0
+	}
+	if t <= c[0].T {
+		return c[0].V
+	}
+	for i := 1; i < len(c); i++ {
+		if t <= c[i].T {
+			a, b := c[i-1], c[i]
+			if b.T == a.T {
+				return b.V
+			}
+			return a.V + (b.V-a.V)*(t-a.T)/(b.T-a.T)
+		}
+	}
+	return c[len(c)-1].V
+}
+
+//ColorKey is a colour a gradient passes through at time T
+type ColorKey struct {
+	T float64
+	C color.RGBA
+}
+
+//Gradient is a colour changing over a particle's lifetime, blending between its keys
+type Gradient []ColorKey
+
+//Solid creates a gradient which stays one colour
+func Solid(c color.RGBA) Gradient {
+	return Gradient{{T: 0, C: c}}
+}
+
+//Blend creates a gradient passing through the colours evenly spread over a particle's life
+func Blend(colors ...color.RGBA) Gradient {
+	g := make(Gradient, len(colors))
+	for i, c := range colors {
+		g[i] = ColorKey{C: c}
+		if len(colors) > 1 {
+			g[i].T = float64(i) / float64(len(colors)-1)
+		}
+	}
+	return g
+}
+
+//At returns the gradient's colour at time t, as red, green and blue from 0 to 1
+func (g Gradient) At(t float64) (float64, float64, float64) {
+	if len(g) == 0 {
+		return 1, 1, 1
+	}
+	if t <= g[0].T {
+		return channels(g[0].C)
+	}
+	for i := 1; i < len(g); i++ {
+		if t <= g[i].T {
+			a, b := g[i-1], g[i]
+			f := 1.0
+			if b.T > a.T {
+				f = (t - a.T) / (b.T - a.T)
+			}
+			ar, ag, ab := channels(a.C)
+			br, bg, bb := channels(b.C)
+			return ar + (br-ar)*f, ag + (bg-ag)*f, ab + (bb-ab)*f
+		}
+	}
+	return channels(g[len(g)-1].C)
+}
+
+func channels(c color.RGBA) (float64, float64, float64) {
+	return float64(c.R) / 0xff, float64(c.G) / 0xff, float64(c.B) / 0xff
+}
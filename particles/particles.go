@@ -0,0 +1,270 @@
+package particles
+
+import (
+	"image"
+	"math"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/tauraamui/berrybun/utils"
+)
+
+// most vertices one draw call can index with 16 bit indices, four to a particle
+const maxBatchVertices = 1 << 16
+
+//Effect describes how the particles of one kind of effect, such as a dust puff, are born, move and look
+type Effect struct {
+	// spritesheet the particles are drawn from, and the regions of it picked between at random for each one
+	Image  *ebiten.Image
+	Frames []image.Rectangle
+	// how many particles a burst gives off at once, and how many a continuous emitter gives off each tick
+	Burst int
+	Rate  float64
+	// how many ticks each particle lives for, picked between the two
+	MinLife, MaxLife int
+	// speed in pixels per tick a particle is born with, heading in Direction radians give or take Spread,
+	// where 0 is right and positive turns clockwise down the screen
+	MinSpeed, MaxSpeed float64
+	Direction, Spread  float64
+	// scale of the sprite, its colour and its opacity over the particle's life
+	Size  Curve
+	Color Gradient
+	Alpha Curve
+	// pixels per tick added to the particle's downward speed each tick
+	Gravity float64
+	// fraction of its speed a particle loses each tick
+	Drag float64
+	// how much the system's wind pushes the particle, 0 for not at all and 1 for fully
+	Wind float64
+	// most radians per tick a particle spins by, either way
+	Spin float64
+}
+
+//Emitter gives off an effect's particles continuously from a rectangle of the world until stopped
+type Emitter struct {
+	Effect *Effect
+	// top left of the area particles are born in, and its size, a point if both are 0
+	X, Y          float64
+	Width, Height float64
+	// how many times the effect's Rate the emitter gives off, 0 pausing it
+	Scale float64
+	// particles owed from fractions of Rate in earlier ticks
+	owed    float64
+	stopped bool
+}
+
+type particle struct {
+	effect    *Effect
+	frame     image.Rectangle
+	x, y      float64
+	vx, vy    float64
+	rotation  float64
+	spin      float64
+	age, life int
+}
+
+//System owns every live particle, recycling them from a pool of fixed size so nothing is allocated as they come and go
+type System struct {
+	// wind in pixels per tick pushing on every particle, by how much each effect allows
+	WindX, WindY float64
+
+	// the pool, with the live particles packed at its front
+	particles []particle
+	live      int
+	emitters  []*Emitter
+	random    *utils.Rand
+
+	// vertices and indices built up for each spritesheet while drawing, kept between frames
+	batches []batch
+}
+
+type batch struct {
+	image    *ebiten.Image
+	vertices []ebiten.Vertex
+	indices  []uint16
+}
+
+//NewSystem creates a system able to hold capacity particles at once, any more being dropped
+func NewSystem(capacity int, seed uint64) *System {
+	return &System{
+		particles: make([]particle, capacity),
+		random:    utils.NewRand(seed),
+	}
+}
+
+//Len returns how many particles are alive
+func (s *System) Len() int {
+	return s.live
+}
+
+//Burst gives off the effect's burst of particles at x, y
+func (s *System) Burst(e *Effect, x, y float64) {
+	s.Emit(e, x, y, 0, 0, e.Burst)
+}
+
+//Emit gives off n of the effect's particles somewhere in the rectangle at x, y of the given size
+func (s *System) Emit(e *Effect, x, y, width, height float64, n int) {
+	if len(e.Frames) == 0 || e.Image == nil {
+		return
+	}
+	for ; n > 0 && s.live < len(s.particles); n-- {
+		p := &s.particles[s.live]
+		s.live++
+
+		angle := e.Direction + (s.float()*2-1)*e.Spread
+		speed := e.MinSpeed + (e.MaxSpeed-e.MinSpeed)*s.float()
+
+		*p = particle{
+			effect:   e,
+			frame:    e.Frames[s.random.Next(uint32(len(e.Frames)))],
+			x:        x + width*s.float(),
+			y:        y + height*s.float(),
+			vx:       math.Cos(angle) * speed,
+			vy:       math.Sin(angle) * speed,
+			rotation: s.float() * 2 * math.Pi,
+			spin:     (s.float()*2 - 1) * e.Spin,
+			life:     utils.Max(1, e.MinLife+int(s.random.Next(uint32(utils.Max(1, e.MaxLife-e.MinLife+1))))),
+		}
+	}
+}
+
+//Start begins giving off the effect's particles continuously from x, y until the returned emitter is stopped
+func (s *System) Start(e *Effect, x, y float64) *Emitter {
+	em := &Emitter{Effect: e, X: x, Y: y, Scale: 1}
+	s.emitters = append(s.emitters, em)
+	return em
+}
+
+//Stop ends an emitter, the particles it already gave off live out their lives
+func (s *System) Stop(em *Emitter) {
+	em.stopped = true
+}
+
+//Clear removes every particle and emitter
+func (s *System) Clear() {
+	s.live = 0
+	s.emitters = nil
+}
+
+// float returns a random number from 0 up to 1
+func (s *System) float() float64 {
+	return float64(s.random.Next(1<<24)) / (1 << 24)
+}
+
+//Update runs the emitters then moves and ages every particle by a tick
+func (s *System) Update() {
+	emitters := s.emitters[:0]
+	for _, em := range s.emitters {
+		if em.stopped {
+			continue
+		}
+		em.owed += em.Effect.Rate * em.Scale
+		n := int(em.owed)
+		em.owed -= float64(n)
+		s.Emit(em.Effect, em.X, em.Y, em.Width, em.Height, n)
+		emitters = append(emitters, em)
+	}
+	s.emitters = emitters
+
+	for i := 0; i < s.live; {
+		p := &s.particles[i]
+		p.age++
+		if p.age >= p.life {
+			// swap the last live particle into the dead one's place, keeping them packed
+			s.live--
+			s.particles[i] = s.particles[s.live]
+			continue
+		}
+
+		e := p.effect
+		p.vx += s.WindX * e.Wind
+		p.vy += s.WindY*e.Wind + e.Gravity
+		p.vx *= 1 - e.Drag
+		p.vy *= 1 - e.Drag
+		p.x += p.vx
+		p.y += p.vy
+		p.rotation += p.spin
+		i++
+	}
+}
+
+//Draw draws every particle within view, a rectangle of the world, transformed by op's GeoM and coloured by its ColorM,
+//with one draw call for each spritesheet used
+func (s *System) Draw(screen *ebiten.Image, op *ebiten.DrawImageOptions, view image.Rectangle) {
+	for i := range s.batches {
+		s.batches[i].vertices = s.batches[i].vertices[:0]
+		s.batches[i].indices = s.batches[i].indices[:0]
+	}
+
+	for i := 0; i < s.live; i++ {
+		p := &s.particles[i]
+		e := p.effect
+
+		t := float64(p.age) / float64(p.life)
+		size := e.Size.At(t)
+		alpha := e.Alpha.At(t)
+		if size <= 0 || alpha <= 0 {
+			continue
+		}
+
+		w, h := float64(p.frame.Dx())*size, float64(p.frame.Dy())*size
+		reach := int(math.Max(w, h))
+		if !image.Pt(int(p.x), int(p.y)).In(view.Inset(-reach)) {
+			continue
+		}
+
+		b := s.batch(screen, e.Image, op)
+		// the spritesheet's colours are premultiplied by alpha, so fading one means scaling them all
+		r, g, bl := e.Color.At(t)
+		r, g, bl = r*alpha, g*alpha, bl*alpha
+		cos, sin := math.Cos(p.rotation), math.Sin(p.rotation)
+		base := uint16(len(b.vertices))
+		for _, c := range [4][2]float64{{0, 0}, {1, 0}, {0, 1}, {1, 1}} {
+			// corner relative to the particle's centre, spun about it
+			cx, cy := (c[0]-0.5)*w, (c[1]-0.5)*h
+			x, y := op.GeoM.Apply(p.x+cx*cos-cy*sin, p.y+cx*sin+cy*cos)
+			b.vertices = append(b.vertices, ebiten.Vertex{
+				DstX:   float32(x),
+				DstY:   float32(y),
+				SrcX:   float32(p.frame.Min.X + int(c[0])*p.frame.Dx()),
+				SrcY:   float32(p.frame.Min.Y + int(c[1])*p.frame.Dy()),
+				ColorR: float32(r),
+				ColorG: float32(g),
+				ColorB: float32(bl),
+				ColorA: float32(alpha),
+			})
+		}
+		b.indices = append(b.indices, base, base+1, base+2, base+1, base+3, base+2)
+	}
+
+	for i := range s.batches {
+		s.flush(screen, &s.batches[i], op)
+	}
+}
+
+//batch returns the batch particles from img are drawn in, flushing it first if it can't take another particle
+func (s *System) batch(screen, img *ebiten.Image, op *ebiten.DrawImageOptions) *batch {
+	for i := range s.batches {
+		b := &s.batches[i]
+		if b.image != img {
+			continue
+		}
+		if len(b.vertices)+4 > maxBatchVertices {
+			s.flush(screen, b, op)
+		}
+		return b
+	}
+	s.batches = append(s.batches, batch{image: img})
+	return &s.batches[len(s.batches)-1]
+}
+
+func (s *System) flush(screen *ebiten.Image, b *batch, op *ebiten.DrawImageOptions) {
+	if len(b.indices) > 0 {
+		screen.DrawTriangles(b.vertices, b.indices, b.image, &ebiten.DrawTrianglesOptions{
+			ColorM:        op.ColorM,
+			CompositeMode: op.CompositeMode,
+			Filter:        op.Filter,
+		})
+	}
+	b.vertices = b.vertices[:0]
+	b.indices = b.indices[:0]
+}
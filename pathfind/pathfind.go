@@ -0,0 +1,303 @@
+package pathfind
+
+import (
+	"container/heap"
+	"image"
+	"math"
+)
+
+//Grid is a map of cells which can be walked across
+type Grid interface {
+	Size() (int, int)
+	// Cost returns how expensive it is to walk into the cell, false if it can't be walked into at all
+	Cost(x, y int) (float64, bool)
+}
+
+//Movement is which neighbouring cells a path may step to
+type Movement int
+
+const (
+	//FourWay steps only up, down, left and right
+	FourWay Movement = iota
+	//EightWay also steps diagonally
+	EightWay
+)
+
+//Corners is when a diagonal step may pass the corner of a blocked cell
+type Corners int
+
+const (
+	//NoCornerCutting only steps diagonally when both cells beside the step are open
+	NoCornerCutting Corners = iota
+	//CutCorners steps diagonally when at least one cell beside the step is open
+	CutCorners
+	//SqueezeCorners steps diagonally even between two blocked cells
+	SqueezeCorners
+)
+
+//Options are the rules a search follows
+type Options struct {
+	Movement Movement
+	Corners  Corners
+	// lowest cost of any cell in the grid, keeps the heuristic from overestimating so paths stay shortest
+	MinCost float64
+}
+
+//DefaultOptions walks in eight directions without squeezing past corners, over cells costing at least 1
+var DefaultOptions = Options{
+	Movement: EightWay,
+	Corners:  NoCornerCutting,
+	MinCost:  1,
+}
+
+//Status is how far a search has got
+type Status int
+
+const (
+	//Searching is still looking, it needs more steps
+	Searching Status = iota
+	//Found has a path
+	Found
+	//NoPath has searched everywhere reachable without getting there
+	NoPath
+)
+
+var (
+	fourWay  = []image.Point{{0, -1}, {1, 0}, {0, 1}, {-1, 0}}
+	eightWay = []image.Point{{0, -1}, {1, 0}, {0, 1}, {-1, 0}, {1, -1}, {1, 1}, {-1, 1}, {-1, -1}}
+)
+
+// states of a cell during a search
+const (
+	unvisited uint8 = iota
+	open
+	closed
+)
+
+//Search is an A* search which can be run a little at a time
+type Search struct {
+	grid     Grid
+	opts     Options
+	from, to image.Point
+	width    int
+	height   int
+	status   Status
+
+	// per cell cost to reach, the cell reached from and search state, indexed y*width+x
+	cost   []float64
+	parent []int32
+	state  []uint8
+	queue  nodeQueue
+	// how many cells have been expanded so far
+	expanded int
+	path     []image.Point
+}
+
+//NewSearch starts a search for a path between two cells, nothing is searched until it's stepped
+func NewSearch(g Grid, from, to image.Point, opts Options) *Search {
+	w, h := g.Size()
+	s := &Search{
+		grid:   g,
+		opts:   opts,
+		from:   from,
+		to:     to,
+		width:  w,
+		height: h,
+	}
+	if s.opts.MinCost <= 0 {
+		s.opts.MinCost = 1
+	}
+
+	if !s.inside(from) || !s.inside(to) {
+		s.status = NoPath
+		return s
+	}
+	if _, ok := g.Cost(to.X, to.Y); !ok {
+		s.status = NoPath
+		return s
+	}
+
+	s.cost = make([]float64, w*h)
+	s.parent = make([]int32, w*h)
+	s.state = make([]uint8, w*h)
+
+	start := s.index(from)
+	s.parent[start] = -1
+	s.state[start] = open
+	heap.Push(&s.queue, node{index: start, priority: s.heuristic(from)})
+
+	return s
+}
+
+func (s *Search) inside(p image.Point) bool {
+	return p.X >= 0 && p.Y >= 0 && p.X < s.width && p.Y < s.height
+}
+
+func (s *Search) index(p image.Point) int {
+	return p.Y*s.width + p.X
+}
+
+func (s *Search) point(i int) image.Point {
+	return image.Pt(i%s.width, i/s.width)
+}
+
+//heuristic estimates the cost from p to the goal, never more than the real cost
+func (s *Search) heuristic(p image.Point) float64 {
+	dx, dy := math.Abs(float64(p.X-s.to.X)), math.Abs(float64(p.Y-s.to.Y))
+	if s.opts.Movement == FourWay {
+		return (dx + dy) * s.opts.MinCost
+	}
+	// octile distance, straight steps plus the diagonal ones
+	return (math.Max(dx, dy) + (math.Sqrt2-1)*math.Min(dx, dy)) * s.opts.MinCost
+}
+
+//Status returns how far the search has got
+func (s *Search) Status() Status {
+	return s.status
+}
+
+//Expanded returns how many cells the search has expanded so far
+func (s *Search) Expanded() int {
+	return s.expanded
+}
+
+//Step expands up to budget cells, returning the search's status afterwards
+func (s *Search) Step(budget int) Status {
+	for ; budget > 0 && s.status == Searching; budget-- {
+		if s.queue.Len() == 0 {
+			s.status = NoPath
+			break
+		}
+
+		n := heap.Pop(&s.queue).(node)
+		if s.state[n.index] == closed {
+			// a stale entry for a cell since reached more cheaply
+			continue
+		}
+		s.state[n.index] = closed
+		s.expanded++
+
+		p := s.point(n.index)
+		if p == s.to {
+			s.status = Found
+			s.path = s.buildPath(n.index)
+			break
+		}
+
+		s.expand(p, n.index)
+	}
+	return s.status
+}
+
+//Run searches until there's a path or there can't be one
+func (s *Search) Run() Status {
+	for s.Step(math.MaxInt32) == Searching {
+	}
+	return s.status
+}
+
+func (s *Search) expand(p image.Point, index int) {
+	neighbours := fourWay
+	if s.opts.Movement == EightWay {
+		neighbours = eightWay
+	}
+
+	for _, d := range neighbours {
+		n := p.Add(d)
+		if !s.inside(n) {
+			continue
+		}
+		ni := s.index(n)
+		if s.state[ni] == closed {
+			continue
+		}
+
+		c, ok := s.grid.Cost(n.X, n.Y)
+		if !ok {
+			continue
+		}
+
+		if d.X != 0 && d.Y != 0 {
+			if !s.canCutCorner(p, d) {
+				continue
+			}
+			c *= math.Sqrt2
+		}
+
+		cost := s.cost[index] + c
+		if s.state[ni] == open && cost >= s.cost[ni] {
+			continue
+		}
+
+		s.cost[ni] = cost
+		s.parent[ni] = int32(index)
+		s.state[ni] = open
+		heap.Push(&s.queue, node{index: ni, priority: cost + s.heuristic(n)})
+	}
+}
+
+//canCutCorner returns whether a diagonal step from p past the two cells beside it is allowed
+func (s *Search) canCutCorner(p, d image.Point) bool {
+	_, a := s.grid.Cost(p.X+d.X, p.Y)
+	_, b := s.grid.Cost(p.X, p.Y+d.Y)
+	switch s.opts.Corners {
+	case NoCornerCutting:
+		return a && b
+	case CutCorners:
+		return a || b
+	}
+	return true
+}
+
+func (s *Search) buildPath(end int) []image.Point {
+	var path []image.Point
+	for i := end; i >= 0; i = int(s.parent[i]) {
+		path = append(path, s.point(i))
+	}
+	// walked back from the goal, so turn it around
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
+
+//Path returns the cells of the path found, from start to goal inclusive, nil until the search has found one
+func (s *Search) Path() []image.Point {
+	return s.path
+}
+
+//Cost returns the total cost of the path found
+func (s *Search) Cost() float64 {
+	if s.status != Found {
+		return math.Inf(1)
+	}
+	return s.cost[s.index(s.to)]
+}
+
+//Find searches for a path in one go, returning nil if there isn't one
+func Find(g Grid, from, to image.Point, opts Options) []image.Point {
+	s := NewSearch(g, from, to, opts)
+	if s.Run() != Found {
+		return nil
+	}
+	return s.Path()
+}
+
+type node struct {
+	index    int
+	priority float64
+}
+
+//nodeQueue is a min heap of cells ordered by estimated total cost
+type nodeQueue []node
+
+func (q nodeQueue) Len() int            { return len(q) }
+func (q nodeQueue) Less(i, j int) bool  { return q[i].priority < q[j].priority }
+func (q nodeQueue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *nodeQueue) Push(x interface{}) { *q = append(*q, x.(node)) }
+func (q *nodeQueue) Pop() interface{} {
+	old := *q
+	n := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return n
+}
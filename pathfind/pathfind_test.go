@@ -0,0 +1,400 @@
+package pathfind
+
+import (
+	"image"
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+//textGrid is a grid drawn as rows of text, # blocked, . costing 1 and a digit costing that much
+type textGrid []string
+
+func grid(rows ...string) textGrid {
+	return textGrid(rows)
+}
+
+func (g textGrid) Size() (int, int) {
+	return len(g[0]), len(g)
+}
+
+func (g textGrid) Cost(x, y int) (float64, bool) {
+	switch c := g[y][x]; {
+	case c == '#':
+		return 0, false
+	case c >= '1' && c <= '9':
+		return float64(c - '0'), true
+	}
+	return 1, true
+}
+
+//openGrid is a grid of width by height cells costing 1, with some blocked
+type openGrid struct {
+	width, height int
+	blocked       map[image.Point]bool
+}
+
+func (g *openGrid) Size() (int, int) {
+	return g.width, g.height
+}
+
+func (g *openGrid) Cost(x, y int) (float64, bool) {
+	return 1, !g.blocked[image.Pt(x, y)]
+}
+
+//scattered is a size by size grid with about a fifth of its cells blocked, the same every time, leaving the
+//corners clear
+func scattered(size int) *openGrid {
+	g := &openGrid{width: size, height: size, blocked: map[image.Point]bool{}}
+	random := rand.New(rand.NewSource(1))
+	for i := 0; i < size*size/4; i++ {
+		g.blocked[image.Pt(random.Intn(size), random.Intn(size))] = true
+	}
+	for y := 0; y < 3; y++ {
+		for x := 0; x < 3; x++ {
+			delete(g.blocked, image.Pt(x, y))
+			delete(g.blocked, image.Pt(size-1-x, size-1-y))
+		}
+	}
+	return g
+}
+
+func opts(m Movement, c Corners) Options {
+	return Options{Movement: m, Corners: c, MinCost: 1}
+}
+
+//checkSteps fails the test if any step of the path isn't to a neighbouring open cell allowed by the movement
+func checkSteps(t *testing.T, g Grid, path []image.Point, m Movement) {
+	t.Helper()
+	for i := 1; i < len(path); i++ {
+		d := path[i].Sub(path[i-1])
+		if abs(d.X) > 1 || abs(d.Y) > 1 || d == image.ZP {
+			t.Fatalf("step %d from %v to %v isn't to a neighbour", i, path[i-1], path[i])
+		}
+		if m == FourWay && d.X != 0 && d.Y != 0 {
+			t.Fatalf("step %d from %v to %v is diagonal moving four ways", i, path[i-1], path[i])
+		}
+		if _, ok := g.Cost(path[i].X, path[i].Y); !ok {
+			t.Fatalf("step %d is into blocked cell %v", i, path[i])
+		}
+	}
+}
+
+func TestFourWay(t *testing.T) {
+	g := grid(
+		".....",
+		".###.",
+		".....",
+	)
+	s := NewSearch(g, image.Pt(0, 1), image.Pt(4, 1), opts(FourWay, NoCornerCutting))
+	if s.Run() != Found {
+		t.Fatalf("no path found")
+	}
+	checkSteps(t, g, s.Path(), FourWay)
+	if s.Cost() != 6 {
+		t.Errorf("path costs %v, expected 6", s.Cost())
+	}
+	if p := s.Path(); p[0] != image.Pt(0, 1) || p[len(p)-1] != image.Pt(4, 1) {
+		t.Errorf("path runs from %v to %v", p[0], p[len(p)-1])
+	}
+}
+
+func TestEightWay(t *testing.T) {
+	g := grid(
+		".....",
+		".....",
+		".....",
+		".....",
+	)
+	s := NewSearch(g, image.Pt(0, 0), image.Pt(4, 3), opts(EightWay, NoCornerCutting))
+	if s.Run() != Found {
+		t.Fatalf("no path found")
+	}
+	checkSteps(t, g, s.Path(), EightWay)
+	// three diagonal steps and one straight
+	if expected := 3*math.Sqrt2 + 1; math.Abs(s.Cost()-expected) > 1e-9 {
+		t.Errorf("path costs %v, expected %v", s.Cost(), expected)
+	}
+	if len(s.Path()) != 5 {
+		t.Errorf("path has %d cells, expected 5", len(s.Path()))
+	}
+}
+
+func TestCorners(t *testing.T) {
+	oneSide := grid(
+		"..",
+		"#.",
+	)
+	bothSides := grid(
+		".#",
+		"#.",
+	)
+	tests := []struct {
+		name    string
+		g       Grid
+		corners Corners
+		// cells in the path, 0 if there shouldn't be one
+		length int
+	}{
+		{"no cutting past one corner", oneSide, NoCornerCutting, 3},
+		{"cutting past one corner", oneSide, CutCorners, 2},
+		{"squeezing past one corner", oneSide, SqueezeCorners, 2},
+		{"no cutting between two corners", bothSides, NoCornerCutting, 0},
+		{"cutting between two corners", bothSides, CutCorners, 0},
+		{"squeezing between two corners", bothSides, SqueezeCorners, 2},
+	}
+	for _, test := range tests {
+		path := Find(test.g, image.Pt(0, 0), image.Pt(1, 1), opts(EightWay, test.corners))
+		if len(path) != test.length {
+			t.Errorf("%s: path %v has %d cells, expected %d", test.name, path, len(path), test.length)
+		}
+	}
+}
+
+func TestWeightedCosts(t *testing.T) {
+	g := grid(
+		".9.",
+		"...",
+	)
+	s := NewSearch(g, image.Pt(0, 0), image.Pt(2, 0), opts(FourWay, NoCornerCutting))
+	if s.Run() != Found {
+		t.Fatalf("no path found")
+	}
+	for _, p := range s.Path() {
+		if p == image.Pt(1, 0) {
+			t.Errorf("path %v crosses the expensive cell rather than going round it", s.Path())
+		}
+	}
+	if s.Cost() != 4 {
+		t.Errorf("path costs %v, expected 4", s.Cost())
+	}
+
+	// worth crossing once going round costs more
+	g = grid(
+		".3.",
+		"#.#",
+		"...",
+	)
+	s = NewSearch(g, image.Pt(0, 0), image.Pt(2, 0), opts(FourWay, NoCornerCutting))
+	if s.Run() != Found || s.Cost() != 4 {
+		t.Errorf("path %v costs %v, expected straight across for 4", s.Path(), s.Cost())
+	}
+}
+
+func TestNoPath(t *testing.T) {
+	g := grid(
+		"..#..",
+		"..#..",
+	)
+	for _, m := range []Movement{FourWay, EightWay} {
+		s := NewSearch(g, image.Pt(0, 0), image.Pt(4, 1), opts(m, SqueezeCorners))
+		if s.Run() != NoPath {
+			t.Errorf("found %v through a wall", s.Path())
+		}
+		if s.Path() != nil || !math.IsInf(s.Cost(), 1) {
+			t.Errorf("a failed search has path %v costing %v", s.Path(), s.Cost())
+		}
+	}
+
+	if s := NewSearch(g, image.Pt(0, 0), image.Pt(2, 0), DefaultOptions); s.Status() != NoPath {
+		t.Errorf("a search to a blocked cell is %v, expected to fail straight away", s.Status())
+	}
+	if s := NewSearch(g, image.Pt(0, 0), image.Pt(9, 0), DefaultOptions); s.Status() != NoPath {
+		t.Errorf("a search off the grid is %v, expected to fail straight away", s.Status())
+	}
+}
+
+func TestStepBudget(t *testing.T) {
+	g := &openGrid{width: 40, height: 40}
+	s := NewSearch(g, image.Pt(0, 0), image.Pt(39, 39), opts(FourWay, NoCornerCutting))
+	if s.Step(5) != Searching {
+		t.Fatalf("a long search finished in 5 steps")
+	}
+	if s.Expanded() > 5 {
+		t.Errorf("expanded %d cells on a budget of 5", s.Expanded())
+	}
+	if s.Path() != nil {
+		t.Errorf("unfinished search has a path")
+	}
+	if s.Run() != Found || s.Cost() != 78 {
+		t.Errorf("resumed search is %v costing %v, expected found costing 78", s.Status(), s.Cost())
+	}
+}
+
+func TestSmooth(t *testing.T) {
+	g := grid(
+		".......",
+		".......",
+		".......",
+	)
+	straight := []image.Point{{0, 1}, {1, 1}, {2, 1}, {3, 1}, {4, 1}, {5, 1}, {6, 1}}
+	if got := Smooth(g, straight, DefaultOptions); len(got) != 2 || got[0] != straight[0] || got[1] != straight[6] {
+		t.Errorf("straight path smoothed to %v, expected just its ends", got)
+	}
+
+	g = grid(
+		"...",
+		"##.",
+		"...",
+	)
+	around := Find(g, image.Pt(0, 0), image.Pt(0, 2), opts(FourWay, NoCornerCutting))
+	got := Smooth(g, around, DefaultOptions)
+	if len(got) < 3 {
+		t.Fatalf("path around a wall smoothed to %v, cutting through it", got)
+	}
+	for i := 1; i < len(got); i++ {
+		if !lineOfSight(g, got[i-1], got[i], 1, NoCornerCutting) {
+			t.Errorf("smoothed path %v can't walk straight from %v to %v", got, got[i-1], got[i])
+		}
+	}
+
+	// the direct line crosses a cell dearer than any on the path, so smoothing keeps to the path
+	g = grid(
+		".....",
+		"..9..",
+		".....",
+	)
+	path := []image.Point{{0, 0}, {1, 0}, {2, 0}, {3, 0}, {4, 0}, {4, 1}, {4, 2}, {3, 2}, {2, 2}}
+	for _, p := range Smooth(g, path, DefaultOptions) {
+		if p == image.Pt(2, 1) {
+			t.Errorf("smoothing went through the expensive cell")
+		}
+	}
+	got = Smooth(g, path, DefaultOptions)
+	for i := 1; i < len(got); i++ {
+		if !lineOfSight(g, got[i-1], got[i], 1, NoCornerCutting) {
+			t.Errorf("smoothed path %v crosses dearer cells between %v and %v", got, got[i-1], got[i])
+		}
+	}
+
+	short := []image.Point{{0, 0}, {1, 0}}
+	if got := Smooth(g, short, DefaultOptions); len(got) != 2 {
+		t.Errorf("two cell path smoothed to %v", got)
+	}
+}
+
+func TestPlannerResumes(t *testing.T) {
+	g := scattered(60)
+	p := &Planner{Budget: 50}
+	first := p.Plan(g, image.Pt(0, 0), image.Pt(59, 59), DefaultOptions)
+	second := p.Plan(g, image.Pt(59, 59), image.Pt(0, 0), DefaultOptions)
+	if p.Pending() != 2 {
+		t.Fatalf("%d searches pending, expected 2", p.Pending())
+	}
+
+	p.Update()
+	if first.Expanded() > 50 {
+		t.Errorf("first search expanded %d cells on a budget of 50", first.Expanded())
+	}
+	if second.Expanded() != 0 {
+		t.Errorf("second search started before the first finished")
+	}
+
+	updates := 1
+	for p.Pending() > 0 {
+		before := first.Expanded() + second.Expanded()
+		p.Update()
+		if spent := first.Expanded() + second.Expanded() - before; spent > 50 {
+			t.Fatalf("update %d expanded %d cells on a budget of 50", updates, spent)
+		}
+		if updates++; updates > 10000 {
+			t.Fatalf("searches never finished")
+		}
+	}
+	if updates < 2 {
+		t.Errorf("searches finished in %d updates, expected them to be spread over several", updates)
+	}
+
+	whole := NewSearch(g, image.Pt(0, 0), image.Pt(59, 59), DefaultOptions)
+	whole.Run()
+	if whole.Status() != Found {
+		t.Fatalf("no path across the scattered grid")
+	}
+	if first.Status() != whole.Status() || first.Cost() != whole.Cost() {
+		t.Errorf("planned search is %v costing %v, expected %v costing %v", first.Status(), first.Cost(), whole.Status(), whole.Cost())
+	}
+	if second.Status() == Searching {
+		t.Errorf("second search never finished")
+	}
+}
+
+func TestPlannerCancel(t *testing.T) {
+	g := &openGrid{width: 100, height: 100}
+	p := &Planner{Budget: 10}
+	s := p.Plan(g, image.Pt(0, 0), image.Pt(99, 99), DefaultOptions)
+	p.Update()
+	p.Cancel(s)
+	if p.Pending() != 0 {
+		t.Errorf("%d searches pending after cancelling", p.Pending())
+	}
+	expanded := s.Expanded()
+	p.Update()
+	if s.Expanded() != expanded || s.Status() != Searching {
+		t.Errorf("cancelled search carried on")
+	}
+
+	// searches which can't start are never queued
+	blocked := &openGrid{width: 10, height: 10, blocked: map[image.Point]bool{{5, 5}: true}}
+	if s := p.Plan(blocked, image.Pt(0, 0), image.Pt(5, 5), DefaultOptions); s.Status() != NoPath || p.Pending() != 0 {
+		t.Errorf("search to a blocked cell was queued")
+	}
+}
+
+func TestTextGrid(t *testing.T) {
+	// the test grids themselves, so a mistake in one doesn't pass for a bug in the search
+	g := grid("#.5")
+	if _, ok := g.Cost(0, 0); ok {
+		t.Errorf("# is open")
+	}
+	if c, ok := g.Cost(2, 0); !ok || c != 5 {
+		t.Errorf("5 costs %v", c)
+	}
+	if w, h := grid(strings.Repeat(".", 4), "....").Size(); w != 4 || h != 2 {
+		t.Errorf("grid is %dx%d, expected 4x2", w, h)
+	}
+}
+
+func benchmarkFind(b *testing.B, g Grid, o Options) {
+	w, h := g.Size()
+	from, to := image.Pt(0, 0), image.Pt(w-1, h-1)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if Find(g, from, to, o) == nil {
+			b.Fatalf("no path across the grid")
+		}
+	}
+}
+
+func BenchmarkFind500(b *testing.B) {
+	benchmarkFind(b, &openGrid{width: 500, height: 500}, DefaultOptions)
+}
+
+func BenchmarkFind500FourWay(b *testing.B) {
+	benchmarkFind(b, &openGrid{width: 500, height: 500}, opts(FourWay, NoCornerCutting))
+}
+
+func BenchmarkFind500Scattered(b *testing.B) {
+	benchmarkFind(b, scattered(500), DefaultOptions)
+}
+
+func BenchmarkSmooth500(b *testing.B) {
+	g := scattered(500)
+	path := Find(g, image.Pt(0, 0), image.Pt(499, 499), DefaultOptions)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Smooth(g, path, DefaultOptions)
+	}
+}
+
+func BenchmarkPlannerUpdate500(b *testing.B) {
+	g := scattered(500)
+	p := NewPlanner()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if p.Pending() == 0 {
+			p.Plan(g, image.Pt(0, 0), image.Pt(499, 499), DefaultOptions)
+		}
+		p.Update()
+	}
+}
@@ -0,0 +1,61 @@
+package pathfind
+
+import "image"
+
+//DefaultBudget is how many cells a planner expands each tick unless told otherwise
+const DefaultBudget = 2000
+
+//Planner shares a budget of cells expanded per tick between every search waiting on it, so long searches
+//are spread over several frames rather than stalling one
+type Planner struct {
+	// how many cells may be expanded each update across all searches
+	Budget int
+	queue  []*Search
+}
+
+//NewPlanner creates a planner with the default budget
+func NewPlanner() *Planner {
+	return &Planner{Budget: DefaultBudget}
+}
+
+//Plan queues a search behind any already waiting, poll its Status until it's no longer Searching
+func (p *Planner) Plan(g Grid, from, to image.Point, opts Options) *Search {
+	s := NewSearch(g, from, to, opts)
+	if s.Status() == Searching {
+		p.queue = append(p.queue, s)
+	}
+	return s
+}
+
+//Cancel stops a queued search, it stays Searching forever
+func (p *Planner) Cancel(s *Search) {
+	for i, q := range p.queue {
+		if q == s {
+			p.queue = append(p.queue[:i], p.queue[i+1:]...)
+			return
+		}
+	}
+}
+
+//Pending returns how many searches are still waiting to finish
+func (p *Planner) Pending() int {
+	return len(p.queue)
+}
+
+//Update steps the queued searches in the order they were planned until the budget is spent
+func (p *Planner) Update() {
+	budget := p.Budget
+	for len(p.queue) > 0 && budget > 0 {
+		s := p.queue[0]
+		before := s.Expanded()
+		if s.Step(budget) != Searching {
+			p.queue = p.queue[1:]
+		}
+		// popping stale queue entries costs a little too, so always count at least one
+		spent := s.Expanded() - before
+		if spent < 1 {
+			spent = 1
+		}
+		budget -= spent
+	}
+}
@@ -0,0 +1,98 @@
+package pathfind
+
+import (
+	"image"
+	"math"
+)
+
+//Smooth drops the waypoints of a path which can be walked straight past, leaving only the turns
+func Smooth(g Grid, path []image.Point, opts Options) []image.Point {
+	if len(path) < 3 {
+		return path
+	}
+
+	// a shortcut is only taken when every cell the straight line crosses is open and costs no more than
+	// the most expensive cell of the stretch of path it replaces, so smoothing never cuts across worse terrain
+
+	out := []image.Point{path[0]}
+	anchor := 0
+	maxCost := cellCost(g, path[0])
+	for i := 1; i < len(path); i++ {
+		maxCost = math.Max(maxCost, cellCost(g, path[i]))
+		if i < 2 || lineOfSight(g, path[anchor], path[i], maxCost, opts.Corners) {
+			continue
+		}
+		// the previous waypoint was the furthest reachable in a straight line, turn there
+		anchor = i - 1
+		out = append(out, path[anchor])
+		maxCost = math.Max(cellCost(g, path[anchor]), cellCost(g, path[i]))
+	}
+
+	return append(out, path[len(path)-1])
+}
+
+func cellCost(g Grid, p image.Point) float64 {
+	c, _ := g.Cost(p.X, p.Y)
+	return c
+}
+
+//lineOfSight walks every cell touched by the straight line between the centres of a and b
+func lineOfSight(g Grid, a, b image.Point, maxCost float64, corners Corners) bool {
+	w, h := g.Size()
+	walkable := func(x, y int) bool {
+		if x < 0 || y < 0 || x >= w || y >= h {
+			return false
+		}
+		c, ok := g.Cost(x, y)
+		return ok && c <= maxCost
+	}
+
+	dx, dy := abs(b.X-a.X), abs(b.Y-a.Y)
+	sx, sy := sign(b.X-a.X), sign(b.Y-a.Y)
+	x, y := a.X, a.Y
+	ix, iy := 0, 0
+
+	if !walkable(x, y) {
+		return false
+	}
+	for ix < dx || iy < dy {
+		// which cell edge the line crosses next, vertical, horizontal, or both at once through a corner
+		d := (1+2*ix)*dy - (1+2*iy)*dx
+		switch {
+		case d == 0:
+			sideA, sideB := walkable(x+sx, y), walkable(x, y+sy)
+			if corners == NoCornerCutting && !(sideA && sideB) || corners == CutCorners && !(sideA || sideB) {
+				return false
+			}
+			x, y = x+sx, y+sy
+			ix, iy = ix+1, iy+1
+		case d < 0:
+			x += sx
+			ix++
+		default:
+			y += sy
+			iy++
+		}
+		if !walkable(x, y) {
+			return false
+		}
+	}
+	return true
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+func sign(v int) int {
+	switch {
+	case v < 0:
+		return -1
+	case v > 0:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,250 @@
+package quest
+
+import "fmt"
+
+//State is how far along a quest is
+type State int
+
+const (
+	// the quests it requires aren't all complete yet
+	Locked State = iota
+	// it can start, but is waiting to be offered
+	Available
+	Active
+	Complete
+)
+
+var stateNames = []string{"locked", "available", "active", "complete"}
+
+func (s State) String() string {
+	if s < 0 || int(s) >= len(stateNames) {
+		return fmt.Sprintf("State(%d)", int(s))
+	}
+	return stateNames[s]
+}
+
+//parseState returns the state with the name, false if there isn't one
+func parseState(name string) (State, bool) {
+	for i, n := range stateNames {
+		if n == name {
+			return State(i), true
+		}
+	}
+	return Locked, false
+}
+
+//Log is the player's progress through every quest
+type Log struct {
+	// called as a quest starts and once it's complete
+	OnStart, OnComplete func(q *Quest)
+
+	quests []*Quest
+	byID   map[string]*Quest
+	states map[string]State
+	// how many times each objective of each quest has been done
+	counts map[string][]int
+}
+
+//NewLog creates a log with every quest locked. Unlock starts those which can, once the log has been restored from any
+//save and its callbacks set.
+func NewLog(quests []*Quest) *Log {
+	l := &Log{quests: quests, byID: map[string]*Quest{}, states: map[string]State{}, counts: map[string][]int{}}
+	for _, q := range quests {
+		l.byID[q.ID] = q
+		l.counts[q.ID] = make([]int, len(q.Objectives))
+	}
+	return l
+}
+
+//Quests returns every quest in the order they were defined
+func (l *Log) Quests() []*Quest {
+	return l.quests
+}
+
+//Quest returns the quest with the id, nil if there isn't one
+func (l *Log) Quest(id string) *Quest {
+	return l.byID[id]
+}
+
+//State returns how far along the quest with the id is
+func (l *Log) State(id string) State {
+	return l.states[id]
+}
+
+//Count returns how many times an objective of a quest has been done
+func (l *Log) Count(id string, objective int) int {
+	counts := l.counts[id]
+	if objective < 0 || objective >= len(counts) {
+		return 0
+	}
+	return counts[objective]
+}
+
+//Unlock makes every locked quest whose requirements are complete available, starting those which aren't waiting to
+//be offered
+func (l *Log) Unlock() {
+	for _, q := range l.quests {
+		if l.states[q.ID] != Locked || !l.ready(q) {
+			continue
+		}
+		l.states[q.ID] = Available
+		if !q.Offered {
+			l.start(q)
+		}
+	}
+}
+
+func (l *Log) ready(q *Quest) bool {
+	for _, id := range q.Requires {
+		if l.states[id] != Complete {
+			return false
+		}
+	}
+	return true
+}
+
+//Start starts an available quest, such as one a conversation offers
+func (l *Log) Start(id string) error {
+	q, ok := l.byID[id]
+	if !ok {
+		return fmt.Errorf("no quest called %s", id)
+	}
+	if s := l.states[id]; s != Available {
+		return fmt.Errorf("quest %s can't start, it's %s", id, s)
+	}
+	l.start(q)
+	return nil
+}
+
+func (l *Log) start(q *Quest) {
+	l.states[q.ID] = Active
+	if l.OnStart != nil {
+		l.OnStart(q)
+	}
+	// objectives may already be done, such as a building the player's standing in
+	l.check(q)
+}
+
+//Record notes that something happened n times, such as an item being collected, progressing the objectives of active
+//quests it's part of. It returns whether any did progress.
+func (l *Log) Record(kind, target string, n int) bool {
+	// quests this completes may start others, which it doesn't count towards
+	var active []*Quest
+	for _, q := range l.quests {
+		if l.states[q.ID] == Active {
+			active = append(active, q)
+		}
+	}
+
+	progressed := false
+	for _, q := range active {
+		counts := l.counts[q.ID]
+		for i, o := range q.Objectives {
+			if o.Kind != kind || o.Target != target || counts[i] >= o.Count {
+				continue
+			}
+			counts[i] += n
+			if counts[i] > o.Count {
+				counts[i] = o.Count
+			}
+			progressed = true
+		}
+		l.check(q)
+	}
+	return progressed
+}
+
+//check completes an active quest once every objective is done
+func (l *Log) check(q *Quest) {
+	if l.states[q.ID] != Active {
+		return
+	}
+	for i, o := range q.Objectives {
+		if l.counts[q.ID][i] < o.Count {
+			return
+		}
+	}
+	l.finish(q)
+}
+
+//Complete completes a quest whatever its objectives, such as from the developer console
+func (l *Log) Complete(id string) error {
+	q, ok := l.byID[id]
+	if !ok {
+		return fmt.Errorf("no quest called %s", id)
+	}
+	if l.states[id] == Complete {
+		return fmt.Errorf("quest %s is already complete", id)
+	}
+	for i, o := range q.Objectives {
+		l.counts[id][i] = o.Count
+	}
+	l.finish(q)
+	return nil
+}
+
+func (l *Log) finish(q *Quest) {
+	l.states[q.ID] = Complete
+	if l.OnComplete != nil {
+		l.OnComplete(q)
+	}
+	l.Unlock()
+}
+
+//Saved is the progress through each quest that isn't locked, by id, as it's kept in a save
+type Saved map[string]SavedQuest
+
+//SavedQuest is the progress through a quest as it's kept in a save
+type SavedQuest struct {
+	State  string `json:"state"`
+	Counts []int  `json:"counts,omitempty"`
+}
+
+//Save returns the log's progress to keep in a save
+func (l *Log) Save() Saved {
+	saved := Saved{}
+	for _, q := range l.quests {
+		s := l.states[q.ID]
+		if s == Locked {
+			continue
+		}
+		sq := SavedQuest{State: s.String()}
+		if s == Active {
+			sq.Counts = append([]int(nil), l.counts[q.ID]...)
+		}
+		saved[q.ID] = sq
+	}
+	return saved
+}
+
+//Restore puts the log back to the progress in a save. Quests which no longer exist are skipped, and counts for
+//objectives which have since changed are kept as far as they still fit, so a save outlives the quests changing.
+func (l *Log) Restore(saved Saved) error {
+	for id, sq := range saved {
+		q, ok := l.byID[id]
+		if !ok {
+			continue
+		}
+		s, ok := parseState(sq.State)
+		if !ok {
+			return fmt.Errorf("quest %s has unknown state %q", id, sq.State)
+		}
+		l.states[id] = s
+
+		counts := l.counts[id]
+		for i := range counts {
+			switch {
+			case s == Complete:
+				counts[i] = q.Objectives[i].Count
+			case i < len(sq.Counts):
+				counts[i] = sq.Counts[i]
+				if counts[i] > q.Objectives[i].Count {
+					counts[i] = q.Objectives[i].Count
+				}
+			default:
+				counts[i] = 0
+			}
+		}
+	}
+	return nil
+}
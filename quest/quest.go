@@ -0,0 +1,139 @@
+//Package quest tracks quests defined in data, each a list of objectives completed by what happens in the game, started
+//once the quests it requires are complete and rewarding the player when it's done.
+package quest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+//Kinds of objective, and what their target names
+const (
+	// collect Count of the item named by Target
+	Collect = "collect"
+	// visit the building named by Target
+	Visit = "visit"
+	// talk to a creature of the species named by Target
+	Talk = "talk"
+)
+
+var kinds = map[string]bool{Collect: true, Visit: true, Talk: true}
+
+//Objective is something to do towards completing a quest
+type Objective struct {
+	Kind   string `json:"kind"`
+	Target string `json:"target"`
+	// how many times it must be done, once if left out
+	Count int `json:"count"`
+	// what the objective is shown as, such as "Pick berries"
+	Text string `json:"text"`
+}
+
+//Rewards are what completing a quest gives
+type Rewards struct {
+	// items given to the player, by kind
+	Items map[string]int `json:"items"`
+	// dialogue variables set, by name without the $, such as to change what someone says once it's done
+	Set map[string]interface{} `json:"set"`
+}
+
+//Quest is a quest's definition
+type Quest struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description"`
+	// quests which must be complete before this one can start
+	Requires []string `json:"requires"`
+	// whether the quest waits to be offered, such as by a conversation, rather than starting as soon as it can
+	Offered    bool        `json:"offered"`
+	Objectives []Objective `json:"objectives"`
+	Rewards    Rewards     `json:"rewards"`
+}
+
+//Parse reads a file of quest definitions, checking each is complete and that every quest required exists without
+//any requiring themselves
+func Parse(r io.Reader) ([]*Quest, error) {
+	var file struct {
+		Quests []*Quest `json:"quests"`
+	}
+	dec := json.NewDecoder(r)
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&file); err != nil {
+		return nil, fmt.Errorf("unable to parse quests: %v", err)
+	}
+
+	byID := map[string]*Quest{}
+	for i, q := range file.Quests {
+		if q.ID == "" {
+			return nil, fmt.Errorf("quest %d has no id", i)
+		}
+		if _, ok := byID[q.ID]; ok {
+			return nil, fmt.Errorf("quest %s is defined twice", q.ID)
+		}
+		byID[q.ID] = q
+
+		if q.Title == "" {
+			return nil, fmt.Errorf("quest %s has no title", q.ID)
+		}
+		if len(q.Objectives) == 0 {
+			return nil, fmt.Errorf("quest %s has no objectives", q.ID)
+		}
+		for j := range q.Objectives {
+			o := &q.Objectives[j]
+			if !kinds[o.Kind] {
+				return nil, fmt.Errorf("quest %s objective %d has unknown kind %q", q.ID, j, o.Kind)
+			}
+			if o.Target == "" {
+				return nil, fmt.Errorf("quest %s objective %d has no target", q.ID, j)
+			}
+			if o.Count < 0 {
+				return nil, fmt.Errorf("quest %s objective %d has a negative count", q.ID, j)
+			}
+			if o.Count == 0 {
+				o.Count = 1
+			}
+			if o.Text == "" {
+				o.Text = fmt.Sprintf("%s %s", o.Kind, o.Target)
+			}
+		}
+	}
+
+	for _, q := range file.Quests {
+		for _, id := range q.Requires {
+			if _, ok := byID[id]; !ok {
+				return nil, fmt.Errorf("quest %s requires %s, which isn't a quest", q.ID, id)
+			}
+		}
+		if cycle := requiresItself(q, byID, map[string]bool{}); cycle != "" {
+			return nil, fmt.Errorf("quest %s can never start, it requires %s which requires it", q.ID, cycle)
+		}
+	}
+	return file.Quests, nil
+}
+
+//requiresItself returns a quest q requires, directly or not, which in turn requires q, or "" if there isn't one
+func requiresItself(q *Quest, byID map[string]*Quest, seen map[string]bool) string {
+	var visit func(id string) bool
+	visit = func(id string) bool {
+		if id == q.ID {
+			return true
+		}
+		if seen[id] {
+			return false
+		}
+		seen[id] = true
+		for _, next := range byID[id].Requires {
+			if visit(next) {
+				return true
+			}
+		}
+		return false
+	}
+	for _, id := range q.Requires {
+		if visit(id) {
+			return id
+		}
+	}
+	return ""
+}
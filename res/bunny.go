@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package res
-
-var Bunny_png = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x00\xc0\x00\x00\x01\x00\b\x06\x00\x00\x00\xe5Cy\a\x00\x00\x13OIDATx\x9c\xed\x9d?n\x1c\xb9\x12\xc6\xcb\x0fo\xb2\x17l6\x1b\xe8\x10\x1b\xcc\x15\f\x03\x862]҉3\xc1\xa7\x98c(\x90\xb0\xc1:\xd8l\x02\xbd@\xa0]\xa2\xf8\xa7H\x16Y$\xfb\xfb\x01\x8b\x95g\xa4.\x92U_\xb1\xc9\xee\xae&\x02\x00\x00\x00\xc0\xe4\\\xaf\xd7\xd7\xeb\xf5\xfaZ\xfb=h\xc4\xda\x01G\xb6\u007f\xbd^_\x9f\x9f\x9f_\x9f\x9f\x9f\x836r\xdfk\xb5a\xb7\xf1\xffO\x89\xf1\xbb\xbb;\xba\xbb\xbb\xa3\x98\x03R߷rt\xfbDD\xff\xfb\xf1\xa3\xe9\xfb\x16\xac\xfb\xdf˾X\x00D\xb6\x0e\x80\xfd7\xfex|l\xfa\xbe\x05\xeb\xfe\xf7\xb0_$\x00\"[\a\xc0\xbe=\xd6\xfd\u05f6_,\x00\x00v\x02\x02\x00\x87\xe6\xbf\xd6\r\x00r\xfe\xf9\xfc\xf9퇗\x97\xe0B\xef߯_\xe9v\xbb\x11\xbd\xbc\x8cnڲ\x14\t\xc0\xda\x01G\xb7ODt:\x9d\xe8|>\xff\xfay$\xd6\xfd\xefa\xbfx\x06\xb0t\xc0Q\xed\xfb\xce\xce\xd9=\x9f\xcft\xbd^_/\x97\xcb'\xed\xb6\xec6\xfe\xa25@\xc8\x019\xe3\x9a{\xc1\xa5\xf6]\x00\xecb\xdf\x1dS\xe2p\x89oJ\xb1\xee\u007fO\xfb\xe2Ep\x89\x03\xee\xee\ue907\x15c\x19\x003طȶ\x1c\xeb\xfe\xf7\xb2/\x16\x00?\xe8\xc3\xc3Ç\xefC\x9fi2\x93\xfd#b\xdd\xff^\xfe\xc76h\x05\x16\x02\x84\xfd>@\x00\v\xf2\xfd\xfbw\xd1g \x0f\x04P\x81u\x00\xden7\"z\xbb\xec\xef.\xfd\xbb\xcf\xfc\x9f{`\xdd\u007fM\xaa.\x84}\xfb\xf6\x8d\x88~\xdfw\xf1\xf3\xfe\xfe\xd7gD\xfd\x1d`m\xffv\xbb\xd1\xe9tzg\xdf}6¾\xb3\xf3\xf3\xfe\xfe\xc3g\xfe\xcf=\xb0\uefe6\xff\xc5\x02\xe0\x1dt\xff\xff\xfb˗\xb7\u007fӸA\x87\xfd\xf7\x9f\xf1\xf6\xf8\x9f\xf7\xb2o\xdd\u007fM\xfb\xe2S\xa0\x97\xc0յؖS\xe8w[\x81}\xd8\xefa_,\x80\x92\xbd\xfd\x1e\xd7\x01`\x1f\xf6{\xfcnV\x00\xfc\x8a\xda\xd3\xd3\x13\x95\xfc\xacq5\x10\xf6a\xbf\xa7}\xd1\fP\xa3h\xcd,\x00\xfb\xb0\xdf\xebo\xb0\r\n\x0e\r\x04\x00\x0e\r\x04\x00\x0e\r\x04\x00\x0e\r\x04\x00\x0e\x8dH\x00|kIJ\xcd\xdf\xc0>쏶\x9f\x15@\xcbcu\x1a\x8f\xe4\xc1>\xec\xf7\xfc[\xf1\xbd@NQ1e\xc5.Fh\x01\xfb\xb0oi\x1fD\x88\x15d\x1dU\xa9\xd9\xda\xfe\xea`\x11܀\v0\xbf k\xec\xf3\xdd\xec;[+\vpy\x01X; vɽ\xc7\ra\xb3\xd9\xdfA\x80\xcd\x02\xb0\f\xc0\x19\x1c@\x14/\xc8:\xaaP\xae\xa5\xfd\xd5\x05\xd8$\x80\x19\x02\xd0:\x03\x83\xb5\x05\xd8<\x03\xcc\x10\x80\xd6\x19\x18\xd8\xd2\xe2\u007f\x95\xe2\xb8\u007f<>\xd2\xd3_\u007f\x89?\xdf\x15\xebE\x9f\xb5\xfd\x15Auh\x05~\xde\xdfSh\xbe\xfby\u007fO4`O\xdaھcE\x01n%\x80\x15\x1d\xb0\x03+\vPU\x00V\x018\x8b\x03B\xb8\x8d\x80\x1e\x95\x9aW\xb0?\x82\x16\xff\xab\b`\xe6\x00\xec\x85{+\xe1Q\xedK\x99]\x80\xcb_\b\xcb1\xeaZ\x00\xd1\xc7\xfa\x98\xa3\xebe\x8e\xb4\xbf\x9a\x00c\xdfw\x17@\xef\xf7ƂyXQ\x80\xd5\x02\x981\x00\xad30\xb0\xa5\xc6\xff\xaa\x8b\xe0\x87\x87\x87wER\xfd\u007f\xaf\x8cd\x16s}\xfd\xe3\xf1\x91~\xde߫\xf6\xdd\xda\xfe\xael\xb5\r\xda\v~k\x87\x04^\xb4v\a\xfb\xbc\r)V\x14\xe02\x02\x18逐\xadѧ{~\x1b\xacw\x9cJڠ%\xc0ҵc\x8d\xff\xc5\x02\xb0\xcc\x00#\x1dPj+G\xcd\xd3In}\xf5\xf4\xf4\xd4\u070eڧ\xa3\xac\x05\xc8ט\xae\x0f=\xfc/\x12\xc0\xe8\f`\x95\x81y\xe0\xd5\x10\xfa\xbb\xd2\xfd\xef\x96\xcd\x05\r\xfb\xbc\rV\x02l\t\xfeR\x92\x02\xe0\x818*\x03Xe`\x8d]-\xdfi-\xd4\x1cC\xc3\xfe\f\x02\xe4\xc7\xeb-\xc0\xa8\x00\xb4\x02\xb1\xc4\x19\xd6\x19X3۸c\xf8\xb3YI{Z\xda\xe1Ʊ\xf6*\xac\x95\x00\x89\xdeƨ\xe6\xdaQ\x8d\xff\x83\xd7\x01j\xb3\xc0\xd3\xd3Ӈ\xff.\x97\xcb'\x89\x03\xb42p\xed1\\\x1b\xb5g:\xd7&\xe9q/\x97\xcb'\xad\xaa\x06\\\x845O\xe8\xb5\n\xd0ٮ\xf9\xfb\x1a\xd1\xd6\xf8_\xf5J\xb0߀\xd2N8\xd1he\xe0\x92\xabКW\xab]\xf6\xad-աq\xdf\f\x9f\xcd\xf8\u007f\xb9~\xf6\x10\xa0\x05\xa6\xe5\xd1k:\xde3\x03K\x83[k\xd6s\xc7\xe2B\x90΄\x1a\xb4\x9e\x8a\xb4\xb6sd්5\xb8\x06(9\a\xd3\xca\xd8=2\xb0\xfb9wGb\xe8\xf4\xab6c_\xaf\xd7W>&\xfcT@\x1aX\xa9\xd3\xc1\\\xbb\xb8]>\xa3\xba\xbf\x1b!B\xbf\xff\xa3\xef\x06-\x89\xc7\xe8\"X\"\x02\xed\n\\\xb5\x198t\x1c\x9e\x05K\x8f\xcb\xff\xc6eo\xe9ߺq\xab\xdd\xfe#\xfa8\x0e~@ł9&\xbe\xd2>\xd4\n\xd0ocKb\f\xbd\x1a)4\xb3\xb5&ߪ+\xc1\xa9\xf2t5\rꝁs\xc7\xf2\xc5\xde:\xa8-\xd9N:\x0e!\x1b\\|\xfc8-Y\xb8$\x19p?\xfa\"\xa8\xb1\x1f\xf2\xa1߆\xd6͓\xa2ڠ\xa1쓚%F\f\xba\x8f\x1f\x04ҿ\xf7\a5\xf4\xf9hB۲R1k\x9d\x9a\x96\xe0l\xfbv[\xb7d\x1d\xb1mN\xff\xc2Y\xc9\xe9Wt\x11\x9c\nlI'Z.\xa6he\xe0\x96E\xe0\xc8E+\xb7\xeb/\xa6c\xbf\x97;\x0e\xd1\xfb\x05\xba\x15=\xae\xadh\x92\x9c\x01J\rj]>w\x94f\u007fά\x8f\xe0\xe5\b\xb5\xbbfF\xaa\xed\u007f\xe8ܻ\x05~z9\xca'%1\xd8|7hl\xda+=\x87\xe7\x834z\xc0fg\xf48\xf8\xbbhDr\u007f\x86\xfc\xd7\xda\xfeR!\x96\xc4PrA\x13:hj+\xd1\xff}y\x93\xc1,\xb4\x9e\xfa\xf6h\x8b\xbfI\x91\x9a%\x11w\xcaX\x16\xff\x9d\xc1\xfe\xeel_\x15\xa2\x05~j\xe7\x17\xffm\xbd\xd7e\x05\xfb\xee\xf8;\vpz\x01\xcc\xe0\x80\u0602j\xd46\xa3\x95\xfd#\b0+\x00\xcb\x00\x9c\xc1\x01֕\xa7\xad\xed\x13\xed-\xc0\xa4\x00f\b@\"\xfb\f|dv\x17\xa0\xe8\x14\xc82\x00gp\x00\xb0\xa3\xb7\xff\xb3\x02@\x00\xfe\xc6z\xe1gm\u007fG\x96)\x8bb\x85{\xc0ߟ\xed~\x0e:\xfd\xb2\xb6\xcf\xd9Q|K\t`G\a\xcc\xce\xee\x02,\x12\x80E\x00Z9@\xe3\x19\xe5\x95\xed\xcfBo\xffg\x050S\x06\x98\x11\x8b'\x9eF\xd8?\x8a\x00\xa7\xbd\x106\x8b\x03\xac+N[\xdb\xcfQ\xf2\xccu\t\xa3\xfc\x9f|\x1e`\x86\x00\xcc\xd1\xcb\x01\xe0\x8d\xdd\x05\xa8\xf2\x9e\xe0\x9e\x018\xbb\x03@_z\xfb?+\x80\xa3\a /\xf8\xcb\xff\xedËOi&\x04k\xfb\xbb\xb3\xd46\xa8%\xa9\x82\xbf\xa1\x87\x81\xb4\x17\xa6V\xf6\xa5\x15\xbfW}\x1edz\x01\xac\xe8\x00\xfe\x10\xb8E\x9bB\xf6[m\xaf&@N\xaa\x1d\xa2\x12\x17\xbc\x01!\xb4\xab\x02\x87엠Q\x04*V\xa3\xa7\x96\xd26i\xd9/\xad\x8e\xc1\xed\xb7\xd8\xf6c¢L\xbc\xa4\xb2\x88X\x005\rh\r@M\aԶ'\x94Yj\vx\xd5\x06b\x8b\xfd\xda\x123\xbb\b0\xd7\xf7\xae\xb5A[\x17d-U\t\\u8^%\xaeuqȏ\xd5r\x8c\xdav\xd4گ\x19GW\x16&V\xfb\xb4\x94\x9a~k\xf8\xbf\xfa\xfd\x00\xae\x01\xb5\x0e\xe7ʫ9\x1fL\x15\xdejiS\xae-\xbe\xbdҪ\b)ۥ\xc7\xe0Y\xb8%\xf0\xac\xab=\xd7đ\xa6\xffS\xb3O\xb26\xa8V\x03\xb4.\xd7k\x15Wʵ\xa5\xd7\x05@ɩ@L\x80\xad\xd4\xd4X\xdaE\x80\xa9~gw\x81xI\x8a\xd1W\x86\xf9y\xe0\xc8\f\xec\x9f7\xbb\xbf\xd7\"&\xc0\xd8y\xafV\x81\xaa\x9a\xbf\x9b\xa1ș\xa6\x88|\xc4۠\x96\xc1\xaf\x85$\x03\xfb3_\xae.gm\xf5<)=\x04X\x83V\xb9JM4\xaa\x106]\a\x906\xa0e\a`d\x06\x0e\xd9w\x84v\xb6\xaeWy\x19t~\xbc\xd8i\x98?\xdb\xd6\x14\xc7\r\x8dS\xcd)\xa8?\x06\x16\xc5v9\xbdl7_\b\x93\fL\xad\xfa\xfdc\x8f\xcc\xc0\x92m\xd4\\\xbf|!K\xda\xcag \x89\b\xdd\xef\x12}\x14JHt\x92v\xfbH\xfc\xc0\xed\x84>\xebq˶\xef\xcbX\xd2L\xd9.\x16@ȑ=\xb2tl\xd0{f`G\x8f\xe9\xdaw\x96\xc6\fď\x15ڬ\b\x89@2^\xfcX!?H\xdb\xde{\xd6H\xbd+\x80\xff?\xd5\xe7\xac\x00r{\xb7<c\xf1\x8b\x1e-\x1d\xf7\xb3`\xcc\xf9=2\xb0&\x92\x9a\x96\x12\xa4\x17\x16\xfd\xda\xf8n\u07fe\xa4ߩ\r\x8f\x96\x85l\r\xa9ӰX[J\x17ˢ\x19@:u\xc7\xc4\xd22\xfdi\\U&\xd2/\xdd.\xa5\xb5\xdf\xd2\xe3\xa4\xc6_:\xf38\xb4v\xde,(\xddd(:\x05\xaa\t\xa0\x96-\xac֠\x8f\x95n\x9f\x9d\x96w\"\xb4\xce8\xb1R\xf5\xb5\xf0\x98\xd1H\x84ڈn\x85\b\x19\x1f=\x1d\xd6\xc2/\xe7\xfb?[\xb7\xad\a\x1ao\xb7\xe1\u007f_3f\xa1\xfbpj\x92P\x8b\xbf\xa4\x82\xa9\xbe)+\xf5\x9e\x80P\xe6\xd85\xe0\xc0GjoM.9\xb6\xe4M:\xb5\xbb^ B\xee\xe6><\x8d\x05\xb6\xe5z\xbd\xbe>??\xbf>??G\xabe\xa7\xbe\as2mY\x14\xf0\x11\xcc@\xfa@\x00\x8b\xe0v\xb3b\xf7\xd5\xe7\xbe\aa\x96\x12\x002\xa0-;\x8e\xff\xf4\x0f\xc5;\xf8~~h?9\xf7}\x8f\xf6\xf4<\xfel\xcc6\xfeZ,#\x80\x99X\xed\xc2\x1a\x88SU\x1d:\xf7\xae\xe0UԿ2G\x9b\x81\x88\xfağX\x00\xbbN\x81+r\xc4\x19\xa8W\xfc-{\nt\xc4\f8\x13\xbb\x8c\xff\x92\x028b\x06\x9c\x89\x9d\xc6\u007f\xa9mP\x00\xb4\xa9\x9e\x01v\x99\x02K\x90\xdcո\xe2=\xf4+\xa2\x15\u007fU\x02\xd8i\n\x94PR\x16\xc6=\xff\x80\x8d\x80~h\xc6\x1fN\x81\x84\x94\fz\xaf\x04Q\xfb0?\x88\x03\x014\xd0R+\xb3\x84\xd2\x19\x88\xff\rH\xb3\x94\x00fʀn=гj\x19\a3P\x1f\x96\x10\x002\xa0-;\x8f\xff\x12\x02 \x9a#\x03\x1e\x99]ǿH\x00;N\x81-\x8cZ\x03\xccj\u007f4=\xe2O$\x80\x9d\xa7\xc0Z\xdc\xf9\xbf\xfbo4\xa3\xd7 \x96\xf4\x8c?\xf1\f0\xe3\x14x\xb4\f8\x1b#ǿW\xfc-\xb3\x06\xf0\x99!\x03\x1eY\x803\x8c\xbf\x06\xcd\xe5щ\xd6Z\xf4\xd4\x12*\xf6\x14\xaa\xe4\xdc+\x18\xa4\xa5\xd2W\x0e\xc6R4\xe2\xafz\x06\xd8%\x03Hp\x81^r\x0e\xaay\x1bD\xcc~\xa8\x02\\\x0f\xfb3\xa2\x15\u007f\xcb\xdc\x0e\x8d\fhˮ\xe3\xbf\xc4\x1a\x00\x19Ж\x9d\xc7_e\r\x00Ɛ\x1a\xef#\xfaB\xa3\xcf\xc5\xe5\xd19\xfe\xcb1\x1c\xbb.\x8a-\x03P\xf2b\xbf\xdd\xe9\x11\u007fb\x01\xe4\xde\x13%}\x87T\v\b@[,ǿW\xfc\x89\x04P\xf3\xa2\x04\xed\xa0@\x00\xdab9\xfe=\xe3\xaf\xe9\x14H\xfa\x1d\x00\x1a \xfe\x00\x00\x00\x00\x13v\xac\x8e\f\x80\b\xbc!fO\x96\xb8\x12\xec\xb0\xce\xc0\xff\xfb\xf1\xa3\xe9\xfbV\xac\xfb\xbf#\xcb\b`\x967\xa4\xfc\xf1\xf8\xd8\xf4}-3\xf4\u007fG\x01\x16\t\xc0z\x00\xac3\xb05\x96\xfd\xdfU\x80b\x01\xcc0\x00Dv\x19x\x16,\xfb\xbf\xa3\x00\x8bf\x80\xa3g\xe0\u007f>\u007f\xa6\xbf\xbf|!\xa2\xdfنg\x9d\u007f\xbf~\xfd\xf5\xfd\xae\xec&\xc0\xe25\xc0\xd13\xf0\xe9t\xa2\xf3\xf9L\xe7\xf3\xd9\xfc\xc1\xf8#\xa2\x1d\u007f\xcb<\x10C\xf4\x96\x81\x89\x88\xe8\xe5%8\xcd\xfd\xfb\xf5+\xddn7\xa2\x97\x17U\xbb\xbe\xad\xd3\xe9$\xfa\x1b\xed{d\xac\xfa\xbf3K\t\x80\xe8w\x06v?\x8f\xe2|>\x8b\xec\x9dN\xa7\xae\x8f\x89Z\xf5\x9fhO\x01\x16\t\xe0\xc8\x19\x98\xdb|xx\xa0\xef\u07ff\xbf\xfb>\xf4\x99\x163\xf4\x9f\xdb\xdeI\x80\xc53\xc0\xd13\xb0\x15\x96\xfd\xdfY\x80E\x95\xe1x#rƵ\xb7B\xfd\f\xec\x13\xfal'\xac\xfb_*@MzƟx\x17\xc8r\x00f\xe3۷oD\xf4\xb6\xe3\xe0v\x1d\xdcgD\xf46\roƮ\x02\x14\v\xc0z\x00\xac\xe1A\xed\xc6\xe2\xef/_~\xed\xfb\x8f>\x1f>\x1a\xbd\xe2o\xb9] \xa2\xf7\x19\x98\x88\xe8\xe7\xfd\xbdI\x06\x0e\x05\xfd\b۳\xf4\u007f\a\x96\x11\xc0\xedv\xfb\x15p<\x03\x13\x11\x9d\xa8\u007f\x06~yy\x11M\xad\xa7ө\xcb\x02ܺ\xff\x9c\x9d\x04X%\x80Y\x06`d\x06v;+\x92פ\x8eڅ\x1a\xd9\xff]\x05(^\x03X\x9f\x03\xbf\b\xf7vO\xa7\x93\xf8w[\xe0\x01>\"\xd8g뿳\xe5\xfb\xbd\xa7\x00\xb9]\"\x9d\xf8kz\x1e`\xe4\x00H\xb3\xaa$K\xaf\x88u\xffw\x15\xa0X\x00\xb3\r\xc0\xe8\f<\x1b\xa3\xfb\xbf\xab\x00\x8b\xde\x10s\xe4\f\f\u07b3\x8b\x00\x9b\xde\x0f\x10\xfayWJ\xfax\x84\xf1\xb0F+\xfe\x96y&ؒ\xd8=-\xa9W$\xa1\\\xe3\x1a@\x00\r\x84\xea\xe3\xf7\x023P\x1f\x96\xb9\x106K\x00Ď\xdd\xd3f\xac8l\xca&f \x19K\b`\x86\x00\x98\xa5:5\xefs\xac>~o\xbb\xa9\xcfR\x9f\xcfH\xd1\xfb\x01z\xfcn)V\x01`\xcd,\xe5ɥ\xe3\xafݦ^\x02\x147\xd2\u007f[w\xec\x05\x05\xeesL\xc1@\x93\xd0\x19\x00\x17`\xec\xbb\x1c\xc5\x02\xd04\x0e\x00\x98\x9cX\xb5\xb1\x15\xcb\x00\x02PD\xac\xe2\xf3\xc8J\xd0\xd6\x02\xb4\xb6ߛ\xecu\x00\xeb\x01\xb0\xb6oI\xac\xdcߨ2\x94\xd6\xf6\x9d\xad\x9e\xfeO\n\xc0z\x00\xac\xed\x03[F\xf8\x1fW\x82\xc1\xa1\x81\x00\xc0\xa1Y\xe2J\xf0,\xf0\xe9\x16\xb7}\xef\x01\x04 d\x86`\xb7\x16\xa0\xb5\xfd\x1e\x14\t\xc0z\x00\xac\xed[b\xddWk\xfbD}\xfc/\x16\x80\xf5\x00X\x8aM\xf2\xbb\xb8\xfaݗ^\xfe\xc7\"8\x81d\xd0{9\xa6T\x80\xbb\xd9\x1fET\x00\xd6\x03`m\u007f\x06,\x05hm\u007f\x94\xff\x933\xc0\x91\x1d\x00\xec\x19\xe1\u007f\x9c\x02)q\x84[3v\x04\x02P\xc2e\"+\x11X\v\xd0\xda~-j\x02\xb0\x1e\x00K\xfb.\xf8-E`-@k\xfb\xb5\xfeW\x13\x80\xf5\x00\xf4\xb0_\xf3\x98\xa5Ś\xc4Z\x80\xd6\xf6[l\xab\b\xc0z\x004\xed\xfb\x99\xa4\xf6Yc\x8d1\xb0\x16\xa0\xb5\xfdR\x9b5\xfeO\n\xc0z\x00\xac쇎Q\xd2\x16\r!\xba\vkV\x02\xb4\xb6_k\xbb\xd4\xffQ\x01X\x0f\x80\xb5}\xfe\x06\xf8\\\x1b\\\x858\xfe{-B\f\x9dώ\x16\xa0o{\xd7\x04\xd0e\x17he\a\\.\x97O\xb1\x8a\x179\x9b\xbeX\xdc\x03\x1b5\xed\xb0\x14 \xd1\xc7* \xa3퇎/\xa5\xc4\xff\"\x01H\xab\xf2\xf2\x86\xae쀐\b\x9c\x9d\x1c\xbe]\xfe\xe4\x92\xc4!\xeei\xa7\xd41cv\xb5\xdeLß\xb8\xb2\xb0\xef\xda \xb5\xdd\xe2\xff\xac\x00bu\x80b\xbf\xab1\b38\xc0\x89\x80\x0flL\x18\x925\x83\xc6#|%\x02t\xbf;zWL\xd3\xfe\b\xff\x8b\xee\x05*\x11\x01\xff}mF;\xc0\x15\xbf\xe5Ep\xb90bml\x11\xe1\xe5r\xf9\xf4\xe7\x9f\u007f\xbe\x13\xdf\xd3\xd3ӯ\xb6H\x05\xc8\x13H\xe9}5\xfe\xf1j\xed\xf3cJ\xed\xa7\xa8\xf1\u007f\x8e\xe8-\xbc\xfe@\xb8A\b\r\x90\xdfH_\x89%\xb7\n\xa7\x1c\x10\xfb>Ֆ\x9a6HHM\xd1\xfe\x18\x84Ʊ\xb7m\xff3\"\xf9\x18\x84\x02\x96\xff\xed(\xfb<\x98[\xfc\x9f\xb2[\xbc\b\xcee@\a\xcf@\xb5\xc7\x0fّd\xe0P\x1b\xb4\xafM\xe4v)\xfcӦ֙\xa1ƶ\xa3\xd4\x0f\xfe\xcc\xe7\aP\xe9\x0eMM\x1c\xc4\xfe\xa6\xd4\xff9\xaa\x16\xc1|\x00B\x83\xdd\xea\xe8\x19\x1c !\x96Y|\xf1\xa7\xfa\xd2j;4\x06\xb1S\x15MR\xf65ĞJ\x1a\xad[\xa4\x9c셰\xd84\u009d\xd9{\xb0C\xf4v@I;,\xfa\xefl\x87>\x1fu%>d\xdf\t\x9f\x8fK\xe9\xf8\xe4\x12 \xb7\x9dۭ\xcbm<$/\x84I\xb2\x96壀\xbd\x1cP\xd3\x0e_tV\xa2\xf0\xfb?2\x19\xf8p\x1f\U001084d0\bJg\xfb\xe6\xaa\x103\xdf\x02\xcb\xebڏ\xaa\xa1\xdfsᝲ\x1br\xbc\xb5\xfd\x11m\x88\xbd<E\x8aJY\x94\xd6{gZ\xb0v\x80\x95-߮u\"\xb2\x9aebHۣ\"\x80\xd4Be\x14\xb39`4\xb3\x9d\x8a\x8e&\xb6Q\x036\xe7\xc8ճ\x01\xd9\a\x80\xa5\xfd\xeb\xd5\xfe\xfd\x05\xab\xd3|7\xa8u\x00\x84\xee\xb1\x19U>\xdd\xda\xfe\f\xac\x9e\x80\x9a\x04\x80\x0086\xd6\xfeװ\x8f\xaa\x10\xe0\xd0@\x00\xe0Р<\xfa\x06\xf4\xb8\xf7\xff(l!\x00\xeb\x00\xb0\xb6oQ\x89a\x17T/\x84\x1d5\x00\xac\xed[c\xed\xff\x16\xfb\xddn\x85\x00})}ʫ\xe7\x95Qk\xff\xb7\xd8_v\x11\\\x1a\x00\xbb\xd9'B\xf5l\r\xaa\x05\x80\x00\xb0\xb7o\x89\xb5\xff\xb5\xec7\xcd\x00G\x0e\x00`\xef\u007f\r\xfb[\xec\x02\x1d\tlu겜\x00\xac\x03\xc0\xd2>\xaf\x96p\xf4ۿ\xb5(\x16\xc0\x91\x03\xc0Ҿ_*\xc4\xea\xd4r\xb7\x04T\xb4\x06()W\xa7\x8du\x00Xۯ\xb5\xa9)TK\xff\xf7\xb2/\x16\x00\x02\xc0\xce~m\xd6\xe3\xb6[oO\xb6\xf6\u007f/\xfbE3\xc0\xaa\x01\xa0U\x89\xcd\xc2~M֓\x96/,e\xc7\x04$Z\x03\xec\x10\x00\xa3\xed\x87jc֎\x83_Z1\xf4y\xae\r-윀\xb2\x02\xb0\x0e@\xdfvI\r\x18\xb7Xm\xbd\x15@#\x00K\xc7$4\xee\xfc\x98\x92Ҕ~{j\xc6\xc0\xda\xff\xbd\xd7\x1d\xa2S \xdf\t\xd2bK\xdau9]\x00\x94\\\x00\xd1|2\xa9\xc6>\xff\xb7\xa4\r\xee\\=dGb7V)M3\x01\x8c\xf6?\x17\xbdԶT\x84Ek\x80\xda\x00\xac\xc5\r\\\xa8\xcar\xaa\x8dZ\x8c\xb6\x1f\v|\xe99=\x0ft\x1e,Z7\xc2Y&\xa0\x12\xdb%\xfe\x12\xad\x01j\x8c\xb7\xc2\a,d7\x17\x00~\xb1,\xe7\x84\xda\x12\xdd5\xf6\xf9w\xadA\x98r\xaa\u007f\xae\xab\x15\xf0<\x01\x8c\xf6\xbf\x8f4\x91\x86\xc6)\xe5\xf7\xe4@\x95\x9e\u007f\xc5\x02\xb0t1\x14\xb3\x1b[\u0605\x8e\x1f\xca8#\xed\xd7\xe0\x8b\x96;\xb3\xb7\xedP[\xdcϭ\xfe\x0f}_ӆ\xdc\xc2\xde\x1d?4\x8e1\xbb\xd9\x19 \xb6\b\xf3\r\x13\xa5˅\x97f\x86R\xc5\xc7J\xa8\xd7\"9\rIٯů-z\xbd\xbe\xaf7\xdaӶC+\x01hTk+\x99uj\x8e\xdf|/Pʸ\xd6t8:\x00BvB\xf57\xb5\xed\xa7\x12H\xe9ߴb\x9d\x80BvRI)$\xbe\xa65@l:\x0e5 U\xbf\xbd\x16ׁش\xa6eGJl\x11gU\x83Rsq+\xb1\x95\xfa\xdcb\fr\xb1\x97\x9a99E3@H\x10\xbd꾻\x0eX\x06\x98\xdf\x1e\xab{`B\xfb\xff#f\xbd\x99\x12P\b\x8d\xf7V4\x9d\x02\xf5\xec|\xee\xd8=\xc5!9\xee(qZ\xbcw`\x86\x04$A\xe3Y\xe7\xa4\x00f\xbb\xe7ܺ=#\x83\x90c\x11\x84\x96\tȷ\x13B\xeb\x96\xf4\xaa-)\xc7\xcc\xd9\x01\xe8b\xed\xff\xd9\xd6`\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x90\xf3\u007f\"\xae\xc3\\\xc5pk>\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -0,0 +1,7 @@
+package res
+
+import "embed"
+
+//FS holds the game's default assets, built into the game so it runs without an assets directory
+//go:embed *.png *.json *.wav dialogue/*.yarn scripts/*.lua
+var FS embed.FS
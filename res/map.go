@@ -1,6 +0,0 @@
-// Code generated by file2byteslice. DO NOT EDIT.
-// (gofmt is fine after generating)
-
-package res
-
-var Map_png = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR\x00\x00\x01\x90\x00\x00\x00\xe0\b\x06\x00\x00\x00-\xea0\xb9\x00\x00 \x00IDATx\x9c\xed\x9d\u007fl\\\xd7u\xe7\xbfc\x8b\x1c\x86\xfaArĊ\")[KQ\x16i\xcb\x11+\xbbRlCE\xe2t\xb7\xb1\x9cV\x05\x8a\xa6\xd8n뮑f7\xc2\xc6[\x03\xd9\x16\x85\xdb.\x8a\x05\xda\bE\xdb\x00)Z@.\xda \xad\x1b\xb4\xd8d\xff\xb0\xd1J\xcenӸ\xa8`;rd\x85JdQ\xbf\xac\xca\x12\x87\xa2B\xce\xf0\x87Ds(\xd9o\xff\x18\x9e7\xf7ݹ\xf7\xfd\xbc\xef\x17\xe7|\x00A\xf3\u07bb\xe7\xdd\xf7\xde\f\xcfy\xe7\x9c{\xee\x05\x18\x86a\x18\x86a\x18\x86a\x18\x86a\x18\x86a\x18\x86a\x18\x86a\x18\x86a\x18\x86a\x18\x86a\x18\x86a\x18\x86a\x18\x86a\x18\x86a\x18\x86a\x18\x86a\x18\x86a\x18\x86a\x18\x86a\x18\x86a\x18\x86a\x18\x86a\x18\x86a\x18\x86a\x18\x861E!\xea\t,\xcb\x02\x00|\xf1\xb11\x00\xb0\xa2\x9e\x8f\xf1\xe6\xcbo\x8ek\xbf\xb7\x03C\xbb\x00\x00;\v\xed\x00\x80\xaf\xbd\xfbC\xd7sQ{\x008q傉\xcbc\x98\xdc\xf0\xe0ӟH\xbcϕ\xcd+J=\xd9>\xdb\x1eY\x1f\ae\xe2\xf8\xbfD\x92_g\xe2\"^\xfa\xc2O\x03\x80\xb5\xf7\xd1>\x13\xa7c<\xf8\xe2cc\xd6\xdeG\xfb\n\xcf\xfc\xf9\xffU\x1e\xdfYh\xc7\xee\xf6\xcd\xf8\xcd\t\xef\x1fǉ+\x17p`h\x97mp\x18\x86\x89\a2\x1c\x9dc\xf3\xca\xe3K\xe3]\x16\x90\x8e!\t\x8b\x11\x03r\xfaԴ\xa7\xf1\xf8\xd2\xdd?4\xd1U\xcb\xf0\xdb\xeb~K{l\xef\xa3}8}j\xdazqhWA\xe55|f\xe3\x18>=\xfeu\xdf}\x9d\xb8r\x01\xcf\xeex\x18\xcf\xeex\xd8\xd3ca\x18&\x18:\xc31\xba}=\x00`\xe2\xeam\x88\xc7\xf3dH\"\x1b\x90շa\xedq\x9d\xe1\xb83\xa5\xb6\u00adJ[\u007f\x97c\x9b\x9e\x9bΐ\xec}\xb4\x0f'\x8f\xfd\x9b\x05E\x182\x88\xf1\x90C^~\xdb\x03\x1c\xf2b\x18/V6\xafXd\x18F\xb7\xaf\xc7\xc4\xd5۶\xe1 \xc4\xfd\x13Wo;\fI֍\x88\x11\x0fD\x05\x1b\x8e`\xd0s\x11\rɝ\xa9y|\xa9\xdfݐȄQ\xea\x1c\xf2b\x98x!\xa3!\x1b\x0f\xd5q\xf2H\xf2\x80q\x03\xe2\x16\xaab\xe3\xe1͝\xa9\xf9&#\x02 \xb0!\t\x02\x87\xbc\x18&<\xba\xa4xXDO\x84\xc2Y\"Y\xf2J\x8c\x1a\x90ӧ\xa6\x811\x93gd\xeeL\xcf\xda\xff\xb7\xf5m\xc6\xe9\x9b\xd30=X!\x88\xf1 \xbe\xf6\xee\x0f\xf1쎇\x8d^\a\xc3\xe4\tUnC\xcck,\x8dwi\x13\xe6A\x90\xcf!\x1b\x95Zy\xd9\xfe\xbc\xb1\xb8)Q\xe3\x12K\bK\xf64\xda\xfa\xbb\"y\x1f\x87n>\x1fJ\xee\x95-_\t\xddg\x9a\xfd\x93\x17\xb2r\xfe\xa4s?\x00\x14\xea\x86ڔ\x11\x89\x92\xc7`\xef\x83iU(\xb7Q7\x18갔\x88*\xf7\xe1\xd6V\x85\x9c#!:ǀ\xa5\xf1z\xd4b\xb1\xbc`%iD\x8c\x1b\x10\xd3a\xaaC7\x9f\xc7\xf0Pw(ه\xde\xfe<\xde\x19y1\xd7\xfd\x8bXs\x97\x81\x1ec\xa7c\x18&\x04N\xe3\xd1@V\xfc\xb2g\"\x1a\x11j+\xe6<\xe4\xfc\x87\xec\xc1茐Өt%jD\xeeI\xa2\x934\xa1\x10P\xab\xf6\xcf0\x8c9\x16k\vJ\xe3\x018\x13\xe1n\xc7\xdd\x10ۈ\xa3\xb7\xe8\x9f\xca;\x91e\x8a\x03\x1dX\xac-$R\xd4\x1d\xdb(\xac\xb8\x18y\xe2~\xd7\xe3\xe7_\u007foM\xf7\xcf0L:,\xd6\x16\xac\xe2@\a\x80\x9a\xb6\x8d\xa8\xccU\n_\xf4\"\u008c\xbc\xd2y 2Ł\x8eD<\x91\\y ^\xca\xdbo\x9b\xbc\xf6\xcf0L:4\x8cG\x83\x89\xab\xb7m\xe5-\xffOPnBl\v荋\x1c\xc2\x12\xf7\xbb\xe5FD\xaf\x87<\x97$<\x91\xdcy \x00\xf0\xa7\u007fvR\xb9\xffן\xdb\xdf\x12\xfd3\f\x13?\xa2\xf2%\xe3Q+/\xa3s\xac\xd9P\xa8\xea<\xeam\x9c\x05\u008d\xfd\xee\xc8\xf9\x0f\xbf\txbu\bp \x990\xe4ҀT\xcc\x0e\xbb\xce]\xff\f\xc3\xc4\a\r\xcf\xed\x19\x13CU\xf4\xb9\xcb\xfe\xac\v'\xc9\xfb݆\xf3\xaa\x8cI\x18\xe3\xa1K\xb0\xc7\x1d\xcaʥ\x01\xb9~{\xb1\xa5\xfbg\x18\xc6<r]\x87r\xc4\x14\xe6=GGQ{j\xb74ޥ5\n\xaa\x90\x95\x9b\xb1qˁ\xa4Q\xc1\x9eK\x03\xc20\fc\x12q\xce*\xa09\x19.~\x9e@Sa\x9f\xbdO\x0e\x1b\x91\xe1\xa0z\x11U\xe8K\x9eLQ<O\xe7ؼC\xc6m.-\xb9\xcf$`\x03\xc2\xe0\xd9\x1d\x0f+cr\x97\xac\x15\xd79\xaf\xf2~\xfck\xef\xfe0\xb2[O\xebI\x98\x9e\u0382QCU\xd7\x14\x929w\xec5\xa3\xe7W\xd5v\xe8\x14vCi\xebs\rA\xbc\t?\xa3\xb1D\xcf\xc5m\xc4W\x12\xf9\x0f \xa7\x06\xe4/\xff\xe7\xbfo\xe9\xfeM\xf2쎇\xad\xcfl\x1c\xc3;\xb5\xeb\xcd\aWf\xa1=\xb6\x06\x8e\x1f\x18\xdae\x9d\xb8r!\xb2\x11\x91\xdf^M\xa0\nq\xb4\x92\xbc\xee\x1cTum2\xae\xaf\xfb\xfe\xfc^\xbb\x9c\xb0\xd6M\xdb.~vK\xc2\xcb\xd5\xe6:\x191\xb4\x06\xe8=\x8f\x0fn\xdf\x05\x8a\xben%0\xb92 Ǿ~\x06O\xff\xf2\x1e\xcf6a+ǳ\xde\u007f\\|cq<Ա\xb5p<*\x8b\xb5\x05\xabg\xac\x16(\xd1)\x0f\xe7\x14\x8f\xd1g\x11\xb76kQ\xde\xeb\x1c@#9\f\x03\xab\xaa\x8a\x88\xd7\xe5\xe6\x8d\xc8ߣ\xb3\xb8P=m\xbb\x88.D\xe6\xd6N\xbc>\xf1\x98\xec\t\x89\x06M\x9c'+\x0ere@\x86\x87\xbaq\xec\xebg<۬\xd5\xfe\xe3B|K?\xeb\xf2\xd6\xeev,\x8f\xc7/զ\x94\xed\xc2\xe0g\xae#\xaf)\xbd\xe5vnm\xa3*\xa7,\xcb{\x9d\xc3\xe4\x10Uz\x01\xf0s]\xf2\xb4#2\xaa\"\xc10\xc8Ӟ\xc8\xe7\xa6m\x15\xaa\xe7r\xef\xfau\x98\xabT\xec\x10kw\xa9d\xcc\xe8\xe6ʀ\x00\xe9+\xe8\xb4\xfb\x8f\x03Y\xe1jCB\x1e\xc7\xd6\xc2\xf10DQ\x16A\xce\x11\xe4Mu-\xca\xc7=\xcaH\xf7\xb6\xefU\xfd\x1d\xa4\x92<쵨\x8cG\xd3\xf5m\xbf\xdbtm\xb5r=vu\xef\xfa\x86\xaa\x9f\xabT,SF$w\x06\x84qG^a\xd0ό\xb9gWf]\xb7\xfd\x1e[\v\xc7\x19\xc6͛ԅࢼD\xb8\x19*7\xc4d\xbe.|\xd8s\xb0\x86\xeaqg\x02\x84<\x12\x13F\x84\r\xc8\x1a$\xc8\n\x83\x00\xf0\\\xef\xcf(\xf7O\u05ee\xe1\x1b\x8b\xe3\xda\xe3^dY~\xbav\rG\x16\xde\nu^\x99\xb0\n\x80\t\x06)L\xd3#\x8c(\xf9\xece\x14\xc4\x1c\x96\xee\xbbV\x1d\v2\x95\xbb\x1f\x19\xbf!B\xba\x1f\x95\x111E\xee\fHړ\x19\xa6ݿ\x1f\x82\xae0\xf8\x9d\x857\\\x8fO\u05eeE\n\xfd\xa4-\xefu\u007f&`\xe3\x91oTo\xf0\xf2\x9b\xbd[\xdeC\xf7\x12\xe16\x99\xa2\xd7@\x82(\xf7ᅐ\x17\x89\xe4\x85\xf0d\x8a9\xea\xdf/A\x8c\xc7%k\xc5\xf3x\x14\xe5\x9d\x05y&\xdfȓ\t\xc6Q$'V\x8f{\xe53\xa8\x8dW\x05\xb8nح\xae\xadx^\xbf9!\xb7s\x8a\xe7\x90'\x824E\xee<\x10 \xfd\xc9\f\xd3\xeeߍ0+\f\xbe\xec1\x1a\xc9\xebx\xd4\xf3g]\xde\x0f&\xde\x1e\x19oL\x87\xaf6\x167\x15\xeaÁ\xa9\x9a\xbcˮ,_\x1a\xef\xb2\x13\xd3b\xb5\xb9\\8\xe8gD\x99\xec٨\xe4ht\xd9#\x87\ueeb6Sᷭ8)\xa4\tri@Ҟ\xcc0\xed\xfeMB\xf9\x12\xa0\x91`\xa6m\x11\xb7cy=nʰ\xb0\xe1\x88\x171ē\xd4,\xb3\xe2T\xeau\xba\x1cӋ\xa8\x8a\xf6\xe4\x1c\x89\xaaR\xdc9\xb5\t\x9a\x8e\xd7ϩϡ\xc8u*~\xbc\x9f8\x9fW.\rHړ\x19\xa6ݿi\x9e\xdc\xf48\xa6k\xd7\x00\xd4\x15\xad\xb8\rԇ\xbe\xeen߬=\x06 w\xc7\xe3\x18\xce˘G\xa5 M\x1b\x91\x8d\xc5M\x85\xb9\x8b\x15\v\xe8B\xad\xbc\xec\b\xf7\x90§>\xebo\xee\r\xe3\xe1\xf4L\xdc\xc3T\xb2\xf1P%\xed\xc5\x10\x96خ\xc9 \xaczF\x84ʘ\xc8Ϩ8\xd0a\xbc\xb00W9\x10&>d\x85\xdaW\xbcO\xb9\xdf\xebX\x1e\x8e\x9b\xc6+\x16\xcdDCU\x15\x1e\a:\xe5Z=^\xb4G1\xf5\x1c\xac)s0\xa2\x11P)s\xaf߇\xaa\xc2\\ަY}\xa9\xbd\xea\x9cI\xff\x16s\xe9\x810\xf1\xe3\xf6\x96\xee\xf5\x06\x9f\xf5\xe3q`B\xc1\x95\xfea\xafﶕ\x9f9\x1d\xb9\xbf<\xa0\n\x03\xc5Aw\xa9T\x98\xabT\xac{\u05efk2$䑸%\xefUo\xff\x13p\x86\xaaH^\xe5a\x88FB\xf6.\x00\xe7T\xf2b\x1f\x00\x9af\x02\x96=4\xf2\xaa\xc4\xfb\xfa\xe0\xf6]t\x97J\x85\x1b\xda;\xf2G.\rHړ\x19\xa6ݿiĐ\x0f\x93\x0e\xb5\x17{\xb1u߀\xef\xf6S/^C\xf1\xf331^QvHc\x96Y\xa7\xd1h^@J\xbe&R\xdab\xa2\x9d\x8c\x81\x98\xfb\x10kND\xa3\xa1K\xd0\xcba.q}\x11\x1d\xf2<Xq\x84\xae\x88\\\x19\x90\xb4'3L\xbb\u007f&\xbb\x98\x1a\x85u\xfa\xad\xefz\xb6ٻ\xefc\x91\xfb\xc9#\xf4\x16\x0e\xc4cHD/\x04h^X\xaa\xe9Z\x84\xa4\xbe\xeezT\x86\x86>\xcbFC\fO\xa9\xf2\"\x84x\x0e\xf1\u007f\x1d\xb2\xf1 \xef\xc3U\xc8'\xb92 iOf\x98v\xffI\xa1\xf2H\x1e*n\xc3ٕY\xed1\n\x19e\xfdx\\\xf0H\xac\xf8\x89\xa3\n]D4\x1er\xbf~\xbf_9\x8c\xa4Z`J\xb5\xed\xd7\x00ѹE\xe4\xc4?azȮ\x8a\\\x18\x90M\xa5\"\x16*u7ү\x82\xdeT2W\xba\x9fv\xffI\xf3N\xed:\x1e*n\xc3C\xc5m\x81\x8e\xe5\xe1x\\\xe1:S\x1eH\xf9Ƥg\x1b\xff\x99\x92\xb5A\x1a\xc3xU}\xebBX\xaak\x92\xb7e\xa5/\x1b\x03\xd9;\x11\xebO\xdc\xe4\x01g\x88J4\x1a\xb2Q\xf9\xe0v\xfd\x9c-7\x1b\xefB\xa5\x16X!\x9f>5\xbdf\xfaw\x83V\xc4\x13\t\xbaJ\x9b8\x95\xbb\xbc\x8fp;\x96\xa7\xe3Y\x9d\x8d\x97I\x17\xd1\xfb\xf0\xaa\xda\xd6M^\xa8Z\x92\x96\xf6\xcb\xc6G6\b\xb2\x11\x92\xd7\xf8\x10ϯ2>dDdo\x83\xb6M\x86\xadDra@\x80\xe0\n\xf9Rլۖv\xffq³\xf12\x8c\x13q\xc4\x14\xe0>\x02L\fC\xa9\f\x83\x1b\xa2\x8c\x97w\xa5;Fr\xb2\xf7!'\xd1MN\xe3Ndހ\xbc\xb2\xe5+x\xe8\xedχ\x92\x1d\xef\xfe\x12\xda\xfaԕ\xc9y\xe9?\f\x0f>\xfd\x89@^H\xabT\x9e\xab\x8e\x9b\x80g㍏$\n\tETE\x84\xe2u\xa8\x92\xda^ץ\xab:'\x19\xd59\xbcBb\xf2\xf5\xc9\xc8!\xad\xe2@\a\x96.\xdeR\xb6\x8dB\xe6\r\b\x00\xbc3\xf2b(\xb9\xb65\xd2\u007f\x18\x82\x18\x11\xb1z;\xab\x95\xe3q\x1c7\xb9\"a\x1aƣU\xe6\xdfҭu\x11'rr[6$\xe21y[\x0e5\x11\xf2\xf7%\xb7\xb7\xef\x13\xfaP\x98ܟ\xaa\xdeC\xf4>xI[&4b~D6&tL\x1c\x10\xeaV\xd9\xedV\x9c\x97\xf7\xe3Y\xe2\xd3?\xfb\xf3i_B\xa6H\xaa\x90\x90\x10\x93\xcf*C\xa0K\x8e\xeb<\"y\xaa\x13@m\bU/\x02*\xe3\xe15\xa0@W\x04\xc9u LhT\x9eȹc\xaf9\fL\x96+\xc7\xf3P\x99n\xc2\x1b\xe8\x1f\xec\xf7\xdd\xf6\xb4\x99\xb5\xb0rA\x92\x85\x84~\xa6=\xf7c4\xe4m\xb9\xd0P7\xbaJ\xeeG\fS\xa9~_\xa2\x11IbخLb\x06\xa4\xad\xbf\vw\xa6\xcc\xcf\xe3\xaf\xe3\xd0\xcd獞\xef\x95-_1z>7\xda\xfa\xeb?\x88B\xf70\xac\xb9ˑ\xce\xe5\x16\xc6:w\xec5<\xbb\xe3\xe1H\xe7g\xccP\xbe1\x89\xd3o\x9d\n\xd4\x1eh\x9d0\x16\x10o!!\x15\x11\x8a\xb9\x037\xcfC\xb7_\xf4`\xc4Ϻ\x90\x98\n/OK^\xc4J\xec\x9bHʘ$\xea\x81$eD\x0e\xdd|\x1e{\x1f\xed\xb3k7\x8c\x9c\xf3\xca\xf3\x89\x18\x112\x1eI\xc2S\x99D'\xaa\x12\xaf={\x17\xa7\xbe\xf6f\xa0\xf6&\xfa\xcd\x13q\x17\x12\x8a\x88\x86\xc4O\x9d\x87(#+m\xd1X4BP\x8d\xf3\xc8sd\xc9\xfbUK\xee\x8a\v`\xc9\u05eb\xba\x8e\x0fn\xdf\xc5ԛg\n\xaaa\xffQH<\x84\x15\xb7\x82<8\xfe,\xf6>ڇ\xfe\xd1>,\x18\\^vx\xa8\x1b\x87\xae<\x8f\xe3c_3vN7\x92\xf4\xd6d\xb2T9\x9e\xd7\xca\xf40T\xf7\xaeG\xcf\xe9\xdb\x18\xd8:\xa8mS\xbe1\x89\xea\xde\xf5\xe8D\xf3\x9a\x12k\x95\xa4\n\t\xe5\xa9Ld\xef\x81<\ny[U\xc4'n\xab\xa0{z{\xbc\xbem\xafH(ϥ%`oo\xbf\xab|\x06*\xe3AŃq\x18\x0f \xc5\x1cȝ\xa9yܙ\x8eaL\xbe0\xcay\xe4\x89\xfb15a\xa6\xa0\x8f\xbc\x99\xa5\xef\xbfk\xe4|2\xaa\xe1\xbem}\x9b\xb1\x121\x84嗬V\x8e\xc7}\xdc\x14QCI\xa3\xdb\xd7\xe3\xed\xf1\xba\x11\xc1i}5zuoc\x8e$&^\xe4p\x94\x8c\xdb$\x85\xaa\xe1\xc0n9\f]^E6Z\x00儺\x1c\x06\x8c\xfe'\x83AP\xddG\x1c\xc6\x03H\xc0\x80\xc8\x1eG\\\nX\xa4\u007f\xb4\x0fo\xfec\xf0\xa5]\xdd\xd8>\xdc\x05\\1zJ\adLM\u05cd\xb8\r\xe7}\xf0\xe9O\xe0c\xc8v\xe5x\x12ǣbJ\x99\x932\xa9\xee]\xafU,\x8dpHk\x18\x90\xa4\xeb@\xc8\v\x01\x00\xd5\xd4\xee\xba\x1c\x87\xaa\n\\\x87\xeee\xc3m\xba\x12Un\xc6m\xb8\xeeԛgb5\x1cD\xa2\x1eH\x12\xc6\xe3Ru\xd96\x1e\x9593\t\xa4Rw\a\xae^\x9e\xafW\x97\xc7<W\xe2\x9d\xe9Y\x87\x111\x91H\xf7\xfa\x11q\xe5y6\x10\x93Āw\x15s\xab\x90t\x1dHw\xa9T8w\xec5\xf4?\xb6\xc76$\"n^\x87\xec\x15\x00\xcd5$\xf2\x9a\x1f\xa2ATy\"*OH\xe5\xfd\x88\xe1\xaa\xc07\x1d\x92\xc4\fH\x12\xc6C\xa42\xb7\x8cR\xb7\xf7\x90\xbc\xa4\xcf\xe5\aو\xc4M\xab\xae\x89n\xaa\x900\x8eJt\xd5\xdcI\xf5}\xad\xe1y\x10Iׁ\x88\x90\"&C\"#{(\xbaYq\x81\xe6\xaaq\xdd\xd0d\xb7E\xa1DT\xf5\x1e\x14\xc2:0\xb4\xcb:q\xe5B\"F$\xd6%m)|\x95fB\xf8\xdd\xf2\xbc\xfd\xbf\xf8Y>.\xb7\x91\x8f%M\\!-\x15Onz\x1c\x0f\x15\xb7\xd9ʕ\xb6\xc5܁۱<\x1e7\x8d)\xe3\xa1Z\xbe5\xa9%]\xb3\nM\x1dB\x89\xe6$g\xe3\x05\x80\xe1\xe9e\xec\xab\xd60<\xbd\x8c\xe1\xe9\xba\xe2\xbew\xfd\xba\xa6|\x83\f)y\xd5\x10^\xd54\xee\xa2QP\xadgN\xff\x8bFJ\xac<\xff\xe0\xf6]\fO/\xa3\xd4^\xc0\x81\xa1]J\xa3g\x9a\xd8\f\x88\x98\xfb\x88%Y\x1e\x002\x04;\x06\xba\x9a\xf6\x89\xdb\xe2\xf1,Q\xe8\x1e\x8e\xbd\x0f^\x13=\x1a\xbc.z2t\x8e\xcd'\x12\xda\xfb\xec\x9eQ\x00\xc0\x81\xa1]V\xa9\xbd\x80\xdeb\x01\xa5v\xe7K\xbd8ZK\x97\vqˋ\xa8\xe6\xb2\xd2\xc9\xea\xce#\xef\x17\xaf5\t#\x12{\b+\xe9Е\f\x19\x05\xd1`\x88\xfbd\xa3\xe1v,i(\x94\x95\xe4h,\x82\xd7D\xf7O+{\aI\x91t\xf8\nh\x18\x0f\xa2\xb7\xb8\xfayz\x19\x97\xfb:\x9ar#~\x96\x8e\xf5\xaa'\x91s\x1d\x1fܾ\xeb\xe8Gu~\xd1\xfb\xc0\xea\xf5\xda\xd7z\xael\xcd>8\x10[8+\x16\x03\x92\x85Е\x8c\xca\x18\x88\xfbt\x86$mȈ\x98H\xa6\xeb\xe0BB&\xcbd\xcd@\x97\xda\v\x0e#\"W\x80\x93B'\xc5\xef\x96\x1b\xa1\xe3nx\x85\xca\x00ء\xab\xa4\x89\xd5\x03I3tU\xea\xee\xa8\x0f\xbd5A\xb2/\xffJ\xd2\xf0B\x18\u007f\xf0t\xee\xf1\x92\xe4\\X:fj\xceh\x90lD\x00\xa7\aB9\x12\x95\xf7 \x8f\xd2҅\xbfl\xaf\xc2\a\xa2\xf1\x90\xaf5N\x8c\x1b\x90\xb4\xbd\x8f\xef\xe3\xd7P9\xff\x97xj\xa4\aW/\x9b\xbb\x86W\xcfW\xf1^\xfb琼\x8dwz!I\xc0k\xa2\a\x87\x8dG|\xa4\xb1\xa4\xed[\xbfz/\x96\xc6K\xc0\x89\x19{\x9f\xfc\x86\xef扐\xd7@FDU\x9d\xee\xa7b]\u05f7\x8aʊ\x85R{\x01\x95\x95\x86\x01\x99:Ћα\x1f\xe1\xee?\xffXS\xfbs\xc7^C\xa1\x10M\xa3\xad\xb9\xd9x\v\xdd\xc3xo\xeesx\xf5\xfc_\x1a=\xef{\xed\x9fKL\x81\xebh\xeb\xdb\f܌\xbf\x1f^\x13=?\xd4^\xecM\xad\xef\xe2\xe7g\xbc\x1b唥\xf1.\v\xa8+`\x9b\x133\xb6\x82&\x85.\x1a\x11\x11\xd1s\xa0c\xaa\xc2D\xa09\x84EƇΡ\xcc\xc3\b\x88\x1eGe\xc5r^\xf3꽴#\x9ew\xdf\xd8\fH[\u007fWj!,2\"\x00p\xff\x8aӐ\xbc\xd7\xfe9WY]\xfb4\x8dG\xdcCyyM\xf4h\xa4\xe5}\xd4^\xecE\xff\xe0}\xd8:0\x90x\xdf7\xcae\x9c\xfa_\x93\x18\xfa\xbdb\xec}\xc9\xc9\xf3\xa4\xbc\x10\x99\xa9\x03\xbd\xb6\x11!E>S\xab\x1b\x93RU\x9a\xb8UP\xfa\xa5j\r\x95\x15\xcbaHT\x88\x86\xa3\xd4^p\x9c\x03h\x18\x8f\xeeN\xf9\x99\xd7l#\"\x1b\x8f\xb81j@\xf6>\xda\a\x9cz֞p\xb0\xf3\xc7w\xc47\xe7\x95\x06k\xee2~\xa1\xe7\xeb@O}\xfb\xe4tÂWV\xac\xfa1\x17\xdc\xda\u007f\xb3\xfaˉ\x1b\x12\xd1x\xd0\x14\xf5{\x1f\xed3ڇ\xe95\xd1\xef\xd9М\xf4\xbb\xa7\xbd\xfe\xff9\xa8\xe7&\xf3s\xfc\xc3[\xeb2]\x99\xeeg>\xac\xa0sf\xe5i\xba\xf6\xa8\xf7\xaf;&\x17U\xa6\x89h<\x80f\x8f`\xa6f9\xf6\x91b'##\x1a\x12\x19\xd1p\xa8\xce\v8\x8d\x87X\xdc\xdc\xddY\x9f\x85\xc3\xdc\x1a\x9b\xfe0\ue050\x11q\x90d\xe2\xa0\a\x8e\xa9\xdcON\xbfo\u007f\x19\x14\x1b\x1c\x1e\xeaƦR\xe3\x8b\x10\xa7}\u05f5\a\x80\x1f\xaf\xfe\x150\xd7\xe8jgO\x02\xd5\xe9R\xc8ʴ\xf1\x00\xccV\x9e\x9fk\x9fƓ?y\xbf\xe3\x99ҳV\xed\x93\xf7\x8b\xc8r\xdfz\xfb\x06Jw\xda\x1c\x95\xf3\xba\xeb\xf3s\xdcd%\xba\xac\xf8\xc4}:Ũk\xe3\xd6\x1e\xc8V\xbe%\xe8\xbd\xc9m\x00\xf5\xfd\xc8\xf2\xbavQ\xd1\xcd\x137\xfc\xcc\x13Z\x99f\x0f\xa0\xce\xdcR\r\xbdłÈ\xd06\xa1\xf4V\b\x85\xe1 z\x8b\x85\xa6~\x83L\xd5\xe4wy\xeb\xa0\xc4\x12\u008aC\xc9y\xb1P\xa9\xe1\U00095e66\xa9\xdcK\xed\xf5\a?\xb7T\xb3=\x8bM\xa5\xa2V\x99\xe9\xda\x03\xc0\xfe\xa1n\x9c\xbc2\x87\xfdC\xdd\xe6Fxe\x001_\x10t\xcdq\x92}\xa7v\xdd6\x1e\xaa\xa9\xf4\xe5\xe7\xedǘ\xd0gjO\xcf_4\f\xba\xeb#\xbc\x8e\x9bF\xf7\x86lj\xbf\x8a +\x19\xfaejr\xca\xf5\xdc7\xcae\xe5\xfe\xa8\xf7㵐RZ\xa3\xb0\x00\xe0\xe3\xe3\x15t\xf7t\xa0\xd4݁\x9e\u0557\xc7ju\xb9I\x91\xcbF$\b~\xe5\xe8%x\xa1R\xb3\xafagO\az\xc7+\xf8\x97\xb1R\xe0~\xc3bĀ\xf4\x8f\xf6\x19\x9b6=\f\xa2\xf1\x90\xd9\xd9\xd3Q\x9f\x04q\xf5\xb3j\xa1)q[n?<ԍ\x85J\r#O\u070f\xab\x97\xe7m%\x06 U#\xd2?\xda\a\x1c\xfb7#犺\xa6\xb8h<\xbc\x90\x8d\a\xa0\xf6Ld\xe31\xf2\xc4\xfdx\xf3\x1f/8\x8c\x88\xa9\xeb7\x81\xbc\xc0\x8fnfUqa ՚\xd7~\xdaӄ\x8bC\xa8ǻ\x83\xacd藽\xfb\x1e\xf5uny\xcd\xef0\xf7\xaf\x93Oz&^7>>^\xb1=\x80\xca\\\xc3h\x94\xba\xeb\x06\xa52\xb7l\xbfx\x12\xe4y\x045$\xaa0\x18m\xcf-հc\xa0\v\xd5\xea\xb2\xc3x\r\x0fu\xa3\xba\xaa\xb7>\x9e\xa0\x111b@ο\xfe\x9eѵ7L@׳\xb7ԇ\xbd\xd21\xf1\x8dWFlO\x86\xb1\u007f4y\x8fʍ\xfe\xd1>\x1c\xfb\xfa\x19c狣\xb2[\xfe=\x88\xcf\xdc\xed\xf9\x8bm\xe8\xf9\x8f<\xe1n\x98\xb2R\x99\xee6a\x1eм\xf6\x03\xed\v\xd2>)\xc2\x18\xa50\xf7\xef&\xafZ\x95/\r\xc8x\x90\xe7!\xbf\xf9\xeb\bぐ\x9c\xca\xf8\xcc-\xd5\xd0\xddY\xb4'w\xad\xcc-۞\xc8\xd5\xcb\xf3\xf6\xb5tw\x16\x133\"\xc6BXdDRab\xda^\xab\x83\xd6\x02\x11gЕ?\x03\xd0ή+\xb6=}jڞ\xca\x1d\x00zz:l+O}\xa5\x81I\xe3\x014\x87u\xa2\xd4U$\xf9\xfc\xfd\\_\x12u!\xaa7b\xdd\"A\xaa\xa9\xbb\x83\xb6O3\x8c#\xa3\xf3\b\xa2ޏj\xb2\xc1\xb4\xa1߮\xfc\x1b$\xc5.\xa23\x1e䥸\xe5Q\xfc@S-\x89\x9e\xc8\xdeG\xfb\x1c\x86$\t\"\x1b\x90\xb9\xa5\x1a\xfe\xee\xcd)\xfc\xd2c\xfd\xc6\x17q\xf2\x8b\xf8\xc0T\xd7@\xd6Zt7u\x0fYVt\"\xd5\xear=\xf6yeun\xad\x94\xee\x17\xa8'\xfbMpφ\xbbxyq\n?W\xec\x8fT\xf7qΪ{\x1bI=\xff\xa0\xd7'\xe7y^6\x94@\x97QM\xcdm\xb2}։z?n2I>\x9fα\xf9\xc2\xd2x\x97\xb5\xfb\xe4\xac#\xefqy5|\xadz\x01\"\xe5/{\x0f\xf2\xc8)\xb7\x97'20\xe4\x85й\xe40\x96\xca\x13\xa1\x17-:\xff\ue4f38\xbb\u007f3:\xc7\xe6\xb3=\x17\xd6\xe7\x9f\xfewx\xf1ؿᩑ\x1e\x13\xa7\vDen\x19\x97\xaa\xf5\x04\x92\\y.\xbe\xcd҃\xa6\xcf^\xe7Ե\x13\xdf>Қp\xf1\xe4\xf4\xfbص\xf1\x1e\\X\xfc\xd0\xc8\xf9\x9e\x1a\xe9\xc1\xcb\xe7\x9d\n\xf5\xec\xca,.Y+\x80f\xd8k\xd3\xf1v$\xfe\xfc\x03]\x9f\xc0˵\xa9\xa6\x8a\xdd$\xf0\x9aH/j{/\xa6&\xeb\xdeX\xff\xe0}\xae\xc7\xdd\xda\xc8m\x83\\\x93\xe9\xfbI\n\xd1+\xa0\\\x83\xf8\x82\xd3\xddYĥ\xea\xb2\xeb\xf0^\xc0\xf97\x10\x86\xba1qz(\x95\xb9e\xcc-\xd5쿋$\xbd\x0f\xc0`\x12\xfd\xf3\x00^4\x94\xd4\rå\xea2\xf6\v\nG|ӕ\x15\x91\xd7CV).Q\xa6\xb2b\xd9\xca\xe7\xc2\xf9j\x94\xcb\x0eŮ\x8d\xf7\xd4\xdfj\x16\xcdx!\x8f}z\x17\x80\vMF\xa4n\xa4V\xb4\xc3]\xc5\xe3%\xab\x80\x1d±؟?V\x02]\x9f\xe3\xfc\xed\x05\xec\xec\xe90\xe2\xc5\xf9\x99\xe8.\x0ezN\xdf\x06\xb6v;\x94\xbe\x8a\xf2\x8d\xe6\xf5\xd5U\xfbtm\x06\xb6\x0ej\x8f\xcfܼ\x89{1\xe4\xe3j\xf3I\xff\x89\x19\xf4\xae\xfe\xad\r\x0fu\xdb\xde\a)m\xf2,T\x89sBnC\xbfm\x1a\xc5%\xe6S\x80\xe6e&Īw:\u007fo\xb1`\xf7/z#\x04\xfd\xed\xed\xec\xe9@\xe5\xc4\f\xe6\xc7ڢ?\f\r\x91\r\b=\xa0\xfe\xd1><ep\uea60\x9c\xbc2פ\xacD%\x16HYL\xbf\x8f\xfd}\x1f\xd1\xc7\xedW=\x80\xb4f\xec5\xf9\x96A\xdf\x1f\x19\x11\x99\x1dM{\xd4\xc7\xd3z\xfe~\xafO$\xae\xb7\xb4\xa5\x8b\xb7\xecϝ\x0fl\xf0<\x16\xb6\xfd\xe0\xad\x02\x1e}\xf41m\x05:\r\xb1=u\xeaM\xcc܌>\xf7\x8d\xaa\xaf\xfer\x19\xa7N\xbd\x89ɋ\xb7\"\xdfOX\xf9\xa4\x98[\xaa\x8f\xf2\x94\xf3\x13\xb2ס\x9aĐd(\tO\x90\xc1\x90\xf3)M\x06G\x9a:\xc5\xd9Os\xbeD\xbcF\xbf\xf9\x94(\x18\xf1@h\xa4RO\x12\x85u:V\xe3\xe2\xaa7ޓ\xd3\xef\xdb_\x808L\xd7m\xfb\xe4\xf4\xfb\xd8/\x9cC>gwg1\xb5\xfb\xa57 S\x18\xf9\xfer\xf4\xfcM>\xbf\xeeR\xa90w\xb1b\x01\xc0\xa1\x91\xc7m\x8fୋ\xce$\xfd\xbe\r\x8d\x1c\f\x1d\x13\xf7a\nx\xeb\xd6u_\xed˷\xeaށ\xae\x16#\x0et}\xed۰My?\xf2\xfd\x0f\xde*\xd8ތ\xdcޏ\xbc}\xef7&1)\x19\x9e8\x11\xdf\xf8U!$\xdd̷$\x17\xb4\xf8O\xf4l\xe4\"D\xb7kK\vㅄ\xb2E\x95\xf1\xfa\xa3\x8f\"\xaf\n\x9b\x90\xf2r\x8bO\xea\xb6ON\xbf\x1f(\xafS\xad.\xe3U\x8f\x90\xd6S#=\xae\xf7\x10\xf5\xf9E%\xcf\xcf?\xea\xf5\x87\xe1ܱ\xd7\xf0\x13\x0f\x8f\xe1\xd3?\xfb\xf3\x8e\xfd\xfbV\xa3D\xe5\x1b\x93\x18\xd8:\xe8\xc8)웬\xef'C\x00\xd4CE\xa2\x02\xa5\xf6S\x93\xd7\xec\xfd\xb4\xcfO\b\xca+\xb4e\x12\xd5u\xef\x93/q\x83\xf3\x98x\xff\x83(8\x9e\x91x\xcf\xf2y\x01`\x00\xc0\x993\xa7\x81\x84<\x11Q\x89{M\x95.\xe6\xd5Ĥ7!\x16!\xca\x05\xb4\xf4\xdbU\x19\x04:\xaf<+\xaf\x9c\xb0W\x19\xb981f@\xa6&\xa6=\xffx\x01\xef?\xf0\xa8\xf2\xaaQ>\xf4&0\xb7Ts\xbcɺo\xd7\\\x93\xacb\xe2\x8ax\xf5|\x15\xd7o/\xda\xdb\xdb\xd6o\x04\x00ǾW\xcf#\xd2`\x83jլ\xf7A\x98\xfa\xfe\xd2|\xfe~\x88\xeb\xf9Ɉ\nO\xfc<5y\xad\xae\xfc$fn\xdeĞ={\xd1?x_S2{j\xf2\x9agR[\x86r\x14I\xa0\xba6y\x1fݓ\xdb\xfd\x93\\\xd0{\x8d\x8b\xf9/\xb4\x15\xf0\xe7w,\xb7\xa9\xd4\xe5ߨ8\x99a\xe9\xe4,\x80\x86G!\x87\xb0t\xbf_\xb1 \xb1iv\xdd\x13\xce\x19\x90\xe9\xdaTF\xae\xb2b\xd5\xef!Fb\x99\xca\xc4k\x88\xa6\xdc\xceo\x92կ\xbcc[\x11{\xbfT]ƙ\xea\x02\x00\xe0\x9fo\xd4\xf36{z6i\xc7n\xeb\xae\xc7kT\x91\xd7H\x9f\xa8\xf7\x1f\x17y\u007f\xfei??\x11\xd1[\x90\x95\xfa\x9e={\x1d\xfbH\xb1\xca\x1eF\xf9\xc6df\x94\xaa\n?\x06\x8e\xeeI4\x1e\xaa\xfb\xf7s\x9f~<0S\x90\x11\xd1\x1dw\x9b\xfd\xf6\xec\xfe\xcd\xd8}r\x16ݝ\xce\xfd\xa2ᐓ\xdf\"\x17\x16?l:\u007fS\u007f'\xf4S\xea\xc7m<\x00C\x06d\xa1R\xb3\xdd1\xd5\x03\xa1}\xaa\xf8\xb8L\x14y\xf9\xb8N\x91\x90\xf2\x92\xf7}rksR\\>G\xa9\xbbC\xa9\x14\x89==\x9b\x9c\xed\xdb\v(\xb57\xf6y\r\x1d\xf5{\xff&W\x1d3\xf5\xfde\xe1\xf9\xa7\xf1\xfcܠ\xb8\u007f\xff\xe0}ػ\xefc\xf8\xeb\xbf:\xea)CI\xeb(9\x0e\xea7\xaa\x172s\xf3&N\x9dzS9\x1ak`\xeb`\x93\x81\x14ۉ\xdbr[\x1d\xba\xbe\xe4s&\xe5]\x01\rELk\x84\x84\xe5\xdd\xf2|SN\x84&j\x15\x87\x06\a\xf1\x90U\x06,κ\x0f\x19c\x1e\xc8B\xa5\xe6kt\x8b<\x87\x8c\xbc?\xac|\x90\xf3l[\xbf\xd1\x11V\xa2}n\xa8\xfaR]\xbf\x9f\xda\x02\xb1\xa8N\x96\xf7#\x1b\x87\xf2\x8b\xfa\xfd\xc9\xc7݈\xf3\xf9{\x11\xd7\xf3s\x83ު\xc9 \xec\xd9Ә\\g`렭0\xc57\xf0\xa8\t\xf2\xfe\xc1\xfb\x8c\xbc\xa9\xf7n\xd9\xe2:\xe2k/V\xd7\x06YU\xfc\xb4>\t]\xbf\xbcM\xa1:B\xbe\u007f\xf1\x1c2I\x0e\x1aPA\x8a9\x88!9\xbb\u007f3pr\xd6\x0e\xe3\x8a\xc3x7\x95\x8a8}\xaa^\x80K\u007fO4\x8cW\xe5}\xf8\xbd\xbe$\x89uEB\xb7?\xea \xca\xca\xef1\xb7*f\x91\xa7Fz\xf0\xeay\xe7\xba\x1fO\x8d\xf44\x8d\xc1\x96\xcf\xeb\xe7\x1a\xbcr \xb4/\xea\xfd'A\x1e\x9f\u007f\x90\xe3i\x91d\b\xc6\x14A\x94\xb7\xdcV\xdev\xbb\u007fS\x064N\xc2\x18\x12q\"\xc6Rw\x87\x9d\x03Q\x8d.\f\x9a\xa3K\xc3p\x10knI[\xc0;\x06_\x99[\xc6S#=\xca9\x9a\\\xcf\xe5\xd2N)\x9bB\xb5s\x16\xc8\xca\xf3O\x92\x1b\xe5\xb2\xf2\xadY\xcc_P\"\x99\xc2/\xbd[\xb68\xb6\xa9M\x96\xf3\x1d&p\xbb\xff<Aӝ\xf8i+\x16\xfe\xb9\xb5\ts\r\x81\x85\fbĀ\xd8s\x14\xa5\x8c2^\xeeҖF\xfd\x88r'\xaf̡\xb2ba\xd7\xc6{\xb4\xe7uC\x15\x8a\xf1\nτ\xc1\xa4a2\xf5\xfde\xe1\xf9\xfb\xbeVÆ\x9d\xc24S\x93ךb\xff\xba!\xb5\xba\xbc\x80ܾ|c\xb2)\xbf\xe0&\x93\x96\x01R%\xffݶU\xf7\x1f$!\x9f\x05\xfc\x18\x11J\xa6ӈ,\x1a\xca+\xcf\xeaK\x06\xc6o\xf8*m\xe3\x01\x182 \x8f}zWj\x13)\xba\x11F\xf1Ȋ%\xa8\xc7\xe1U\xe3`\"\xfeN!\x1f\xaf\x9a\x13\xbf\xc4\xf5\xfd%\xfd\xfc\x83\xf4a\xf2\xf9\x11[\a\x06\xea^\xc8[\xdfm\x8a\xe3SH\xe6̙\xd3\xf6\x9b7)B\xf1M\\%'\x0e\xe5\xa5\xf3\x9f:\xf5&\x004%\xad\xc5\xcfI\xbf\xd9\xcb\xf9\vq\x19\x051\a\xe2v\xff\xb4Og0\xd3H\xa2{\x114\xa4E\xbf\xe9\xb0%\rY0\x1c\x84\xb1J\xf4\xc7>\xbd\vǾ~&ժ\xc80IU\x99\xfd}\x1fQރ*,#\xb7\xab\xacXؿ:\xaaBǅ\xf3\xd5H\xcfh\xa6\xe6\x9e/\b\x83\xa9\xef/\xed\xe7\xef\x878\x9e\x9f\x88\x18\xbb\x97\xe3\xf8S\x93\xd7𩃇\\\xa7 \xf1*\x00$\x83B\x94oL\xdaInQa\xd3T#i(Z\xb7\xfc\x85\x9f\xfb\xa7\xfb\xd3\x19\xe1\xac\"*v٘\xc8^\x88\xea\xef\xc3\xcd\xfbȒ\xd1\x1012\x9d;PWBO\xff\xf2\x9e\xd4\x16\x95\xfa\xc6?]U\x8f\xa9\x0e8a\x1e\xc9ɓ\x9f\xc9_\xf8\x8e\x81.Ǌ\x84W/\xcf\xe3\xc2\xf9\xaao\xc5\xe4g\xf5>\x15\v\x95\x1aN\x9d5\xf7\x8cM}\u007fi?\u007f\xbf\x98~~A OB\x15\xa2\x12\x87\xfb\xfa=\x17\xc9\x02\xd9W\xae@\xe3Z\x83\xdc\u007f\x1e\xeeK\x85\x9b1\x11\xe7\xc7\x12\xb7\xddΑU\"\x1b\x90\v\x8b\x1f\x16\xf0\xaf\xefYO\xfe\xe4\xfd\x99\bcy\xad7\x11\x14\xd7\"\xb6\xcbR\xdf\xed\x05\xcf)\xd6i\xe4\x91<\xf5yP.,~\x88\xd9\a\a\"\xff\xc0\xdeض\xd5\xe8\xf7\x97\xe6\xf3\x0f\x82\xa9\xe7\x17\x141\xa1.\xbei\xc7\r\x85\x89\xfc\x12\x87\xe7\"O\xe9\"\x13\xf49|\xf1n\xfc\x85r& Cp\x16\x9b\xadݫCz\x81f\xc3A\xdeG\x1e\f\a\x11ـ\x9c\xb8r\x01/}\xe1\xa7\v\xdf\xf9\xd7\xf7\xac\xb4f\xa7\x15\xf13\xb2'\f~ι\xd3g\":JE\xf4\xbb\xe5y\xe3ʏ\x8c\x88\x89\xef/\xcd\xe7\xef\x878\x9e\xdf\xf7~8^\xf8\x89\x87\xc7,\x9a\x0f\x8b\u07b4\xdd\xd6\xdd\bR]N\xed\xe5s\xfa\x9d\xef*\xa8\xf1 \x19\x93FDw\x0faڞ9s\x1a\xbf\xf9S\xbbbU\xb2\xfb\xfe\xe6\x83\x18\xceZ)\xa0>A\xa2%\xe7F/,~\x88]\x1b\xef)\xec\x1a\xaf\x00\xe3fz\xfb\xea\x99\t3'r!\xf2\x97`Y\r\xef\xec\xc0Ю\xd4Ƭ\xd2\x1a\x0f\x80S\xd9D]\xf3a\u007f\xdfG\x8c\x9f3*q\xbd9o>W\x0e\xfd\xfd\xe5\xe9\xf9\x8b\xcf\xefܱ\xd7\"\x9d\x8b\xf8\xf3_\xfa8\xfe\xe8\xdb\x17\x12\xff\xfd\xf7n٢|\xb3'%,&\xad\x83B\xb2^\x9e\x83\xd8W\x12\x88\xc6\xe3\v\u007f\xf7/\x89\xf4i\x1aYW\x9e\xb8r!\x15\xaf\xa3P\x88֭\xd1:\x90\xb4\x1e\x02\x00\x1c\x1a\x19\xd1\xfe\xf1\x9e\xb8r\xa1\xf0\xfbO},\xd0\xf9~\xf7\xd5\xef\xda_\xb2\xea\xedW\xbc\xd7\a\x9f\xfeD\xc0\xab\xcd&Q\fS\xe9\xf2\x94\xf6\xf9\x87=/\x194\xd5\xf37eDݾ;7\xe3Brb\x9b\xb8ߊU\xfcѷ/X37o*'(\x04\xa2^S\xb7\xe7\xf9\xcd\xf5\xd5Z\xa4\xa9+M\xb2&\v\tM\x0f\xfd\xccj\xf1ZVi\xa5\xe7\xdf0@\xe1\xf4\xc1sѦW\x8a\xac\xb4\xbd\xde\xe0\xbf` J\xc1\xac]\u058c\x01\xe9-\x16\x94\xa1\r*H\xfb\xddW\xbf\x1b\xf8\x9c\xbb6\xde\xe3zN\xa6\x81\xdb\xf3\u007f#\xe49ݞ\u007f\xd8s2\fc\x0e\xa39\x90Ve\xad\x84\xb0\x98\xe0D\xf5 \xd2&\xaf9\x04\xc6\fQs \xfc*\xcd0\fÄ\x82\r\b\xc30\f\x13\n6 \f\xc30\xd3\x15\xa7B\x00\x00\x1fxIDATL(\u06000\f\xc30\xa1\x88\x9cD\x1f=\xf8q\x00\xe6\n\xb2\x98\xd6@UG\xd1Jx\xdd\u007f\xab?\x1f&\x192\x91D\xe7\x1f9\x13\x94V\xff\xcdx\xdd\u007f\xab?\x1f&\x1f\xf00^\x86a\x98\x16%\x13\x1e\b\xc30\f\xd3z\xb0\x01a\x18\x86aB\xc1\x06\x84a\x18\x86\t\x05\x1b\x10\x86a\x18&\x14l@\x18\x86a\x98P\xf0T\xcdL\xaaD\x1d\xc5w`h\x17\x00`g\xa1\xdd>\xa5\xae\xed%k\x05\x10~\xf3'\xaeD_\xc2W\x9eH\x93\x86\xdf~vϨ\xe3:\xbezf\"\xd0\xdfZ\\\xf2\xa6'\xfe|\xae\xab\xf9\v\xdcT\xaa\xaf\xf5\xbdP\xa9)\xf7\x13\xf2\xf1<\xca\x1f}tFy\x0e\x15\xa3\xdb\xd7\x03\x00&\xae\xde\x0eu\\\xc5\x0f~\xe3l$\x1d\x9e\xa9\x05\xa5\x18&\rv\x16ڱ\xbb}3ή\xccZ\xbb\xdb7k\xdb\xed\x06\xf0rm\xca\x02P\x10\f\x8eq>\xbbg\xd4ztw\x9fcߡ\x91\x11\xeb\x95\xf3\xe7}\xfd\xb5\xa6-\x1f\x04Q\xa9.TjMJV\xd5n-ɇ\x85\x8c\x05\x11\xc4hd\t\x0ea1\xb9\xe73\x1b\xc7\x00\xc0Z\xfd\x1f\x00\xf0Pq\x9b\xf2\xf3\xaa\xe1\xb0\x00\xe0\xd9\x1d\x0f\x1b\xbf\x16Qyo*\x15m\xc5\xf3\xa9G\xb6\xba\xae\x9a\x99\x15\xf9(l*\x15\xb1P\xa9\xd9\xffZM^\xc5\xe8\xf6\xf5\x0e\xcf\xc2\xcdP\x88\xc7E\xb9,\xc3\x1e\b\x93{ީ]\xb7\x9e\xdc\xf48\xa6k\xd7|\xb5\xff\xb9b?^\xaeMY;\v\xed\xb1\x85pI\x19ɟ\xf3\"\x1f\x05?\xa1\"\xb7\xebɻ\xbc\x8e\xd1\xed\xebm\x031q\xf56\x96ƻ\xd096\x1f\xf8<Y\x82=\x10&\xd7\xfc\\\xb1\xdf\x12=\f\xbf\xec,\xb4㒵b\xfc\x8d|\xa6\x96\xee\xcc\fi\xf6\xaf\v\x01\xc9\x06L\xa7|\xf3.O\xe8\xbc\x0e2\x1a\"K\xe3]\x8e}\xf4\xd9\xcb[\xc9\n\xec\x810\x8caH\xe1\x88\xc9X\xfa|hd\xc4\xea-\xba;>qʟ;\xf6\x9a\xd1D\xba\xdf7q\xd9#\"ټ\xcb{!z\x1dd\x1cd#\xa2\x82\xdat\x8e͇J\xae'\x05\x1b\x10fM\xa1\xcb}\xd0\xe7\xb3+\xb3\x89]\x8bJ\xc1\xf4\x16\v\xd81\xa0W \xef\x96\x1b!\x8d0\xf23W\xe6\\\xe5\x01\xf7\x89\x1a\x83\x1a\x970\xe11\xb1}\xde\xe5U\x88\x8a^\xf4:(\\\xb54ޅZy\x19\x00P\x1c\xe8\xb0\xf7\x89m\xe4\xcfY\x85\r\b\xb3f\xe8+އ?\x9b\xf9\a\xd76n\xa3\xb4L\xa1\n\x85\x9c>5\r\x00\xe8\xee,\xa2\xa7\xa7C/\\\x9e\x8f&\u007fŽ\u007f/\xc2x(\xa2\x12օ\x81d\x8f\x88\xe4ւ<\xe0\x1c\x82+z\x1d*j\xe5e\x1cnk\x03\x00\x1c]5$@ݘ\x90!\xa9\x95\x97ѹ:&$\x8b\x9e\a\xc1\x06\x84Y3\xfc\xd9\xcc?P\xad\x87\x9e\x95Y|f\xe3X\xac\x9eH\xb5\xba\x8cju\x19\x95\xb9\xbar\x98[\xaa+\x9cʊ\x85K\xd5e\x00s.\xd2\xf1\xca\xfb!j\x98KV¢r>rs\x11\x00\xf0\u0096\x8dM\xdb\xd5jC\x99\xd2\xfe#\xa3\xbd\x8dv\xab\xfb\x82ȿ\xb0ecc[!\x1f\xe4\xfa\xfd\"\x87\xac\x00\xacz\x1c\xb4\xbd\x8c\x9e\x9e\x0el*\x15q\xf8r\xc3ː\x8d\tPs$ڳ\x18\xcab\x03\xc2\xe4\x1e\xf2<.Y+(y\f\xac\xba\xb4\xb2\x82o,\x8ecw\xfbf\\\xaaM%t\x85\xf9#\xac\x11\xd1\xe5\a\xaa\xd5e\x1c\xbds\xc7\x0e\xd9\x1c)וy\xcf\xc1z\xbb#ǅ\xc67\xef\xd8\xfb_8^/\xd4+\x0et8B@~\xe5i\xbfN^\x84\x8c\x8dWXKu\\T\xea\xa2\xd2o\x84\xa1\x1aa+\xf2>\x00`\xfbpݨ,Tj8\\m\x9c\xef\xa8\xc3\xe0d\x176 L\xee\xf1k<\x00\xa0\xd4^\xc0\xa5\x95\x15 \xc1\\H^\xf1cD\xe4|\x82\x8e\xa3wH\xa9\xd7ߪ{\x0e\xd6V\xdf\xd0i\xbb\xa1\xdc\xeb\xd4\xcfK\x06G\xce\a\x90\x81 \x99\xb0\xf2\xa2\x97@F\xe5\x05\x1f\xf7jo\x0f9=\x03\xafD\xf9\xe1\xb66;\x04)\x9f\x8f\x8c\t\x00\x1c\xbe<\x8f\xa3\xe5e;\xac\xd596\x9f)σ`\x03\xc2䚗W\xbd\x88R{\x01g\xaa\v\xbed\xf6\xf4l\xaa\x1b\x91\x98\xb9\xb4\x1aR\x91GM]\x12B-2^#\xac\xbc\xe4\xfd\xf4\x1f\x84 \x9e\x88n\xea\x0fq\xbf\\\xfb@\xdb\xf4?}V%\x9e\xc5v\xa6\xe4\xc56\xf4\xc6\u007f\xa4\xbc\x88\x17\xb6l\xd4փ\xf8\x19\x85%^G\xdd\xf3X\xb6\x8d\x87N^\xdc\xee\xe9\xe9\xc0\xe1*l#\"\xdeC\x96BYl@\x985\xc1Ξ\x0e\xdf\x06dgO\aNN\xbf\x1f\xeb\xf5\x9c\xbc2\x87\xfdC\xdd\x00\x80W\xcfW\xb1kc\xbd\xe4\xcak:\x91C##\xd6\x0e\x97\xe3~\xe4\xdd\xfa\x8f\x03U\xbe@\xdcw\xe4\xe6\xe2꛴3\xf9\xaf\x1bq\xa4\x1a}\xd4\x1c\x12\xd2\xef\x0f#\xefgh\xad.\xb4%*r\xf9|b\xc2|\xfbp\x97R^\xf7\xfc(Gr\xb4\xbc\x8cZ\xb9\x88\xe2\x80\xe7%&\x0e\x1b\x10&\xd7\xcc>8Px\xe0\xf2\x945<ԍm\xabFA\x17ʢ$\xf2\xf0P7NN\xbf\x8f\xd9\a\a\x12\x9fLt\xf8\x99'\\3ٻc\x96\x0f\x83\x9b\x17\"\xbf\xa5딬\xee\xed\x1fp\xd6J\xe8ޮ\xdd\u07ba\xa3ʋ\xde\xca\xd2x\x17\x8a\x03\x1d8R^\xc4\x11MHNT\xf2\xba\xf3ҹ\x00ghJ\x96\x97\x91\x9f\x9f\x987\xa2g\x97\x05σ`\x03¬\t\xaa\xd5e\xfc\xd2c\xfd\xf6\x1fe\xff\xa8s2\xc1\xa9\x89\xfa0օJ\r\x97\xaf\xb8\x8fb\n\xcbj}EA5\xe7ԅ\xc5\x0f1u\xa0\xd7\xf3\x1cg\xf7o\x06N\xce\xda\xdeCX\xf9\xb0\xfd\x9bB5\x1c֭\xaeA5\xf45\x881\x88\"\xaf\xf2Jje\xf5P^/ܦ'\t2\x9aK~~Y\x1d\x89\xc5\x06\x84\xc9=\x17\x87\xfb\v\xb8<e\xedGC\xe9.\xbc\xfe\x9e\xb2\xed\xe5+s\xb1x\x1fbq\xde+\xe7\xcf7\x19\x91\xa9\x03\xbd\xb8\xfc\xd2\xeb\xbe\xfb<0\xb4\xcb!\u007f\xe2ʅ@\xd7\x1b\xb5\xff\xb0\xe8\xa6\xfc\x10CD\xa22\x94\x15\xa1N1\xfaU\x98A\xe5U\x95K\xf6C>\xee\xe6\xd1\xd4\xca\xcb82\xda\xeb*\xef6\xef\x96[\xee(+D6 \xa3\a?\x0e\x00X\xd9l~^!f\xedAC\x197\x167\x19Uf\x17\x87\xfb\v\x95se_\xbf\xc1$BW\xbdł]U\xbek\xe3=8\x11Py\a5\x18\xa6\xfb\xf7\x8b[L\x9f\xea4(\x04\x038\xdf\xf6\xc59\xa2T\xf8\xdd\x1fE^\xce_4\x12\xf0\xcb\xf6=\x10:e/z\x05~s*n\x86\x89\x8e\xbd01\xd3t]\x13Ȗ\x111\u206cl^\xb1ⰎQ\xdd5\x96Ϟ|\xe7X\xfd\x8fk\xb1\xbc`\x996\"\xa2a\xd8|\xaelQ.\xa4\xb2b\xc5j4\xe4\xa9A\x92\\\x8fCE\xd6\xd6\x03\x91\x95\xaa8Myڡ\x18ݵP\x1eD\xac\r1\x81[5\xbb\xf8\xfc^\x98\x98qԮ\x00\xd9\fcE6 \x8b\xb5\x05\xabg\xac\xe6k\xeez*\xf3\xa7τ<\r\x80|ܫ\r\xcb\xe7K\x1e\xa8\xff\x81.\x96\x17,\x18^\x15se\xf3\x8a\xb5\xfb\xe4,*\xa8\x8f\xb6\xa2\xa1\xac\x9bϕ\xad\xa9\x03\xbdh\x9f\x8do\nw\xa0y=\x0e\xa0\xae\x18h=\x8e\xb8\x8dH\x9a\xfd\xfb\x9dWJ\xfc\x9dd\x05yzu?\xa3\xb2DYB\x96#/B5,XF\xf5\xfc\xc4\xe1\xc9Y\xc4X\x0e\xc4ϏB<n\xa2\xad\xdb9X>\xbb\xf2\xe2\b\x15\x93\x90\xf1 \xc31<\xd4\r`\xaeQ7qb\x06S\az-\x93FD71a\x9a\xebq\xc4ѿx\x9f^u!\x0eEysQ9Q\xa0\x9f\xb7g\xaf9\xa5L\xa0\x1b\x82[+/\xdbJ\xdfk=\x10\xd5KSm\xb5~\x83\xcey\xe4xs\x81\xa2Π\x1c\xb9\xb9\xe8(\xbat\xbb\xe6\xb4128\xdc\xd4\xeaY~\xce\xe1Wi\xb1|\xf6\xe4\xe3\xfc\xe1{\xe5\xe0v\n\x13\x10ƙ\xafk\x85\xf5@tFS\x15\xc2za\xcbF;\xefE\xe8\xf4E\x90\x17L\x93\x88ףR\xd8A\xd6\x03!\x83!V\xca\x03\xab!1a..\xaf\t\x1bE\xc4\xd0_\xd6V*\xe4\x05\xa5\x985\xc9Ξ\x0e{\xb8\xeeN\xb7\xd9kc@\\\x8fC^\x9bc\xad\xf4OF\x84\xd6\xd4\xf0;\xc4\xd5\v9'\x91\x86\xd2\xec\x1c\x9bG\xcf\xc1Z\x93§\xfb\x14\x97\n\xa6\xebTy2@\xf3\x82Q\xaaD\xbc\xee\xf9\xc9\xe1\xab,y\x1e\x84\x91\x10\x96\x1c\vg\x18\x15\x14\x92\x88#|\xd5>\xdb^Xټb5f\xacuok\xfc\x02\x14$\x1d\xb6J\xa3\u007f\xaf\xf0\x98*\x1c\xe3\xa5\b\xe9w\x92T\xb2]5\x12\xcb-砻_\xf9z\xc5s\xe9r#GF{\xed\xcf\x00\x00\xa1j_\xbc\x06z&Y3\"\xc6<\x10\xbfƃ\x1e\x82\xeaA\xf8y8^\x8bҳ|z\xf2i\xd3>\xdb^\x98:Ћʊ\xa5\xfc\x97D\x12\x1d\x80\xe3-\x95\xfe\xf9]\x8f#O\xfd\x93\x17\x12f\xeas\x95W\x11\xb5\x06ĭ/\xbfס\x9b\fQ\xe5y\xe8\xbc:\x957\xd296\x8f\xe2@\a^\x98\x98\xb1\x8dj\xcf\xc1\x9a=ڊ\xb6)\xf4\xa5J\xe6g\xf1\x05=\x91BB\xd1C\x11\x13cn\x9e\x8b<\xa2G׆\xe5ӕ\x97'xS!+\x80\xb8\x92\xe8T\t\xee\xd5\xce䒮*\xa2\xaeǑ\xf7\xfe\x1d\xc3Q\xb7lđ\xe3pԂ\xf8\xfd\xad\x98|Y\xf1\x93\x90\u05cd\xc2\xf2k\x14\xddF\x1e\xd2\xf9\xc4Y\x88U\xfd\xfb=wVHt\x14\x96\x8c\xcaU\x8c\xfa\x16\xc2\xf2\xe9\xc8\xfb\xfd\xfe\xe30\x1c\x84h\x18\xe4Do\\F\xe3\xc1\xa7?\xe1\xbaDl\xab!\xd79\xd0\"OAG`\x85%h\xd8KnC:\x89Fa\xe9\n\xfd\x82\x86\a\xe5z\x8e\x86A\x99\xc7\xd2xG\x93\xf1j\xa95у\f\xc9U}abu\xa5jȟ\xbc\x9f\xcea\xc7TY>5y/\xef\x03h\xaeԍӈ\xe8\x949\xed\x8f\xdb\xfbh5\xfc\xae\a\"\x12\xa7B\x14_dT\xd1\x0e\xd7끳\x12\xbdZ]v,\x1f\xac[\x0f\xc4\xedZ\x9a\xfaЄ\xb7\xa8\xdf,\xd7|\xa8Ht.,Yq\xc9\xc8\x1e\x89.\xf4\xa1{\xc0,\x9f\xae|\xda\xf8\xf1\x04\xa2.תB煘X\x8f#\nI\xf7\xafZ\x0fD\x9e\xca=\x89\xdfN\xd4\x04|]\x89\x03G\x8f\x03GJ͡,?끸1\xba}=\xb0\xfd.&\xae6\x8c\x9c\xfc\\D\x83\x93Eσ\x88}\x14\x96\xea\xe6ŷP\xdd\x1b\xa9.\x1e)\xcb\xca\xc7Y>Yyу\f\xf3\xfd\x9b\"H\x18)\x0e#\"\x93\xe4z\x1ci\xf7\xef\xb5\x1e\x88\xfc;JB!\x06\xe9\xc3o\xdb(\x05\x99^\x1eP\x96\x8d\x84\x1b\xc6<\x10?\x05f*\x0fDV$n\xc7\xfc\xc0\xf2\xe9\xc8\a\xf9\xfe\xd7\"\xad\x98\vy\xf0\xe9O\u0dc7\xfc\xad\a\x028\xbd\x8f\xac\xfc&T\xd7\xd1\xf8ݫ\x87\x83\xbb\xad\xe7\xe1\a\xdd=\xe7\xc5\xeb\x10\x89=\x84%W\x97F\x89\x83\xbb\x19\x1b\x96Ϧ\xbc\xea\xfb\x8f\x83 \n<N\xefC5\x95;\x90\xdcz\x1ci\xf7O\xd0H,q\x14VVC\x9f\x84\xf8{\xa6$\xbaH\x1cu5y1\x14:\x8c\xf9\xb5^\xf5\x05\xf4O\x1ca\xc0\xac\x1d\xb2\xf0\xfd\xfb1\fq\x1a\x0f:\xb7j\xc2¤\xd6\xe3H\xaa\u007f\xddst\x9b\xf2C\xfc\u07b3\xf2\x96-^G\xd0\xe2A\xd3\x06%+\xcf$\b\x89/(%\xc7C\x97.\xdeB\xe7\x03\x1b\xec\xcfv\xbb\xd5}\x84\xea\x18\xedc\xf9\xf4\xe4\xe5v^\x84\x99\xedԍ\xa0a\xa38\xc3L\u2e63\xae\xc7\xf1\xd9=\xa3\x0e/\xe2\xabg&\x02\xc9gu=\x90\xbc\xe1\xb5\x1e\x88nԢ_\x0e\x9fj\xf6\nuIz\xb7ŧ\xd2\"\x91\x1c\b\x1do\x1a\xc2{\xf1\x16\x06o\x150\xb9\xaa\x9c\x06o5~㓂\xc2\xd2\x1d\xa3},\x9f\x9e|uu_\x98\xef\u007f\xad\x12u=\x8e\xb4\xe5\xbd\x10\xbd\x0f^\x0f$\x1a~\x9e_\x9c\xfdG%\x11\x0f\xc4K\xb9\x90r\x9a\xb9y\x13\xbd[\xb6``\xeb pc\xd2\xd1f`\xeb`cC86\xb0u\x10\x03\x00\xca7&\x1d\xf2\x03\xab\xc7˫mEy:\x86\r\xf5\xe3\xdc\u007f\xf8\xfe\xfd\x90\xc5)\x18LC\xdeG\xd4\xf58Җw\xc3\xcf4\ueb78\x1e\x88)Ҙ\xf6?*\x89T\xa2\xabG88\x19\xd8:\xe8Tr\xab\x9f\xcb7&\x9d\xca\vh\xdaV\xc9{\xb5W\x1d\xe3\xfe\x83\xf5_\x16\fI\xd4\xef\u007f\xad\x11u=\x8e\xb4\xe5U\xf8\x1d\xfelj=\x90$P\x15\xf6-\x8d\a[\x0f\xc44~BUY14\x89V\xa2'Q\x89,3s\xf3\xa6\xfd\xd9M\x91r\xff\xe1\xc8Z%:\x93\x1e\xba\xf5@\x8e\x94\x17\x95saeň\xa8\xe6\xac\x12\x91\xa7ďKy\xebBXI\xf5\x1f\x86ē\xe82\x146\x89\x83\xf2\x8dI\xecٳױ\x9d\xa4\x12o\xf5\xfe[\x15\xd5\x1f<}>42byU\x86\xc7)o\x9a \xca,˳\x18\x88P%\xfa\x91\xe3\xc0\x91\xd5\xe7&{t\x80\x19E\xee\xf7\x1cq\xf5\x1f\x95D\xd6\x03\xf13\x1b\xab\xac\xdc\xc4\xf0H\x18\xc5\x17UYr\xff\xfe\xfa\xf7\xaaD\a\x92\x9b\x8d7k\xa8\xfe\xc0{\x8b\x05\xec\x18\xd0\xdf;\x8d\x9c\x8aK\xde4q\xac\a\x12\x95\xa0\x036\xe40\x96\x97\xa13\xf9\\Ä\x17\xb3`8\x88D+\xd1\x01\xf5\x8f\x87\xc2,b\xb8E\xd7&\b*\x990\xe7\xe1\xfe\xf5r\xf7\xae\xce&\x17\xe5\xfb_k\xa8\xde\xf8i=\x8e\xee\u03a2c\x82\xbe&\xca\xf3\xb1\xcaǁ\xa8\x04\xfdz;\xa6\xc3X\xaaE\xa8\xfcʉ\xd7a\x97\x17\xacN\xa8H\xa8BH\x9bJE`\xe1N\xd4K\xf7\xf5\xfc\xb4\xfd\xa7L\xe2\xeb\x81\xc8\xfc\xe7_;\f\x008\xfd\xd6w\x01\x00\xfd\x83\xf7\xd9Ǧ&\xaf\xa1\u007f\xf0>l\x1d\x18h\x92\xbbQ.cj\xf2\x1a\xf6\xee\xfb\x18˧(\u007f\x16sM\xc7d\xbc<Ե\x80X\t\xef\xb6\x1eG}\x82C\xf7g\x16\xa7|\x12DY\x0f$\f~\x17\xa6\xf2B7\n+\xe97~وd\xc9\xe3\x90It=\x10]\x92\n\x80VQ\xa9\xf6\xa9\xf6\xb3|:\xf2g?\xac+\xb3\xa8\xdf?\xb36H{=\x90\xa0\xe7Nj=\x10\xbf\xf8\xc9odi\xb8o꣰n\x94\xcb\x00\x80\xbd\xfb\x1eU\xca\xf6\x0f\xf6k\xcf\xdb?؏\xa9\xc9)\x96OQ\xfe\xf5\xe5\x13<\nk\x95\a\x9f\xfe\x04\x1eO\xfb\"bD5\x847+끨\xbc\\\xbf珴\x1eHD\r\x1a\xe4\xf9)\xfbO\x99\xd4Ga\x01\xfa\xb7\\\xbf\xb8)9\x96\x8f_\x9e\t\x06\xadӡ\xc2\xcf\xda\x1dQ\xe5\x83\x10d\uec34\xd6\x03\x89b<TĽ\x1e\x88\x0e\xbfS\x97\xb4\xd4(\xac\xa4փ`\xd2!+\xeb\x81d\x85\x99\x9a\x85\x1d.ǽ*\xc1\x0f\x8d\x8cXq\xca\xc7AV\xd6\x03\t{\xde$\xd6\x03\xf1:/\xe1\x96 \xcfR\xe8\x8aHe=\x10f\xed\xc1\u07ff?\x86\x9fy\xc25\x93\xbd;fy\xd3Ȋ\xae\xe5\xd6\x03\x898\n+\xc8\xf3S\xf6\x9f2\xb1/\x93&\xaf\aᗩ\xc9);?\xe2\xd6&)\xf9\xa9\xc9)\xfb\xdf\xe9\xb7N%\xde\u007f\xde䉰\xdf\u007f^y\xe5\xfc\xf9\xc2\xc9+ͣ\xa4\xfc\xae\xc7qv\xfff\xa4)o\nRr/lو\xea\xf1b.<\xce4\xd6\x03ё\xe5\xe9KDR\x9f\vkj\xf2\x9aR\x86\x94\xd7\xd4\xe4\x942F\xef\xa5\xe0L\xca\x03\xcdy\x022\"I\xf4\x9f\ay\x9e\v\xab\xc1+\xe7\xcf\x17\x0e\f\xedrx\nA\xd7\xe3H[>,^끐\x17\x92\xb6\xe7A\xa8\x8a\bUh\x15\xba\xe1,\xb2\xfc\xfc\xdc\xf2\x1dY0(\x91\u007fP\x83\x9f\xdck\xf5\x1c\xac\xb9\xbe]\x8a9\x12\xb1`g\xe9\xe2-\x1c\x1aq\x8e[\xd9:0\xe0PX\xa2\xa2rK\xb6\x8bm\x82\xc8S[2\bb\x9b\x1b\xe52\xb6\x0e\f\xe0F\xb9\xec\x18\xa5\xa4:g\xd8\xfe\xa3^\u007f\xda\xf2\u007f{\xfd[\x9e\xf3a\xe9\xbe\xffZy\x19\u05ff\xfdv\"\x8b,Ņ.\xc9\xfc\xf8\xf5\x1b\xf8ꙉ\x84\xaf\xc6<^I\xf4纬\xa6\x90\x9a\xa8\xf4h\x18\xef\xd1;w\xecZ\x102\"r\xf1\x9flT\xfc\xee\x8f\"/\xff&\x81\xba\xf7q\xb8\xad\xad\xa9`S\x95\xd4\xfe\xf2\xba;\x8e{\n\xca\x0f~\xe3l\xaa\xbf\xffB!Z\xf7\xa9ׁ\xc8\n[\xa6\u007f\xb0\xdfV\xe4^\xa3\x85\xdcި\xbd\xe4u\xc7\xfb\a\xfb\x1d\xc3]\xe5}\xa2L\x9aןZ\xff\xd7\xeb\xffq\x1d\xc8\xda\xc4k\x06^\xaf\xf5,zz:\xec\x91Xb2\xbdsl^\xe9\x85\x045\x06Q\xe5U\xb3\xf1\x02]8Z^\xc6\vh\x18\x90,\xe4\x1b\xb2H\xeau 2*%\xe5G\xf9\x89\x88\xca\xdeKy\xeaBT~\xae\xcb\xef\xbe8\xaf?\xed\xfey6^\x86\b2JH\x9eoJe\x18t\xc6\xc2o[\xbf\xf2\xe2\xef1\x0f\x93=f\x89Lԁ\x00\rE\x15UޯR\x0fr<H\xffa\xa00Q\xd8\xeb\x8fڿ(\x1f\xb6\u007f\x86!\xe4\xb7\xf5\xc3\xd5e\x1c-/\x03p\xbe8\xd8\xc3z\xa16$\xe2:\"\xa2\x87+\xee\x17\x87\x91\x87\x95\x17?W\x8f\x17\x01\xd4CXY\xcf?d\x81\xccԁ蔗.\x89+\x13\xf4-\xdb4a\xfbw\xab\x04\xf7{\xefn\xfd\xc7\xfd\xfc\xb8\x0eD\xcd[\xbfzoڗ`\x8cu\x9f\xfc\x11\xee\xfe\xf3\x8f5\xed?w\xec5\xbc\U001057f6\xb7u\x13\x01n\x1f\xee\xc2\xe1\xcb\xf38Z^n\ne\x89\xe8~\x13b\xc8KlcJ\x9e\xae\x87r\x1ft\xcdD\x96\xd7\xe3H\x1bc\xc3x\xbd\xea\x00\u070e{\r\x17\xf5\xc2\xcfp\xd28\x89\xda\u007fT\xc3\x17\xb5\u007f\x13\xcf/\xca\xf7\xbf\x16\xf9\xc1o\x9cM\xfb\x12\x8c\xe1v/\v\x95\x9a\xfdύ\xed\xc3]8\xdcֆZyy\xf5-_M\xadܘ;\xcb-\x9c\xa4;\x1eF\xbe1}I=g#\x1a\x0f\x82\x8c\b\x19\x12Ή\xd4\xc9D\x1d\x88n(/@\xb3\xbe\xba+8\xaf\xe1\xa8a\x14d\x90:\b\xb7v^\xc3}\xfd\xf6\xefV\x87\x12\xf5\xfe\xe3x~D\xabՁ\xb4\x1aA\x14iOO\a\x0e\xb7\xb5\xe1p[\x1b\xaaǋ\xb6\xb2'\x05N\x1e\x00\xed\a\xea\x06\xa1V^v\xb4\x11\x8f\x9b\x90\xa7}\xe4}x\xe1\xc7`\xb6\n\xa9ׁ\xdc(\x97\xd1?x\x9f\xad\xc4\xe4!\xa4\xaa\xcf$G1\u007f\x1aj\x1bF^\xa7<\x83\xd4A\xc4ݿ|^\xc0i\x98\xa2\xf4oB\x1e\xe0:\x90V&\xc8z\x16\xd4\xf6p\xb5\xbe\xff\xa8\xa0\xccI\x81\x1fnk\xc3\xd1\xe3\xce}\xd4\xce\xde>NR\xd1\xe5i\x9f\xdb:+q\xae\a\x92g\x12O\xa2\xcb\xf1O\xb1\x8eBV\xa6^#\x80\xc4\xfa\x8c\xb0\xf2\xba:\x0e\x92\x11\xdf\xf8\xe5$\xb5x\xee\xa8\xd7/\xf7!*\xf5\xba\x91U\x9f\xc3T\xffa僢\x8b\u007f\xe7\x15Z\x03d\xad\x13\xe4>\xfd\xacg\xd1\xd3ӁM\xa5\"\x0e_\x9ew\xec#\xc8\xc0\xd0>ݶ|\xce0\xf2ۇ\xbb\xb4\x05|\xba\xebg\xea$2\x17\x16\x1dw\x1b\xc3\x1dt\x04\x90\xbc?\xac\xbcW\x1d\x87\xd7\xf9\xa3\xf6\xefGޭ\x0e%\x89\xfe]\xe5\xaf\xff\x00@\xf8\xef_\\\x88\x89\xc9?A׳\xa0|\x83\xd7\xfcNdpT}\x99\x90\x0f{\xfd\xadN\"\x1e\x88\x9f\x04\xban=\n7D%\x1a&\x11\xedU\x1bB\xd7g\xa2\x8eD\xd7\u007f\xd4:\x94\xa4\xfa\xd7^\xd3u\xefv\x9c\xfbX\xbb\x98\\\xcf\"\x97\xf2\x99)\x84H\x87\xd4s @\xfa\xeb\x81\x00\xe9֑\xe4]\x1e\xe0\x1c\b\x13}=\x8b\xbcʷr\xf1a\xe6*\xd1\xd3\"\xed:\x92\xbc\u0095\xe8L\xd4\xf5,\xf2,\xdf\xca\xc6\x03H`\x18o\x1eH\xbb\x8e\x84Y{|\xf4\x8f\x93^\x99#>\xdc\xee\xc5O^Aƭ\x9e\"o\xf2\xad\x8e\x11\x032q\xf5\xb6k\x82\\>\xee\xc7j'\xb9\x9eE\xdau$y\x96_\x1a\xef\x8a\xe5\xfbg\xf2M\xd4\xe9?\xf2.\xdf*$\xba\"!\xd0<\xb5E\x16\xd6\x03I\xbb\x8ed-ȇ\xfd\xfe\x01\x1e\x89\xb5\x96\b\xba\x9e\x85.ϐ\x17y\f5\xedn)\x8c\xad\aB\xf8\x99\xd2\x1b\xc8\u007f2\xf5W\xb6}ʱ\x1du=\x8e\xbf\xbd\xfe\xad\x18\xae2~do\xc2\xef|XT\x15\xbc\xb1\xb8)\xd2o\xd0\xcd\xf0|\xf1\xb11\v\x00\xbe\xfc\xe6\xb8k\x1f\xd4.(tި\xf2Y\xe7\xa3\u007f\xbc;\xd0\xd4,\a\x86v\xe1ĕ\v\xa1\xfazv\xc7\xc3\xf8ڻ?\f%\xcb\x04'\x13\xeb\x81\x04\x19\xa6i'T\xe1\x1dƈ\xbanr\x9c\xf2\u007f;\xfe-\xfcʶOE\xae#\x99\x9a\x9c\xb2\x17e\nҿ\x1f\x92\x96\xf7\xf2B\x92\\\x85\ue2cf\x8dY\xc3CݨV\x97\xf1\xd9=\xa3\xd6W\xcfL(\xffR\xa8]\x18\x0e\x8d\x8cX;{:\x10E\xfe\x95\xf3\xe7saD\x82\x10\xd6x\x00`\xe3\x913R\x1b\xc5,*\x14y\x850\xfa,\xb7\u05f5IZ\x1e\xa8\xbfy\xff\xc57\xff\x0f~\xefW\x9ek\xba\xb7 u$\xb2\xf1\xc8\xc3\xfd\xeb\xe4u\xc4]\a\xe2\xc7\x03\xf8\xec\x9eQ\xab\xbb\xd3\x19\xae \x0f j2\x94\x93\xa9L\xab\x12\xfbt\xeen\xf8\x8d\x9b\xebڥ%\xafz\x9b\x8e\xba\x9e\x86\xaa\x0f\xaf\xeb\xcc\xf2\xf3\x13\x89\xb3\x0e\xc4̓\xb8|e\x0e\x00\xb0c\xa01Ӫȡ\x91\x11\xab\xb7Xp,\xbdꗞ\x9e\x0eTV,\xcc-\xd5\"\xc9g\x9d\xb54\x9a\x8c1ObS\x99\x98:\x87_\xa5\x97\xb4|Ru$Y\xbd\u007f?2qՁ\xe8<\x80\xbd\xa5>_\xf2\xd5\xea2*s\xc1\f\x80,\x0f\xb8O\xc6'\xb7\x0fjpҀ\x8d\a\xe3E\x8b\x17⛁\xebH\xd2C\xf4\x00\xfc\x1a\x81Rw\x87\xed\x01\xf4\x16\v\xf6\xbe(\x90\xf1\xa8V\x97\xd1\xd3\xd3a\xffO\xfb\xc46t<\xab\xb0\xe1`\xfc\x92x\x12}-\u2d9c\xacjxp\xab!\x87\xfbL{!\xa4\x8c\x83\x18\x01Y\x81\x93\xf1)uw\xa02\xb7l\xff\xafڧ\xeb\x87Ω2\"\xb2\x81\xc9\"l8\x98\xa0\x18\xadD\xf73\xcaƫ\xe0,o\xf2S\x93Sv=\x88\xec\x89\xc8u$\xf2\xa2Pa<\x97\xacݿ\x9fc\xb4\"a\x9c/\x1a\x95\xb9\x86\a\"\xfe\xafڧB4\n\xba\xcfb_*dc!\xee\x0f\x1a\xe6J\x92\x8f\xfe\xf1n6\x1eL(\x8cU\xa2\xbb\xed\xd3)\x16\xb7\n\xe6,ˋ\xdb\xe2\xda!dD蟘X\x97\xff\xa9\x8a\x10u}f\xed\xfe\xc3\xc8\xc7=\x847\xac\x01\x98\xa9Yڶ\xaa\xf3\x94\xba;\x1c\xfbI\x9e\xf20\xa2\x81\xd0}\xceҨ-6\x1cL\x14\x8c\xe7@\xfc(\x94\xb5(\x1fu=\r:\u007f^\xef\xdfO\xbb\x89\xab\xb7\x8d'\xd0gj\x16v\xac~\xf6k\x00T\x04\xf5\f\xe4\x10\xd8\xd5\xcb\xf3ʵ\xb4U\\\x15\x16Q\nZ\xa4g\x126\x1eLT\x8cN\xe7\x0e4\x86iʅq\xe2~\xb9\xadXT\x98\a\xf9\xc6\xf6-\xe3\xebi\xe4\xe1\xfe\xfdʫ\x8cI\x1c\xa3\xb0(/\x11d\x14Ի\xe5\xc65\x87\x19\x81%˗\xba;\x1c\x86!\x88<)\xf2\xa4\fI܆#\xca\xd44<\xadM\xbe0\xea\x81,\x8dw9\x14\x8f.y\xaa\x9bL//\xf22\xa6\x12\xe4y\xb9\u007f\xbf\xf2\u2d9b\\\x14^9\u007f\xbep`h\x97\x85\xe9\xf7\x03˞\xb8r\xa1\x00\x00iʋ\xca\\\xfclژ\xb0\xb7\xc1ā\x11\x03\xa2R\xaa\xb2\xf2 D%\xec\xb6>v\x1e\xe4M\xb0t\xf1\x16\x80|\u07bf\x97\xbc\xee\x98iH\x91\x8b\x1c\x18\xdae\xed\xdaXO\xf1]X\xfcP\xd9&+\xf2*L\x18\x934\x8dF\x18O\xe2\xc1\xa7?\x11\xd3\xd50qa<\a\"*\x8c0\xca#\xef\xf2QI\xfb\xfaM\xca\a9\x16\x85\xe1g\x9eh*\xe9\xeeG\xbd\x02\x9dBE\xaa6\x97_z\xbd\x90\x05y/\x82\x86\xb8\xb2\xe2m\x041\"l<\xf2I\xa2\x85\x84\xb2\x02\t\xaaP\xb2&\xef\x06\x8d\xc2r\vo\x05\x1dƛ\xb5\xfb\xcf\xfb\x8c\xcaY`\xdf\xdf|\xe0\xbb\xedg\xfff\x14\x00\xf0\xd53\x13\xea\xe3{\xea\xc7\xf7E\xbf,\xd7~\x82 \x1a\x06٘\xb0\xd1\xc8?\x91g\x02\xdd\xfa\xb1\x8fZ\x9d\x0fl0q-\xb9c\xe9\xe2-\x1c\x1ay\\YD\xa8\x9bޝ \x03\xf3\xca\xf97Ъ\xcfO\x9e\xce\xddd\xf2t\xf8\x99'\xac\xfe\x133\x00\x80\xa9\x03\xbd\xbe\xdf\xf6\xb3\"\x9fgd\xc3\xe0\xf5\xbd\x06mϘ#\xeat\xeeF\rH=\x9e_GV\x8a\xaac\xb4/o\xf2Dw\xa9\xd4\xf4\xfc~q\xecIK^\x0f\xe4\u007f\x8f\u007fG\xfb\x9c\xe7*\x15\x8b\xfa\xcb\xcb\xfd\x9b\x92\xaf\x95\x97q\xfd\xdbo\xb7\x8cb\x15!\xa5\xb9\x16\x95\xa5\x9b\xd7aR\x86\x89N\xea\xeb\x81t\x97J\x85\xb9\x8bu%8x\xabq1\x93\x92\xc2U\x1d\xa3}\xf2v\xe6\xe57X(\xbe\xfb#\xa0T\x82_\x9e\u007f\xfa?Y*c\xd2]*\x15\xe6*\x15k\xe9\xe2\xad\xfcܿ!\xf9V\xf5\xbc\x80\xba\x92\\\xeb!\x9c \x86\xa0\x15\x9e\xc7Z\xc4H\x0e\x84\x94\xc3\xcc͛\xe8ݲ\x05\x03[\a\x81\x1b\x93\x8e6\x03[\a\x1b\x1bұ(\xf2\x03[\a1\x00\xa0|c\xd2!OA\xa5\xf2j[Q\xde\x0e8m\xa8\x1f\x0f\xd2?\xb5\x9f\x11\x8e\xc9\xc6A\xccm\xa8\xf6\xfd\xe2ؓ\x96x\xac\xf8\xee\x8fлeK.\x9f\x1fm\a\x91\xc7j[\xb4\xb0\x01\x01\xf8M\x9b\xc9?\x91\xc3\a\xa3\a?\x8e\xf5\xefU-\x87\x82\x10(ߘ\x84\xee\x98\x1f\xb2(O\xca\xf2#?1R c\x00\xc05T%\"\x1b\x90\xf7\xbfw\xdeڳg\xaf\xef\xfe\x83\x90Eyz~\xf7~j\xa8\xa5r\x03\xad\x02\x87\xb0\xf2C\xea!,/fn\u07b4?GQDY\xedߏ\xd1\xf8ű'-\xb1\x9d_C\x93\x05\xa2>\xbf\xb4\xbf\u007f&]\xfc\f\xe5\xe5\xd0U~\x89Հ\x94oLB|\xb3\x8e\xfa6\x9b\x85\xfe\xcbRh\xc8\x0fy2\x18\"Q\x9f\x9f\x97|\xfbl{.\x9f\v\xe3NP\x0f\x82=\x8e\xfcbĀܾ\xbf\xa7p\xe6{\xa7-\x93\xcaZT\xd4a\xcee\xba\xff\xa41q\xffQ\xe5\xe3\b}\x89\xe1\xbf\xd0'g\x18&\x13\x18Ɂ\x10\xef\u007f\xef|\xf6\x17y6\x84i\x05تώ\xdf>\x19&=2\x95\x03I\xea\xadR\xa7t҈\xa5\xea\x86\x1f\x06\xad\xba\xe57r\x86a\xf2F\xae\xd6D\xf7z[\xa5\xe3i&\xe5tט\x85kc\x18\x861I.\fHؤ\\\x92\xca\xda\xef5\xb2!a\x18f\xad`tM\xf48\x88\x12#O*\xbe\x1e\xa6\x1f\x8e\xfd3\f\x93w2o@\xd62lD\x18\x86\xc93\x99\baũHU\xe7\xe6\xf0\x11\xc30LtR5 i\xbd\x81\xbb\xf5\xcbƅa\x18\xc6\x1f\xa9\x85\xb0\xb2\x1a\xbe\xc9\xeau1\f\xc3d\r\xa3\x85\x84\"+\x9bW\x1c\x85q\x97_z\xbd\x10\xe4\xed>\xef\xf2i\x87\u0382\xf6\x9f\x96\xe1\x8cZ\xc8\xc40Lz\x181 +\x9bW\xe4\xddV\xe7ؼc\xc7\xea\xf2\xa7\x8e\xfe\xdag\xdb\x014\x94\x97\xb8n\xb4F\x1e@c-iyQ\x9e\xb4\xe5\x83bʠD\xed?M\xaf\x8b\r\b\xc3\xe4\x978r V\xe7\xd8<F\xb7\xafw\xec\x9c\xc0<\x96ƻ,h\x8c\xd6\xf03O\xd8Fgi\xbc\xabI\xfe\xed\xf1\x86R\x1f~\xe6\tK\x9e\x06<m\xf9\xbc\xc2!;\x86a\xc2\x12k\x12}\xe2\xeam\x00hR\xc62\xdb~\xea\x11\xeb\xf1\xc3\xeb0q\xb5\xbe\xdd96\x8f\xb7_邨\xd0E\x8f\xe0\x91Cw\xb1\xed\xa7\x1e\xb1h=m\x9d|a\xfeK\x00\x00\xab\xeb\xb7C\xc9\xff\xe9\x99!\x00\xc0\xaf\xef\xb9\xe2*\xcf0\fӊ\x98L\xa2[\xab\xff\x004\x8c\x87\xfcYn'\xb6\x11\rM\xe7\xd8<\x96ƻ\x9a\x8c\xc7\xe8\xf6\xf5\xf2\xf9\x94\xf2\x85\xf9/\xa1\xad\xbf\vm\xfd\rC\x12D\xfeO\xcf\f\xa1\xafx\x1f\xfa\x8a\xf7ن\xc4M\x9ea\x18\xa6\xd50\xe2\x81\xd4\xca\xcbV\xcf\xc1\x9a\xbd-+}վ\xea\xf1\xa2\xd5^l\xac\aQ=^\xc4\xc4\xc1\x86\x12\x97\x95\xb4\xb8\xbfz\xbc\xd8t\r\xb2\xfci\xe9xPy\x19/y\x86a\x98V#r\bf\xf0\x93{\x1d\xc6CDg\f\bR\xc4$/o\xcb\xf2^\xed\xabǋ\xe8X\xffe\x00\xc0\xca\xf9\x93h\x1f\xd9\xdf\xf4y\xf9\xf6\x17]\xe5_\x9cy\x10\x00\xf0\x8d\xc5q|f\xe3X\xd3\xe7\xcf\xf7\x9es\xb4\xbf\xfe\xed\xb7#=è\x89\xf4\xbc\xe708\x89\xce0\xf9%\xb2\a\xf2\xc1\xed\xbb\x00\x9aC;K\xe3]\xc0\xf6\xbb\xf6gU\x18\x8ad\xed}\a\x1b\x86\xa28\xd0ᐯ\x95\x97\x1d\x86E%\u007f\xf2\xd6o\xa1mg}\xb4T[\xff\u007f\xc0\xdf\u007f\xfa/\x00\x00\xff\xf1\x1f\xff\xab\xdd\xee\xce\xf7\u007f\a\xa3\xdb\xffP)\xbf\u007f\xf2#\xe8\xdbt\x1f\x00\xe0\xb9\xe2}h\xfb\xdd\xfa5?\xf7\xfb?c\xb7{~\xf2:Nn_\xd7$\xcf0\f\xd3j\x18M\xa2\xcb\x1eCàt9\x8e{ɿ\x81\xbb\xa8\x95\x971q\xb5n0j\xe5\xa2/y\x00\xb83UW\xfaw\xa6g\xf1\xa3\x87o\x01\x00\x96\xbe\xff.\xda\xfa6\xfb\xba\x87\xe9\xda5\x00\xc0;\xb5\xeb\xd8{\xf5~\x00\xc0\xe9\x85w\xf0Pq\x9b/y\x86a\x98V\xc1\x88\x01Y\x1a\xef\xc2\x04\xe6\x1d۔\x04\a\x1a\tq\xb9\rp\xcb!/\x87\x94H\xbe\xe7`]\ue363\xb0\x8f\xab\xe4?\xd8\xf0\a\xc0\xf4\xef\x00\x00\xac\xb9\xcb\xf8\xef?\xfc\xe2\xea\xe7\xff\x87;\xab\xfd~\xb0\xe1\x0f0qU-\xff\xc6\xe0]<?y\x1d\x00pve\x16O\xbe\xf4\xf8\xea\xe7FF\xe5+\x83\x8b\xe8\x91\xe4\x19\x86aZ\x11#\x85\x84s\x95\x8a\xd5\xf9\xc0\x06{\x9f\x9c@'\xc4b\xbc\xa5\x8b\xb7\xd0]*\x15\x00`\xaeR\xb1\xee]\xbf\x0eb.et\xfbz\xbc\xfd\xca:\xd4\xca˨\x0f\xb1m\x84Ǫǋ\xf8\xe0\xf6]\xa5<\xf5q\xf7\xc2\xff@\xe7\x8f\xef\xa8\xf7\xf5\xfdw\xb1nן\xd8\xd7\xe6G\xfe\xf0[mxrS݀|g\xe1\r\x1c\xddwG+\x9f\xe7B´s(\x9c\x03a\x98\xfc\x12y\x18\xef\xb9c\xaf\xa1\xf3\x81\r\x85\xa5\x8b\xb7\xb0t\xb1\xf1F.\x86\x9b\xc4\xcfԮ\xf3\x81\r\xb6\xf2u3\x1e\x00\xf0\xf6+\xeb\x1c\xe7\xe89Xý\xeb\xd7A%\xdf96\x8fG\x0e݅5w\x19w\xa6\xe6qgj\x1e\xd6\xdce<r\xe8\xaem\xd8\xfcȟ]\x99\xc5t\xed\x1a\xa6k\xd7pve\xd6U>M\xd2\xee\x9fa\x98\xd6\xc5X\x0e\x84\f\xc2\xd2\xc5[Vg}\xc0RS\xceB4\x1c\"b\"\x1e\x80m<h\u007f\xad\xbc\x8c\xb7_\xe9\xc0#\x87\x1a9\x96\x0fn\xdf\x05\x8az\xf9\x0f\xb7\xfd=V\xce\u05cf\u007f\xb8\xed\xef\x03\xcb׆ځ+\xe3\x00\x80\u007f\x1ajG\xf1\x95uZy\x86a\x98V$\x96Jt9\xdfA\xfb\xdcr\x06K\xe3]x{\x1c\x0e/\xa6\xf7\x17>\x00\x00\xcc|\xb3\xbe\xffğ\x00\xf5PY\xf3\xb9T\xf2=\xff\xe5\xafW\xe5\xef\r%\u007f\xea\xbf\xd5\xeb\x1d?\xf8\xe6]Oy\x86a\x98V\xc3H%\xfa\xe5\x97^\xb7\xffu\x97J\xf5p\xd6j\x15\xb9\xfdo5\xe7\xd1>\xdb\x0e\xfaGL\xbdy\xc6\x0e\x81Q^\xa1\xf3\x81\r\x8dJ\xf4\xd5\xfc\x8a}\ue2f70\xf5\xe6\x99BV\xe4E\xdc\xf2\x1aI\xccƛv\xff\fô\x0e\x913\x98\x96圕\x84\x94\xd4\\\xa5\xe28@\x8aYF\x8e\xe1\xaf\x15y\xdd~\xd3D\xed?\xed\x1c\n'\xd1\x19&\xbf\xfc\u007f\x1e\xe6\x1ePJR\x8b\xdd\x00\x00\x00\x00IEND\xaeB`\x82")
@@ -0,0 +1,31 @@
+-- called by the trigger zone around the burrow on a generated map, as bunnies hop up to it and away again
+
+function enter()
+	if night() then
+		-- too late to be out, the bunny sleeps until morning
+		set_time("06:00")
+		say("It's late, and the burrow looks cosy.")
+		say("You curl up and doze until the birds start singing.")
+		return
+	end
+
+	if not get("burrow_visited") then
+		set("burrow_visited", true)
+		spawn("rabbit")
+		say("Rabbit", "Oh! A visitor. Nobody comes this way any more.")
+		return
+	end
+
+	local hour = time()
+	if hour < 10 then
+		say("Home sweet burrow. Still early, plenty of time for berries.")
+	else
+		say("Home sweet burrow.")
+	end
+end
+
+function leave()
+	-- a bird startled out of the bushes as the bunny hops off
+	local x, y = player()
+	animate("bird/fly", x, y - 2)
+end
@@ -0,0 +1,264 @@
+//Package script runs Lua scripts in a sandbox. A script can only use Lua's basic functions, its string, table and
+//math libraries and the functions it's given, so it can't touch files or load other code, and it's stopped if it
+//runs too long, so one stuck in a loop can't hang the game.
+//
+//A script defines functions for the game to call, such as a trigger zone's:
+//
+//	function enter()
+//		local x, y = player()
+//		say("Rabbit", "Oh! A visitor.")
+//		spawn("rabbit", x + 1, y)
+//	end
+package script
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	lua "github.com/yuin/gopher-lua"
+	"github.com/yuin/gopher-lua/parse"
+)
+
+//Value is a value passed between the game and a script, nil, a bool, a float64 or a string
+type Value interface{}
+
+//Function is a function the game gives scripts, an error stops the script
+type Function func(args []Value) ([]Value, error)
+
+// the parts of Lua's base library scripts may use, the rest can load code or reach outside the sandbox
+var safeBase = []string{
+	"_G", "_VERSION", "assert", "error", "getmetatable", "ipairs", "next", "pairs", "pcall", "rawequal", "rawget",
+	"rawset", "select", "setmetatable", "tonumber", "tostring", "type", "unpack", "xpcall",
+}
+
+// the functions of the string library scripts can't use, rep can build a string too big to hold in a single step
+var unsafeString = []string{"rep"}
+
+// most nested calls a script can make, in case one recurses forever
+const callStackSize = 200
+
+//Script is a compiled Lua script
+type Script struct {
+	Name  string
+	proto *lua.FunctionProto
+}
+
+//Compile compiles a script, named for errors
+func Compile(r io.Reader, name string) (*Script, error) {
+	chunk, err := parse.Parse(r, name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse script: %v", err)
+	}
+	proto, err := lua.Compile(chunk, name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compile script: %v", err)
+	}
+	return &Script{Name: name, proto: proto}, nil
+}
+
+//Runner calls functions of a script, running each call in a new sandbox so nothing a script does lingers between
+//them, anything it needs to keep must go through the functions it's given
+type Runner struct {
+	Script    *Script
+	Functions map[string]Function
+	// most Lua instructions a call runs before it's stopped, none if 0
+	MaxSteps int
+}
+
+//NewRunner creates a runner for a script
+func NewRunner(s *Script) *Runner {
+	return &Runner{Script: s}
+}
+
+//Call runs the script then calls the function it defines with the name, returning what it returns
+func (r *Runner) Call(name string, args ...Value) ([]Value, error) {
+	L := r.sandbox()
+	defer L.Close()
+
+	if r.MaxSteps > 0 {
+		L.SetContext(&steps{Context: context.Background(), limit: r.MaxSteps})
+	}
+
+	L.Push(L.NewFunctionFromProto(r.Script.proto))
+	if err := L.PCall(0, 0, nil); err != nil {
+		return nil, luaError(err)
+	}
+	fn, ok := L.GetGlobal(name).(*lua.LFunction)
+	if !ok {
+		return nil, fmt.Errorf("%s has no function %s", r.Script.Name, name)
+	}
+
+	top := L.GetTop()
+	L.Push(fn)
+	for _, arg := range args {
+		L.Push(toLua(arg))
+	}
+	if err := L.PCall(len(args), lua.MultRet, nil); err != nil {
+		return nil, luaError(err)
+	}
+	var results []Value
+	for i := top + 1; i <= L.GetTop(); i++ {
+		v, err := fromLua(L.Get(i))
+		if err != nil {
+			return nil, fmt.Errorf("%s returned %v", name, err)
+		}
+		results = append(results, v)
+	}
+	return results, nil
+}
+
+//luaError returns an error raised running a script without the stack trace, its message already says where it was
+func luaError(err error) error {
+	if e, ok := err.(*lua.ApiError); ok {
+		return fmt.Errorf("%s", e.Object.String())
+	}
+	return err
+}
+
+//sandbox creates a Lua state with only the safe parts of Lua's libraries and the runner's functions
+func (r *Runner) sandbox() *lua.LState {
+	L := lua.NewState(lua.Options{SkipOpenLibs: true, CallStackSize: callStackSize})
+	for _, lib := range []struct {
+		name string
+		open lua.LGFunction
+	}{
+		{lua.BaseLibName, lua.OpenBase},
+		{lua.TabLibName, lua.OpenTable},
+		{lua.StringLibName, lua.OpenString},
+		{lua.MathLibName, lua.OpenMath},
+	} {
+		L.Push(L.NewFunction(lib.open))
+		L.Push(lua.LString(lib.name))
+		L.Call(1, 0)
+	}
+
+	globals := L.NewTable()
+	for _, name := range safeBase {
+		globals.RawSetString(name, L.GetGlobal(name))
+	}
+	for _, name := range []string{lua.TabLibName, lua.StringLibName, lua.MathLibName} {
+		globals.RawSetString(name, L.GetGlobal(name))
+	}
+	if s, ok := globals.RawGetString(lua.StringLibName).(*lua.LTable); ok {
+		for _, name := range unsafeString {
+			s.RawSetString(name, lua.LNil)
+		}
+	}
+	globals.RawSetString("_G", globals)
+	L.G.Global = globals
+	L.Env = globals
+
+	for name, fn := range r.Functions {
+		L.SetGlobal(name, wrap(L, name, fn))
+	}
+	return L
+}
+
+//wrap turns a function the game gives scripts into one Lua can call, raising an error in the script if it fails
+func wrap(L *lua.LState, name string, fn Function) *lua.LFunction {
+	return L.NewFunction(func(L *lua.LState) int {
+		args := make([]Value, L.GetTop())
+		for i := range args {
+			v, err := fromLua(L.Get(i + 1))
+			if err != nil {
+				L.RaiseError("%s argument %d is %v", name, i+1, err)
+				return 0
+			}
+			args[i] = v
+		}
+		results, err := fn(args)
+		if err != nil {
+			L.RaiseError("%s: %v", name, err)
+			return 0
+		}
+		for _, v := range results {
+			L.Push(toLua(v))
+		}
+		return len(results)
+	})
+}
+
+func toLua(v Value) lua.LValue {
+	switch v := v.(type) {
+	case bool:
+		return lua.LBool(v)
+	case float64:
+		return lua.LNumber(v)
+	case int:
+		return lua.LNumber(v)
+	case string:
+		return lua.LString(v)
+	}
+	return lua.LNil
+}
+
+func fromLua(v lua.LValue) (Value, error) {
+	switch v := v.(type) {
+	case *lua.LNilType:
+		return nil, nil
+	case lua.LBool:
+		return bool(v), nil
+	case lua.LNumber:
+		return float64(v), nil
+	case lua.LString:
+		return string(v), nil
+	}
+	return nil, fmt.Errorf("a %s, which can't be passed to the game", v.Type())
+}
+
+//String returns a value as a string, as Lua's tostring would
+func String(v Value) string {
+	switch v := v.(type) {
+	case nil:
+		return "nil"
+	case string:
+		return v
+	}
+	return toLua(v).String()
+}
+
+//Number returns a value as a number, if it is one or is a string of one
+func Number(v Value) (float64, error) {
+	switch v := v.(type) {
+	case float64:
+		return v, nil
+	case string:
+		if n, err := strconv.ParseFloat(strings.TrimSpace(v), 64); err == nil {
+			return n, nil
+		}
+	}
+	return 0, fmt.Errorf("expected a number, not %s", String(v))
+}
+
+//steps is a context done once it's been asked more than limit times whether it's done, which the Lua VM asks before
+//every instruction it runs
+type steps struct {
+	context.Context
+	limit int
+	count int
+}
+
+var closed = func() chan struct{} {
+	c := make(chan struct{})
+	close(c)
+	return c
+}()
+
+func (s *steps) Done() <-chan struct{} {
+	s.count++
+	if s.count > s.limit {
+		return closed
+	}
+	// never done
+	return nil
+}
+
+func (s *steps) Err() error {
+	if s.count > s.limit {
+		return fmt.Errorf("ran %d instructions, is it stuck in a loop?", s.limit)
+	}
+	return nil
+}
@@ -0,0 +1,161 @@
+package script
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func compile(t *testing.T, src string) *Script {
+	t.Helper()
+	s, err := Compile(strings.NewReader(src), "test.lua")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestCall(t *testing.T) {
+	s := compile(t, `
+function add(a, b)
+	return a + b, "sum"
+end
+`)
+	results, err := NewRunner(s).Call("add", 2.0, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 2 || results[0] != 5.0 || results[1] != "sum" {
+		t.Errorf("add returned %v, expected [5 sum]", results)
+	}
+
+	if _, err := NewRunner(s).Call("missing"); err == nil {
+		t.Errorf("calling a function the script doesn't have didn't fail")
+	}
+}
+
+func TestFunctions(t *testing.T) {
+	s := compile(t, `
+function enter()
+	local x, y = player()
+	say("Rabbit", "hello from " .. x .. "," .. y)
+end
+`)
+	var said []Value
+	r := NewRunner(s)
+	r.Functions = map[string]Function{
+		"player": func(args []Value) ([]Value, error) {
+			return []Value{4.0, 7.0}, nil
+		},
+		"say": func(args []Value) ([]Value, error) {
+			said = args
+			return nil, nil
+		},
+	}
+	if _, err := r.Call("enter"); err != nil {
+		t.Fatal(err)
+	}
+	if len(said) != 2 || said[0] != "Rabbit" || said[1] != "hello from 4,7" {
+		t.Errorf("said %v", said)
+	}
+}
+
+func TestFunctionErrorsStopTheScript(t *testing.T) {
+	s := compile(t, `
+function enter()
+	spawn("dragon")
+	reached = true
+end
+`)
+	r := NewRunner(s)
+	r.Functions = map[string]Function{
+		"spawn": func(args []Value) ([]Value, error) {
+			return nil, fmt.Errorf("no species called %s", String(args[0]))
+		},
+	}
+	_, err := r.Call("enter")
+	if err == nil || !strings.Contains(err.Error(), "no species called dragon") {
+		t.Errorf("expected the function's error, got %v", err)
+	}
+}
+
+func TestSandbox(t *testing.T) {
+	for _, name := range []string{"os", "io", "require", "dofile", "loadfile", "load", "loadstring", "module", "debug", "package", "channel", "coroutine"} {
+		s := compile(t, fmt.Sprintf("function check() return %s == nil end", name))
+		results, err := NewRunner(s).Call("check")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if results[0] != true {
+			t.Errorf("scripts can use %s", name)
+		}
+	}
+
+	s := compile(t, `function check() return string.rep == nil and ("x"):upper() == "X" and math.floor(2.5) == 2 end`)
+	if results, err := NewRunner(s).Call("check"); err != nil || results[0] != true {
+		t.Errorf("the safe libraries aren't there as expected: %v %v", results, err)
+	}
+}
+
+func TestCallsDontShareState(t *testing.T) {
+	s := compile(t, `
+count = (count or 0)
+function bump()
+	count = count + 1
+	return count
+end
+`)
+	r := NewRunner(s)
+	for i := 0; i < 2; i++ {
+		results, err := r.Call("bump")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if results[0] != 1.0 {
+			t.Errorf("call %d counted %v, expected every call to start afresh", i, results[0])
+		}
+	}
+}
+
+func TestMaxSteps(t *testing.T) {
+	s := compile(t, `
+function loop()
+	while true do end
+end
+
+function caught()
+	while true do
+		pcall(function() while true do end end)
+	end
+end
+
+function recurse()
+	return 1 + recurse()
+end
+`)
+	r := NewRunner(s)
+	r.MaxSteps = 10000
+	for _, name := range []string{"loop", "caught"} {
+		if _, err := r.Call(name); err == nil || !strings.Contains(err.Error(), "stuck in a loop") {
+			t.Errorf("%s: expected the script to be stopped, got %v", name, err)
+		}
+	}
+	if _, err := r.Call("recurse"); err == nil {
+		t.Errorf("endless recursion wasn't stopped")
+	}
+}
+
+func TestCompileError(t *testing.T) {
+	if _, err := Compile(strings.NewReader("function broken("), "broken.lua"); err == nil {
+		t.Errorf("compiled a broken script")
+	}
+}
+
+func TestNumber(t *testing.T) {
+	if n, err := Number(" 3.5"); err != nil || n != 3.5 {
+		t.Errorf("Number(\" 3.5\") is %v, %v", n, err)
+	}
+	if _, err := Number(true); err == nil {
+		t.Errorf("true is a number")
+	}
+}
@@ -0,0 +1,28 @@
+package sound
+
+//Backend decodes and plays sounds, ebiten's audio in the game and a recorder in tests
+type Backend interface {
+	// Load decodes a sound file so it can be played
+	Load(name string, data []byte) (Clip, error)
+	// Play starts a voice playing the clip, over and over if loop
+	Play(c Clip, loop bool) (Voice, error)
+}
+
+//Clip is a decoded sound ready to be played
+type Clip interface {
+	Name() string
+}
+
+//Voice is a clip playing, which can be adjusted while it plays
+type Voice interface {
+	// SetVolume sets how loud the voice plays, 0 silent to 1 full
+	SetVolume(volume float64)
+	// SetPan sets where the voice is heard from, -1 fully left through 0 centre to 1 fully right
+	SetPan(pan float64)
+	// Playing returns whether the voice is still playing, one-shot voices stop by themselves at the end of their clip
+	Playing() bool
+	// Restart plays the voice's clip again from the beginning
+	Restart() error
+	// Close stops the voice for good
+	Close() error
+}
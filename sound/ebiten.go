@@ -0,0 +1,189 @@
+package sound
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sync"
+
+	"github.com/hajimehoshi/ebiten/audio"
+	"github.com/hajimehoshi/ebiten/audio/wav"
+)
+
+//SampleRate is the rate every sound is played at, files at other rates are resampled as they're loaded
+const SampleRate = 44100
+
+// bytes in one frame of decoded sound, a 16 bit sample for each of the left and right channels
+const frameSize = 4
+
+//EbitenBackend plays sounds through ebiten's audio package
+type EbitenBackend struct {
+	context *audio.Context
+}
+
+//NewEbitenBackend creates the backend along with ebiten's audio context, of which there can only be one
+func NewEbitenBackend() (*EbitenBackend, error) {
+	context, err := audio.NewContext(SampleRate)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create audio context: %v", err)
+	}
+	return &EbitenBackend{context: context}, nil
+}
+
+type pcmClip struct {
+	name string
+	// 16 bit little endian stereo samples
+	pcm []byte
+}
+
+func (c *pcmClip) Name() string {
+	return c.name
+}
+
+//Load decodes a WAV file, holding the whole sound in memory so any number of voices can play it at once
+func (b *EbitenBackend) Load(name string, data []byte) (Clip, error) {
+	s, err := wav.Decode(b.context, audio.BytesReadSeekCloser(data))
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode sound %s: %v", name, err)
+	}
+	pcm, err := ioutil.ReadAll(s)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode sound %s: %v", name, err)
+	}
+	return &pcmClip{name: name, pcm: pcm[:len(pcm)/frameSize*frameSize]}, nil
+}
+
+func (b *EbitenBackend) Play(c Clip, loop bool) (Voice, error) {
+	clip, ok := c.(*pcmClip)
+	if !ok {
+		return nil, fmt.Errorf("sound %s wasn't loaded by this backend", c.Name())
+	}
+
+	stream := &pcmStream{pcm: clip.pcm, loop: loop, left: 1, right: 1}
+	player, err := audio.NewPlayer(b.context, stream)
+	if err != nil {
+		return nil, fmt.Errorf("unable to play sound %s: %v", c.Name(), err)
+	}
+	if err := player.Play(); err != nil {
+		return nil, fmt.Errorf("unable to play sound %s: %v", c.Name(), err)
+	}
+	return &ebitenVoice{player: player, stream: stream}, nil
+}
+
+type ebitenVoice struct {
+	player *audio.Player
+	stream *pcmStream
+}
+
+func (v *ebitenVoice) SetVolume(volume float64) {
+	v.player.SetVolume(volume)
+}
+
+func (v *ebitenVoice) SetPan(pan float64) {
+	v.stream.setPan(pan)
+}
+
+func (v *ebitenVoice) Playing() bool {
+	return v.player.IsPlaying()
+}
+
+func (v *ebitenVoice) Restart() error {
+	if err := v.player.Rewind(); err != nil {
+		return err
+	}
+	return v.player.Play()
+}
+
+func (v *ebitenVoice) Close() error {
+	return v.player.Close()
+}
+
+//pcmStream reads a clip's samples for a player, panning them between the speakers as it goes
+type pcmStream struct {
+	pcm  []byte
+	loop bool
+	// the stream is read by the audio goroutine while the game pans it
+	mu  sync.Mutex
+	pos int64
+	// gains of the left and right channels, both 1 when centred
+	left, right float64
+	panned      bool
+}
+
+func (s *pcmStream) setPan(pan float64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.left, s.right = 1, 1
+	if pan > 0 {
+		s.left = 1 - pan
+	} else {
+		s.right = 1 + pan
+	}
+	s.panned = pan != 0
+}
+
+func (s *pcmStream) Read(buf []byte) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.pcm) == 0 {
+		return 0, io.EOF
+	}
+
+	n := 0
+	for n+frameSize <= len(buf) {
+		if s.pos >= int64(len(s.pcm)) {
+			if !s.loop {
+				break
+			}
+			s.pos = 0
+		}
+		c := copy(buf[n:len(buf)/frameSize*frameSize], s.pcm[s.pos:])
+		if s.panned {
+			pan(buf[n:n+c], s.left, s.right)
+		}
+		n += c
+		s.pos += int64(c)
+	}
+
+	if n == 0 && s.pos >= int64(len(s.pcm)) {
+		return 0, io.EOF
+	}
+	return n, nil
+}
+
+// pan scales the left and right samples of each frame by their gains
+func pan(frames []byte, left, right float64) {
+	for i := 0; i+frameSize <= len(frames); i += frameSize {
+		l := int16(frames[i]) | int16(frames[i+1])<<8
+		r := int16(frames[i+2]) | int16(frames[i+3])<<8
+		l, r = int16(float64(l)*left), int16(float64(r)*right)
+		frames[i], frames[i+1] = byte(l), byte(l>>8)
+		frames[i+2], frames[i+3] = byte(r), byte(r>>8)
+	}
+}
+
+func (s *pcmStream) Seek(offset int64, whence int) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += s.pos
+	case io.SeekEnd:
+		offset += int64(len(s.pcm))
+	default:
+		return 0, fmt.Errorf("invalid whence %d", whence)
+	}
+	if offset < 0 {
+		return 0, fmt.Errorf("negative position %d", offset)
+	}
+	// always start on a whole frame so the channels don't swap
+	s.pos = offset / frameSize * frameSize
+	return s.pos, nil
+}
+
+func (s *pcmStream) Close() error {
+	return nil
+}
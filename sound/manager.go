@@ -0,0 +1,336 @@
+package sound
+
+import (
+	"fmt"
+	"math"
+)
+
+//Category is a group of sounds sharing a volume on the mixer
+type Category int
+
+const (
+	//Music is the background music
+	Music Category = iota
+	//Effects are one-shot sounds such as hops and pickups
+	Effects
+	//Ambience is the background sound of the world, such as rain
+	Ambience
+	categories
+)
+
+func (c Category) String() string {
+	switch c {
+	case Music:
+		return "music"
+	case Effects:
+		return "effects"
+	case Ambience:
+		return "ambience"
+	}
+	return "unknown"
+}
+
+const (
+	// most voices one sound may have playing at once, and most one-shot voices of every sound together
+	maxVoicesPerSound = 4
+	maxVoices         = 24
+)
+
+type sound struct {
+	clip     Clip
+	category Category
+	// voices which have played the sound, the least recently started first
+	pool []Voice
+}
+
+//track is a looping sound fading in or out
+type track struct {
+	name  string
+	voice Voice
+	// how far faded in the track is, 0 to 1, the level it's heading for and how far it moves each update
+	level, target, step float64
+}
+
+//channel is a looping sound which crossfades to another when changed, such as the music
+type channel struct {
+	category Category
+	current  *track
+	fading   []*track
+}
+
+//Manager plays music, ambience and one-shot sound effects through a backend, at volumes set by its mixer
+type Manager struct {
+	backend Backend
+	sounds  map[string]*sound
+	voices  int
+
+	master  float64
+	volumes [categories]float64
+
+	music    channel
+	ambience channel
+
+	// where sounds are heard from in the world, and how far away they can be heard
+	listenerX, listenerY float64
+	hearing              float64
+}
+
+//NewManager creates a manager playing through the backend, with every volume at full
+func NewManager(b Backend) *Manager {
+	m := &Manager{
+		backend:  b,
+		sounds:   map[string]*sound{},
+		master:   1,
+		music:    channel{category: Music},
+		ambience: channel{category: Ambience},
+	}
+	for c := range m.volumes {
+		m.volumes[c] = 1
+	}
+	return m
+}
+
+//Load decodes a sound file so it can be played by name, mixed with the category's volume, replacing any sound
+//already loaded with the name and stopping its one-shot voices
+func (m *Manager) Load(name string, category Category, data []byte) error {
+	clip, err := m.backend.Load(name, data)
+	if err != nil {
+		return err
+	}
+
+	if old, ok := m.sounds[name]; ok {
+		for _, v := range old.pool {
+			if err := v.Close(); err != nil {
+				return err
+			}
+		}
+		m.voices -= len(old.pool)
+	}
+
+	m.sounds[name] = &sound{clip: clip, category: category}
+	return nil
+}
+
+//Has returns whether a sound is loaded with the name
+func (m *Manager) Has(name string) bool {
+	_, ok := m.sounds[name]
+	return ok
+}
+
+//SetMasterVolume sets the volume every sound is played at, 0 silent to 1 full
+func (m *Manager) SetMasterVolume(volume float64) {
+	m.master = clamp(volume, 0, 1)
+}
+
+//MasterVolume returns the volume every sound is played at
+func (m *Manager) MasterVolume() float64 {
+	return m.master
+}
+
+//SetVolume sets the volume of the category's sounds, 0 silent to 1 full
+func (m *Manager) SetVolume(c Category, volume float64) {
+	if c >= 0 && c < categories {
+		m.volumes[c] = clamp(volume, 0, 1)
+	}
+}
+
+//Volume returns the volume of the category's sounds
+func (m *Manager) Volume(c Category) float64 {
+	if c < 0 || c >= categories {
+		return 0
+	}
+	return m.volumes[c]
+}
+
+func (m *Manager) mix(c Category) float64 {
+	return m.master * m.Volume(c)
+}
+
+//SetListener sets where in the world sounds are heard from and the width of the view around it, sounds fading out
+//a view's width away and panning towards the side of the view they're on
+func (m *Manager) SetListener(x, y, width float64) {
+	m.listenerX, m.listenerY = x, y
+	m.hearing = width
+}
+
+//Play plays a one-shot sound at full volume in both speakers
+func (m *Manager) Play(name string) error {
+	return m.play(name, 1, 0)
+}
+
+//PlayAt plays a one-shot sound from a position in the world, quieter the further it is from the listener
+func (m *Manager) PlayAt(name string, x, y float64) error {
+	gain, pan := m.position(x, y)
+	if gain <= 0 {
+		return nil
+	}
+	return m.play(name, gain, pan)
+}
+
+//position returns how loud and how far to either side a sound at x, y is heard
+func (m *Manager) position(x, y float64) (float64, float64) {
+	if m.hearing <= 0 {
+		return 1, 0
+	}
+	dx, dy := x-m.listenerX, y-m.listenerY
+	// falls off gently nearby and quickly further out
+	gain := 1 - math.Hypot(dx, dy)/m.hearing
+	if gain <= 0 {
+		return 0, 0
+	}
+	return gain * gain, clamp(dx/(m.hearing/2), -1, 1)
+}
+
+func (m *Manager) play(name string, gain, pan float64) error {
+	s, ok := m.sounds[name]
+	if !ok {
+		return fmt.Errorf("no sound called %s", name)
+	}
+
+	v, err := m.voice(s)
+	if err != nil {
+		return err
+	}
+	v.SetVolume(gain * m.mix(s.category))
+	v.SetPan(pan)
+	return nil
+}
+
+//voice returns a voice playing the sound from the start, reusing one which has finished, starting another if there's
+//room or cutting off the oldest if not
+func (m *Manager) voice(s *sound) (Voice, error) {
+	for i, v := range s.pool {
+		if !v.Playing() {
+			return m.restart(s, i)
+		}
+	}
+
+	if len(s.pool) < maxVoicesPerSound && m.voices < maxVoices {
+		v, err := m.backend.Play(s.clip, false)
+		if err != nil {
+			return nil, err
+		}
+		s.pool = append(s.pool, v)
+		m.voices++
+		return v, nil
+	}
+
+	if len(s.pool) == 0 {
+		return nil, fmt.Errorf("no voices free for sound %s", s.clip.Name())
+	}
+	return m.restart(s, 0)
+}
+
+// restart plays the sound's ith voice again, moving it to the back of the pool as the most recently started
+func (m *Manager) restart(s *sound, i int) (Voice, error) {
+	v := s.pool[i]
+	if err := v.Restart(); err != nil {
+		return nil, err
+	}
+	s.pool = append(append(s.pool[:i:i], s.pool[i+1:]...), v)
+	return v, nil
+}
+
+//PlayMusic crossfades from the music playing to the named track over ticks updates, fading out to silence if name is empty
+func (m *Manager) PlayMusic(name string, ticks int) error {
+	return m.change(&m.music, name, ticks)
+}
+
+//PlayAmbience crossfades the background sound of the world to the named sound, fading out to silence if name is empty
+func (m *Manager) PlayAmbience(name string, ticks int) error {
+	return m.change(&m.ambience, name, ticks)
+}
+
+//Music returns the name of the music playing or fading in, empty if there isn't any
+func (m *Manager) Music() string {
+	if m.music.current == nil {
+		return ""
+	}
+	return m.music.current.name
+}
+
+//Ambience returns the name of the ambience playing or fading in, empty if there isn't any
+func (m *Manager) Ambience() string {
+	if m.ambience.current == nil {
+		return ""
+	}
+	return m.ambience.current.name
+}
+
+func (m *Manager) change(c *channel, name string, ticks int) error {
+	if c.current != nil && c.current.name == name {
+		return nil
+	}
+	if c.current == nil && name == "" {
+		return nil
+	}
+
+	step := 1.0
+	if ticks > 0 {
+		step = 1 / float64(ticks)
+	}
+
+	if c.current != nil {
+		c.current.target = 0
+		c.current.step = step
+		c.fading = append(c.fading, c.current)
+		c.current = nil
+	}
+
+	if name == "" {
+		return nil
+	}
+
+	// a track faded out but not yet closed fades straight back in rather than starting over
+	for i, t := range c.fading {
+		if t.name == name {
+			c.fading = append(c.fading[:i], c.fading[i+1:]...)
+			t.target, t.step = 1, step
+			c.current = t
+			return nil
+		}
+	}
+
+	s, ok := m.sounds[name]
+	if !ok {
+		return fmt.Errorf("no sound called %s", name)
+	}
+	v, err := m.backend.Play(s.clip, true)
+	if err != nil {
+		return err
+	}
+	v.SetVolume(0)
+	c.current = &track{name: name, voice: v, target: 1, step: step}
+	return nil
+}
+
+//Update moves fades along and applies the mixer's volumes to the music and ambience, call it once a tick
+func (m *Manager) Update() error {
+	for _, c := range []*channel{&m.music, &m.ambience} {
+		volume := m.mix(c.category)
+
+		if t := c.current; t != nil {
+			t.level = math.Min(t.target, t.level+t.step)
+			t.voice.SetVolume(t.level * volume)
+		}
+
+		fading := c.fading[:0]
+		for _, t := range c.fading {
+			t.level = math.Max(t.target, t.level-t.step)
+			if t.level <= 0 {
+				if err := t.voice.Close(); err != nil {
+					return err
+				}
+				continue
+			}
+			t.voice.SetVolume(t.level * volume)
+			fading = append(fading, t)
+		}
+		c.fading = fading
+	}
+	return nil
+}
+
+func clamp(v, min, max float64) float64 {
+	return math.Max(min, math.Min(max, v))
+}
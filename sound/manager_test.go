@@ -0,0 +1,258 @@
+package sound
+
+import (
+	"fmt"
+	"math"
+	"testing"
+)
+
+//newTestManager returns a manager playing through a null backend, with the named sounds loaded in the category
+func newTestManager(t *testing.T, c Category, names ...string) (*Manager, *NullBackend) {
+	t.Helper()
+	b := NewNullBackend()
+	m := NewManager(b)
+	for _, name := range names {
+		if err := m.Load(name, c, []byte(name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return m, b
+}
+
+//played returns the last voice played of the clip
+func played(b *NullBackend, clip string) *Played {
+	for i := len(b.Played) - 1; i >= 0; i-- {
+		if b.Played[i].Clip == clip {
+			return b.Played[i]
+		}
+	}
+	return nil
+}
+
+func near(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestCrossfade(t *testing.T) {
+	m, b := newTestManager(t, Music, "day", "night")
+
+	if err := m.PlayMusic("day", 4); err != nil {
+		t.Fatal(err)
+	}
+	day := played(b, "day")
+	if !day.Loop || day.Volume != 0 {
+		t.Errorf("music started looping %v at volume %v, expected looping from silence", day.Loop, day.Volume)
+	}
+	for i, expected := range []float64{0.25, 0.5, 0.75, 1, 1} {
+		m.Update()
+		if !near(day.Volume, expected) {
+			t.Errorf("update %d: fading in music at %v, expected %v", i+1, day.Volume, expected)
+		}
+	}
+
+	if err := m.PlayMusic("night", 2); err != nil {
+		t.Fatal(err)
+	}
+	night := played(b, "night")
+	m.Update()
+	if !near(day.Volume, 0.5) || !near(night.Volume, 0.5) {
+		t.Errorf("halfway through the crossfade day is at %v and night %v, expected both at 0.5", day.Volume, night.Volume)
+	}
+	m.Update()
+	if !day.Closed || !near(night.Volume, 1) {
+		t.Errorf("after the crossfade day is closed %v and night at %v, expected day closed and night full", day.Closed, night.Volume)
+	}
+	if m.Music() != "night" {
+		t.Errorf("music is %q, expected night", m.Music())
+	}
+
+	// asking for what's already playing changes nothing
+	m.PlayMusic("night", 2)
+	m.Update()
+	if len(b.Played) != 2 || !near(night.Volume, 1) {
+		t.Errorf("playing the music already playing started it again or faded it")
+	}
+}
+
+func TestCrossfadeBack(t *testing.T) {
+	m, b := newTestManager(t, Ambience, "wind", "rain")
+	m.PlayAmbience("wind", 0)
+	m.Update()
+	wind := played(b, "wind")
+	if wind.Volume != 1 {
+		t.Errorf("ambience with no fade is at %v, expected straight in at full", wind.Volume)
+	}
+
+	m.PlayAmbience("rain", 4)
+	m.Update()
+	// wind comes back while it's still fading out, from where it had got to
+	m.PlayAmbience("wind", 4)
+	m.Update()
+	if len(b.Played) != 2 || wind.Closed || !near(wind.Volume, 1) {
+		t.Errorf("wind coming back played %d voices with wind closed %v at %v, expected its voice to fade back in", len(b.Played), wind.Closed, wind.Volume)
+	}
+	if m.Ambience() != "wind" {
+		t.Errorf("ambience is %q, expected wind", m.Ambience())
+	}
+
+	m.PlayAmbience("", 2)
+	m.Update()
+	m.Update()
+	for _, p := range b.Played {
+		if !p.Closed {
+			t.Errorf("%s is still open after fading out to silence", p.Clip)
+		}
+	}
+	if m.Ambience() != "" {
+		t.Errorf("ambience is %q after fading out, expected none", m.Ambience())
+	}
+
+	if err := m.PlayAmbience("storm", 2); err == nil {
+		t.Errorf("played ambience which was never loaded")
+	}
+}
+
+func TestEffectPool(t *testing.T) {
+	m, b := newTestManager(t, Effects, "hop")
+
+	// every voice still playing, each play takes another until the sound has all it's allowed
+	for i := 0; i < maxVoicesPerSound; i++ {
+		m.Play("hop")
+	}
+	if len(b.Played) != maxVoicesPerSound {
+		t.Fatalf("%d voices played, expected %d", len(b.Played), maxVoicesPerSound)
+	}
+	m.Play("hop")
+	if len(b.Played) != maxVoicesPerSound || b.Played[0].Restarts != 1 {
+		t.Errorf("playing past the limit didn't cut off the oldest voice")
+	}
+	m.Play("hop")
+	if b.Played[1].Restarts != 1 {
+		t.Errorf("playing past the limit again didn't cut off the next oldest")
+	}
+
+	// finished voices are reused before any are cut off
+	b.Finish()
+	b.Played[3].playing = true
+	m.Play("hop")
+	if len(b.Played) != maxVoicesPerSound || b.Played[2].Restarts != 1 || b.Played[3].Restarts != 0 {
+		t.Errorf("a finished voice wasn't reused")
+	}
+
+	// loading over the sound closes its voices
+	if err := m.Load("hop", Effects, []byte("hop")); err != nil {
+		t.Fatal(err)
+	}
+	if len(b.Playing()) != 0 || m.voices != 0 {
+		t.Errorf("%d voices left playing and %d counted after loading over the sound", len(b.Playing()), m.voices)
+	}
+}
+
+func TestVoiceLimit(t *testing.T) {
+	var names []string
+	for i := 0; i <= maxVoices/maxVoicesPerSound; i++ {
+		names = append(names, fmt.Sprintf("effect%d", i))
+	}
+	m, b := newTestManager(t, Effects, names...)
+
+	for _, name := range names[:len(names)-1] {
+		for i := 0; i < maxVoicesPerSound; i++ {
+			m.Play(name)
+		}
+	}
+	if len(b.Played) != maxVoices {
+		t.Fatalf("%d voices played, expected %d", len(b.Played), maxVoices)
+	}
+	if err := m.Play(names[len(names)-1]); err == nil {
+		t.Errorf("a sound started with every voice taken")
+	}
+	if len(b.Played) != maxVoices {
+		t.Errorf("%d voices played, expected no more than %d", len(b.Played), maxVoices)
+	}
+	if err := m.Play("missing"); err == nil {
+		t.Errorf("played a sound which was never loaded")
+	}
+}
+
+func TestCategoryMixing(t *testing.T) {
+	b := NewNullBackend()
+	m := NewManager(b)
+	m.Load("hop", Effects, []byte("hop"))
+	m.Load("day", Music, []byte("day"))
+	m.Load("rain", Ambience, []byte("rain"))
+
+	m.SetMasterVolume(0.5)
+	m.SetVolume(Effects, 0.8)
+	m.SetVolume(Music, 0.4)
+	m.SetVolume(Ambience, 2)
+	if m.Volume(Ambience) != 1 || m.Volume(Category(9)) != 0 {
+		t.Errorf("volumes aren't kept between 0 and 1, or an unknown category has a volume")
+	}
+
+	m.Play("hop")
+	m.PlayMusic("day", 0)
+	m.PlayAmbience("rain", 0)
+	m.Update()
+	for clip, expected := range map[string]float64{"hop": 0.4, "day": 0.2, "rain": 0.5} {
+		if v := played(b, clip).Volume; !near(v, expected) {
+			t.Errorf("%s is at %v, expected %v", clip, v, expected)
+		}
+	}
+
+	// the mixer applies to music and ambience already playing
+	m.SetVolume(Music, 1)
+	m.Update()
+	if v := played(b, "day").Volume; !near(v, 0.5) {
+		t.Errorf("music is at %v after turning it up, expected 0.5", v)
+	}
+}
+
+func TestPosition(t *testing.T) {
+	m, b := newTestManager(t, Effects, "hop")
+
+	// with nowhere to listen from every sound is heard in full
+	m.PlayAt("hop", 5000, -300)
+	if p := b.Played[0]; p.Volume != 1 || p.Pan != 0 {
+		t.Errorf("with no listener a sound played at volume %v and pan %v, expected full in the centre", p.Volume, p.Pan)
+	}
+	b.Finish()
+
+	m.SetListener(100, 100, 200)
+	m.SetVolume(Effects, 0.5)
+	tests := []struct {
+		x, y        float64
+		volume, pan float64
+		description string
+	}{
+		{100, 100, 0.5, 0, "on the listener"},
+		{150, 100, 0.5 * 0.75 * 0.75, 0.5, "a quarter of the view to the right"},
+		{0, 100, 0.5 * 0.5 * 0.5, -1, "at the left edge of the view"},
+		{100, 20, 0.5 * 0.6 * 0.6, 0, "straight above"},
+		{-40, 100, 0.5 * 0.3 * 0.3, -1, "off the left of the view"},
+	}
+	for _, test := range tests {
+		if err := m.PlayAt("hop", test.x, test.y); err != nil {
+			t.Fatal(err)
+		}
+		p := b.Playing()[0]
+		if !near(p.Volume, test.volume) || !near(p.Pan, test.pan) {
+			t.Errorf("%s: played at volume %v and pan %v, expected %v and %v", test.description, p.Volume, p.Pan, test.volume, test.pan)
+		}
+		b.Finish()
+	}
+
+	before := len(b.Played)
+	restarts := 0
+	for _, p := range b.Played {
+		restarts += p.Restarts
+	}
+	m.PlayAt("hop", 100, 300)
+	m.PlayAt("hop", 400, 400)
+	after := 0
+	for _, p := range b.Played {
+		after += p.Restarts
+	}
+	if len(b.Played) != before || after != restarts {
+		t.Errorf("a sound as far as the view is wide away was played")
+	}
+}
@@ -0,0 +1,89 @@
+package sound
+
+import "fmt"
+
+//Played is a record of a voice the null backend was asked to play, kept up to date as it's adjusted
+type Played struct {
+	Clip   string
+	Loop   bool
+	Volume float64
+	Pan    float64
+	// how many times the voice was restarted after first playing
+	Restarts int
+	Closed   bool
+	playing  bool
+}
+
+//NullBackend plays nothing, only recording what it was asked to play, for running without sound and for tests
+type NullBackend struct {
+	// every voice played, in the order they were
+	Played []*Played
+}
+
+//NewNullBackend creates a backend which records what would have been played
+func NewNullBackend() *NullBackend {
+	return &NullBackend{}
+}
+
+type nullClip string
+
+func (c nullClip) Name() string {
+	return string(c)
+}
+
+func (b *NullBackend) Load(name string, data []byte) (Clip, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("sound %s is empty", name)
+	}
+	return nullClip(name), nil
+}
+
+func (b *NullBackend) Play(c Clip, loop bool) (Voice, error) {
+	p := &Played{Clip: c.Name(), Loop: loop, Volume: 1, playing: true}
+	b.Played = append(b.Played, p)
+	return p, nil
+}
+
+//Finish ends every one-shot voice, as if their clips had all played to the end
+func (b *NullBackend) Finish() {
+	for _, p := range b.Played {
+		if !p.Loop {
+			p.playing = false
+		}
+	}
+}
+
+//Playing returns the records of voices still playing
+func (b *NullBackend) Playing() []*Played {
+	var playing []*Played
+	for _, p := range b.Played {
+		if p.playing {
+			playing = append(playing, p)
+		}
+	}
+	return playing
+}
+
+func (p *Played) SetVolume(volume float64) {
+	p.Volume = volume
+}
+
+func (p *Played) SetPan(pan float64) {
+	p.Pan = pan
+}
+
+func (p *Played) Playing() bool {
+	return p.playing
+}
+
+func (p *Played) Restart() error {
+	p.Restarts++
+	p.playing = !p.Closed
+	return nil
+}
+
+func (p *Played) Close() error {
+	p.Closed = true
+	p.playing = false
+	return nil
+}
@@ -0,0 +1,86 @@
+package terrain
+
+import (
+	"math"
+
+	"github.com/tauraamui/berrybun/utils"
+)
+
+//Noise is seeded 2D gradient (Perlin) noise
+type Noise struct {
+	perm [512]int
+}
+
+// the directions gradients can point in at each lattice point
+var gradients = [8][2]float64{
+	{1, 0}, {-1, 0}, {0, 1}, {0, -1},
+	{math.Sqrt2 / 2, math.Sqrt2 / 2}, {-math.Sqrt2 / 2, math.Sqrt2 / 2},
+	{math.Sqrt2 / 2, -math.Sqrt2 / 2}, {-math.Sqrt2 / 2, -math.Sqrt2 / 2},
+}
+
+//NewNoise creates noise which is always the same for the same seed
+func NewNoise(seed uint64) *Noise {
+	n := &Noise{}
+	random := utils.NewRand(seed)
+
+	var p [256]int
+	for i := range p {
+		p[i] = i
+	}
+	// Fisher-Yates shuffle of the lattice hash
+	for i := len(p) - 1; i > 0; i-- {
+		j := int(random.Next(uint32(i + 1)))
+		p[i], p[j] = p[j], p[i]
+	}
+
+	for i := range n.perm {
+		n.perm[i] = p[i&255]
+	}
+	return n
+}
+
+//At returns the noise value at x, y, roughly in the range -1 to 1
+func (n *Noise) At(x, y float64) float64 {
+	x0, y0 := math.Floor(x), math.Floor(y)
+	xi, yi := int(x0)&255, int(y0)&255
+	xf, yf := x-x0, y-y0
+
+	dot := func(hash int, dx, dy float64) float64 {
+		g := gradients[hash&7]
+		return g[0]*dx + g[1]*dy
+	}
+
+	aa := n.perm[n.perm[xi]+yi]
+	ab := n.perm[n.perm[xi]+yi+1]
+	ba := n.perm[n.perm[xi+1]+yi]
+	bb := n.perm[n.perm[xi+1]+yi+1]
+
+	u, v := fade(xf), fade(yf)
+
+	x1 := lerp(dot(aa, xf, yf), dot(ba, xf-1, yf), u)
+	x2 := lerp(dot(ab, xf, yf-1), dot(bb, xf-1, yf-1), u)
+
+	// unscaled 2D Perlin noise peaks at about ±0.7
+	return lerp(x1, x2, v) * math.Sqrt2
+}
+
+//Fractal sums octaves of noise, each at double the frequency and half the amplitude of the last, normalised to 0 to 1
+func (n *Noise) Fractal(x, y float64, octaves int) float64 {
+	total, amplitude, frequency, max := 0.0, 1.0, 1.0, 0.0
+	for i := 0; i < octaves; i++ {
+		total += n.At(x*frequency, y*frequency) * amplitude
+		max += amplitude
+		amplitude /= 2
+		frequency *= 2
+	}
+	v := (total/max + 1) / 2
+	return math.Max(0, math.Min(1, v))
+}
+
+func fade(t float64) float64 {
+	return t * t * t * (t*(t*6-15) + 10)
+}
+
+func lerp(a, b, t float64) float64 {
+	return a + (b-a)*t
+}
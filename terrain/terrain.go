@@ -0,0 +1,187 @@
+package terrain
+
+import (
+	"github.com/tauraamui/berrybun/utils"
+)
+
+//Biome is the kind of land a cell of the map is
+type Biome int
+
+const (
+	Meadow Biome = iota
+	Forest
+	Pond
+	Sand
+)
+
+func (b Biome) String() string {
+	switch b {
+	case Meadow:
+		return "meadow"
+	case Forest:
+		return "forest"
+	case Pond:
+		return "pond"
+	case Sand:
+		return "sand"
+	}
+	return "unknown"
+}
+
+//Cell is the generated terrain for a single tile of the map
+type Cell struct {
+	Biome Biome
+	// whether a river runs through the cell, rivers are water just like ponds
+	River bool
+	// how likely berry bushes are to grow here, 0 to 1
+	BerryDensity float64
+	// height of the land, 0 to 1, rivers run downhill and ponds fill the lowest ground
+	Elevation float64
+	Moisture  float64
+}
+
+//Water returns whether the cell is a pond or river
+func (c Cell) Water() bool {
+	return c.Biome == Pond || c.River
+}
+
+//Generator builds terrain from seeded noise, the same seed and settings always give the same terrain
+type Generator struct {
+	Seed   uint64
+	Width  int
+	Height int
+
+	// size of features in tiles, larger values give bigger biomes
+	Scale float64
+	// elevations below PondLevel are ponds, and up to SandLevel are sandy shores
+	PondLevel float64
+	SandLevel float64
+	// moisture above ForestLevel grows forest
+	ForestLevel float64
+	// how many rivers to try to carve from the high ground down
+	Rivers int
+}
+
+//NewGenerator creates a generator with the default settings for a map of the provided size
+func NewGenerator(seed uint64, width, height int) *Generator {
+	return &Generator{
+		Seed:        seed,
+		Width:       width,
+		Height:      height,
+		Scale:       64,
+		PondLevel:   0.34,
+		SandLevel:   0.38,
+		ForestLevel: 0.58,
+		Rivers:      width * height / 25000,
+	}
+}
+
+//Generate builds the terrain, indexed [y][x]
+func (g *Generator) Generate() [][]Cell {
+	// each map gets its own independent noise from the one seed
+	elevation := NewNoise(g.Seed)
+	moisture := NewNoise(g.Seed + 1)
+	berries := NewNoise(g.Seed + 2)
+
+	cells := make([][]Cell, g.Height)
+	for y := 0; y < g.Height; y++ {
+		cells[y] = make([]Cell, g.Width)
+		for x := 0; x < g.Width; x++ {
+			fx, fy := float64(x)/g.Scale, float64(y)/g.Scale
+
+			c := Cell{
+				Elevation: elevation.Fractal(fx, fy, 5),
+				Moisture:  moisture.Fractal(fx+0.5, fy+0.5, 3),
+			}
+
+			switch {
+			case c.Elevation < g.PondLevel:
+				c.Biome = Pond
+			case c.Elevation < g.SandLevel:
+				c.Biome = Sand
+			case c.Moisture > g.ForestLevel:
+				c.Biome = Forest
+			default:
+				c.Biome = Meadow
+			}
+
+			cells[y][x] = c
+		}
+	}
+
+	g.carveRivers(cells)
+
+	for y := 0; y < g.Height; y++ {
+		for x := 0; x < g.Width; x++ {
+			c := &cells[y][x]
+			density := berries.Fractal(float64(x)/(g.Scale/4), float64(y)/(g.Scale/4), 2)
+			switch {
+			case c.Water() || c.Biome == Sand:
+				density = 0
+			case c.Biome == Meadow:
+				density *= 0.4
+			}
+			c.BerryDensity = density
+		}
+	}
+
+	return cells
+}
+
+//carveRivers starts rivers at random high points and follows the steepest way down until they reach a pond, another
+//river or the map edge. A river in a hollow it can't get out of pools into a pond there.
+func (g *Generator) carveRivers(cells [][]Cell) {
+	random := utils.NewRand(g.Seed + 3)
+	maxLength := g.Width + g.Height
+
+	for i := 0; i < g.Rivers; i++ {
+		// try a few spots for each river, only starting on high enough ground
+		x, y := -1, -1
+		for attempt := 0; attempt < 20; attempt++ {
+			cx, cy := int(random.Next(uint32(g.Width))), int(random.Next(uint32(g.Height)))
+			if cells[cy][cx].Elevation > 0.6 {
+				x, y = cx, cy
+				break
+			}
+		}
+		if x < 0 {
+			continue
+		}
+
+		// the cells of this river so far, which it mustn't turn back onto
+		course := map[[2]int]bool{}
+		for step := 0; ; step++ {
+			c := &cells[y][x]
+			if c.Water() {
+				break
+			}
+			c.River = true
+			course[[2]int{x, y}] = true
+
+			// move to the lowest neighbour it hasn't already run through, even if that's uphill, so a river in a
+			// shallow dip spills over the lowest side rather than stalling
+			nx, ny := -1, -1
+			lowest := 2.0
+			offEdge := false
+			for _, d := range [][2]int{{0, 1}, {1, 0}, {0, -1}, {-1, 0}} {
+				ax, ay := x+d[0], y+d[1]
+				if ax < 0 || ay < 0 || ax >= g.Width || ay >= g.Height {
+					offEdge = true
+					continue
+				}
+				if e := cells[ay][ax].Elevation; e < lowest && !course[[2]int{ax, ay}] {
+					nx, ny, lowest = ax, ay, e
+				}
+			}
+			// a river already on the edge runs off the map unless there's lower ground to follow
+			if offEdge && (nx < 0 || lowest >= c.Elevation) {
+				break
+			}
+			if nx < 0 || step >= maxLength {
+				c.Biome = Pond
+				break
+			}
+			x, y = nx, ny
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package terrain
+
+import (
+	"image"
+	"testing"
+)
+
+// big enough for every biome and a few rivers
+const testWidth, testHeight = 256, 192
+
+func generate(seed uint64) [][]Cell {
+	return NewGenerator(seed, testWidth, testHeight).Generate()
+}
+
+func TestSameSeedSameMap(t *testing.T) {
+	a, b := generate(7), generate(7)
+	for y := range a {
+		for x := range a[y] {
+			if a[y][x] != b[y][x] {
+				t.Fatalf("cell %d,%d is %+v then %+v from the same seed", x, y, a[y][x], b[y][x])
+			}
+		}
+	}
+}
+
+func TestDifferentSeedsDifferentMaps(t *testing.T) {
+	a, b := generate(7), generate(8)
+	differ := 0
+	for y := range a {
+		for x := range a[y] {
+			if a[y][x].Biome != b[y][x].Biome {
+				differ++
+			}
+		}
+	}
+	// not just a few cells at the edges of the same biomes
+	if differ < testWidth*testHeight/10 {
+		t.Errorf("only %d of %d cells have a different biome with a different seed", differ, testWidth*testHeight)
+	}
+}
+
+func TestNoiseRange(t *testing.T) {
+	n := NewNoise(3)
+	for y := 0.0; y < 20; y += 0.37 {
+		for x := 0.0; x < 20; x += 0.41 {
+			if v := n.Fractal(x, y, 5); v < 0 || v > 1 {
+				t.Fatalf("fractal noise at %v,%v is %v, outside 0 to 1", x, y, v)
+			}
+		}
+	}
+	if NewNoise(3).At(1.5, 2.5) != n.At(1.5, 2.5) {
+		t.Errorf("noise from the same seed differs")
+	}
+}
+
+func TestEveryBiome(t *testing.T) {
+	for _, seed := range []uint64{1, 2, 3} {
+		counts := map[Biome]int{}
+		rivers := 0
+		for _, row := range generate(seed) {
+			for _, c := range row {
+				counts[c.Biome]++
+				if c.River {
+					rivers++
+				}
+			}
+		}
+		for _, b := range []Biome{Meadow, Forest, Pond, Sand} {
+			if counts[b] == 0 {
+				t.Errorf("seed %d: no %s on a %dx%d map", seed, b, testWidth, testHeight)
+			}
+		}
+		if rivers == 0 {
+			t.Errorf("seed %d: no rivers on a %dx%d map", seed, testWidth, testHeight)
+		}
+	}
+}
+
+func TestBerryDensity(t *testing.T) {
+	for y, row := range generate(5) {
+		for x, c := range row {
+			if c.BerryDensity < 0 || c.BerryDensity > 1 {
+				t.Fatalf("cell %d,%d has berry density %v, outside 0 to 1", x, y, c.BerryDensity)
+			}
+			if (c.Water() || c.Biome == Sand) && c.BerryDensity != 0 {
+				t.Fatalf("berries can grow in the %s at %d,%d", c.Biome, x, y)
+			}
+		}
+	}
+}
+
+//TestRiversContinuous checks every river is an unbroken run of cells, each river cell next to the one before or
+//after it, and that each reaches a pond or the edge of the map unless it ran out of length
+func TestRiversContinuous(t *testing.T) {
+	for _, seed := range []uint64{1, 2, 3, 4} {
+		g := NewGenerator(seed, testWidth, testHeight)
+		g.Rivers = 6
+		cells := g.Generate()
+
+		inside := func(p image.Point) bool {
+			return p.X >= 0 && p.Y >= 0 && p.X < testWidth && p.Y < testHeight
+		}
+		sides := []image.Point{{0, 1}, {1, 0}, {0, -1}, {-1, 0}}
+
+		seen := map[image.Point]bool{}
+		for y := range cells {
+			for x := range cells[y] {
+				start := image.Pt(x, y)
+				if !cells[y][x].River || seen[start] {
+					continue
+				}
+
+				// flood the river, noting whether it reaches a pond or an edge
+				size, mouth := 0, false
+				queue := []image.Point{start}
+				seen[start] = true
+				for len(queue) > 0 {
+					p := queue[0]
+					queue = queue[1:]
+					size++
+					for _, d := range sides {
+						n := p.Add(d)
+						if !inside(n) {
+							mouth = true
+							continue
+						}
+						c := cells[n.Y][n.X]
+						if c.Biome == Pond {
+							mouth = true
+						}
+						if c.River && !seen[n] {
+							seen[n] = true
+							queue = append(queue, n)
+						}
+					}
+				}
+
+				if !mouth && size < testWidth+testHeight {
+					t.Errorf("seed %d: the river through %v stops after %d cells without reaching a pond or the edge", seed, start, size)
+				}
+			}
+		}
+		if len(seen) == 0 {
+			t.Errorf("seed %d: no rivers carved", seed)
+		}
+	}
+}
@@ -0,0 +1,277 @@
+package tileset
+
+import (
+	"encoding/json"
+	"fmt"
+	"image"
+	"io"
+	"time"
+)
+
+//TileID identifies a tile by its index within a tileset, counting across each row of the sheet in turn
+type TileID int
+
+//NoTile is an empty cell, it has no tile in any tileset
+const NoTile TileID = -1
+
+//Frame is one step of an animated tile
+type Frame struct {
+	Tile     TileID
+	Duration time.Duration
+}
+
+//Tile is the metadata for a single tile of a tileset
+type Tile struct {
+	ID TileID
+	// area of the tileset's image the tile is drawn from
+	Rect image.Rectangle
+	// whether the tile blocks movement
+	Solid bool
+	// kind of ground the tile is, such as grass or water, empty if it isn't ground
+	Terrain string
+	// the tiles drawn in turn in place of this one, empty if the tile isn't animated
+	Frames []Frame
+	// name of the sound played when walking over the tile, empty for silence
+	Footstep string
+	// total length of one loop of the frames
+	period time.Duration
+}
+
+//Tileset is a spritesheet of equally sized tiles and the metadata for each of them
+type Tileset struct {
+	// name of the image the tiles are cut from
+	Image      string
+	TileWidth  int
+	TileHeight int
+	Columns    int
+	Rows       int
+	tiles      []Tile
+	palettes   map[string]Palette
+}
+
+//Palette swaps tiles for others drawn in their place, such as snowy grass for grass in winter
+type Palette map[TileID]TileID
+
+//Swap returns the tile drawn in place of id, id itself if the palette doesn't swap it
+func (p Palette) Swap(id TileID) TileID {
+	if to, ok := p[id]; ok {
+		return to
+	}
+	return id
+}
+
+// the layout of a tileset descriptor file
+type descriptor struct {
+	Image      string           `json:"image"`
+	TileWidth  int              `json:"tileWidth"`
+	TileHeight int              `json:"tileHeight"`
+	Columns    int              `json:"columns"`
+	Rows       int              `json:"rows"`
+	Tiles      []tileDescriptor `json:"tiles"`
+	// named sets of swaps, each swapping a block of tiles for a block the same size elsewhere in the sheet
+	Palettes map[string][]swapDescriptor `json:"palettes"`
+}
+
+// the block w by h tiles from x, y swapped for the block from to, or for nothing if to is missing
+type swapDescriptor struct {
+	X  int `json:"x"`
+	Y  int `json:"y"`
+	W  int `json:"w"`
+	H  int `json:"h"`
+	To *struct {
+		X int `json:"x"`
+		Y int `json:"y"`
+	} `json:"to"`
+}
+
+// metadata for the tile at x, y, or every tile of the block w by h tiles from there
+type tileDescriptor struct {
+	X        int               `json:"x"`
+	Y        int               `json:"y"`
+	W        int               `json:"w"`
+	H        int               `json:"h"`
+	Solid    bool              `json:"solid"`
+	Terrain  string            `json:"terrain"`
+	Footstep string            `json:"footstep"`
+	Frames   []frameDescriptor `json:"frames"`
+}
+
+type frameDescriptor struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+	// how long the frame shows for in milliseconds
+	Duration int `json:"duration"`
+}
+
+//Load reads a tileset from a JSON descriptor
+func Load(r io.Reader) (*Tileset, error) {
+	var d descriptor
+	if err := json.NewDecoder(r).Decode(&d); err != nil {
+		return nil, fmt.Errorf("unable to decode tileset: %v", err)
+	}
+
+	if d.TileWidth <= 0 || d.TileHeight <= 0 || d.Columns <= 0 || d.Rows <= 0 {
+		return nil, fmt.Errorf("tileset %s: tile size and dimensions must be positive", d.Image)
+	}
+
+	ts := &Tileset{
+		Image:      d.Image,
+		TileWidth:  d.TileWidth,
+		TileHeight: d.TileHeight,
+		Columns:    d.Columns,
+		Rows:       d.Rows,
+		tiles:      make([]Tile, d.Columns*d.Rows),
+	}
+
+	// work out every source rect up front so drawing a tile is a lookup
+	for i := range ts.tiles {
+		x, y := i%ts.Columns, i/ts.Columns
+		ts.tiles[i] = Tile{
+			ID:   TileID(i),
+			Rect: image.Rect(x*ts.TileWidth, y*ts.TileHeight, (x+1)*ts.TileWidth, (y+1)*ts.TileHeight),
+		}
+	}
+
+	for _, td := range d.Tiles {
+		w, h := td.W, td.H
+		if w == 0 {
+			w = 1
+		}
+		if h == 0 {
+			h = 1
+		}
+		if !ts.contains(td.X, td.Y) || !ts.contains(td.X+w-1, td.Y+h-1) {
+			return nil, fmt.Errorf("tileset %s: tile %d,%d (%dx%d) is outside the sheet", d.Image, td.X, td.Y, w, h)
+		}
+
+		var frames []Frame
+		var period time.Duration
+		for _, fd := range td.Frames {
+			if !ts.contains(fd.X, fd.Y) {
+				return nil, fmt.Errorf("tileset %s: frame %d,%d of tile %d,%d is outside the sheet", d.Image, fd.X, fd.Y, td.X, td.Y)
+			}
+			if fd.Duration <= 0 {
+				return nil, fmt.Errorf("tileset %s: frame %d,%d of tile %d,%d needs a positive duration", d.Image, fd.X, fd.Y, td.X, td.Y)
+			}
+			f := Frame{
+				Tile:     ts.ID(fd.X, fd.Y),
+				Duration: time.Duration(fd.Duration) * time.Millisecond,
+			}
+			frames = append(frames, f)
+			period += f.Duration
+		}
+
+		for y := td.Y; y < td.Y+h; y++ {
+			for x := td.X; x < td.X+w; x++ {
+				t := &ts.tiles[ts.ID(x, y)]
+				t.Solid = td.Solid
+				t.Terrain = td.Terrain
+				t.Footstep = td.Footstep
+				t.Frames = frames
+				t.period = period
+			}
+		}
+	}
+
+	ts.palettes = make(map[string]Palette, len(d.Palettes))
+	for name, swaps := range d.Palettes {
+		p := Palette{}
+		for _, sd := range swaps {
+			w, h := sd.W, sd.H
+			if w == 0 {
+				w = 1
+			}
+			if h == 0 {
+				h = 1
+			}
+			if !ts.contains(sd.X, sd.Y) || !ts.contains(sd.X+w-1, sd.Y+h-1) {
+				return nil, fmt.Errorf("tileset %s: palette %s swaps tile %d,%d (%dx%d) outside the sheet", d.Image, name, sd.X, sd.Y, w, h)
+			}
+			if sd.To != nil && (!ts.contains(sd.To.X, sd.To.Y) || !ts.contains(sd.To.X+w-1, sd.To.Y+h-1)) {
+				return nil, fmt.Errorf("tileset %s: palette %s swaps tile %d,%d for %d,%d outside the sheet", d.Image, name, sd.X, sd.Y, sd.To.X, sd.To.Y)
+			}
+			for y := 0; y < h; y++ {
+				for x := 0; x < w; x++ {
+					to := NoTile
+					if sd.To != nil {
+						to = ts.ID(sd.To.X+x, sd.To.Y+y)
+					}
+					p[ts.ID(sd.X+x, sd.Y+y)] = to
+				}
+			}
+		}
+		ts.palettes[name] = p
+	}
+
+	return ts, nil
+}
+
+//Palette returns the palette with the name, nil, which swaps nothing, if the set doesn't have one
+func (ts *Tileset) Palette(name string) Palette {
+	return ts.palettes[name]
+}
+
+func (ts *Tileset) contains(x, y int) bool {
+	return x >= 0 && y >= 0 && x < ts.Columns && y < ts.Rows
+}
+
+//ID returns the ID of the tile at column x, row y of the sheet
+func (ts *Tileset) ID(x, y int) TileID {
+	if !ts.contains(x, y) {
+		return NoTile
+	}
+	return TileID(y*ts.Columns + x)
+}
+
+//Len returns how many tiles are in the set
+func (ts *Tileset) Len() int {
+	return len(ts.tiles)
+}
+
+//Tile returns the metadata for the tile, nil if the set doesn't have it
+func (ts *Tileset) Tile(id TileID) *Tile {
+	if id < 0 || int(id) >= len(ts.tiles) {
+		return nil
+	}
+	return &ts.tiles[id]
+}
+
+//Rect returns the area of the image the tile is drawn from, empty if the set doesn't have it
+func (ts *Tileset) Rect(id TileID) image.Rectangle {
+	if t := ts.Tile(id); t != nil {
+		return t.Rect
+	}
+	return image.Rectangle{}
+}
+
+//Region returns the area of the image covered by a block of w by h tiles with id at its top left
+func (ts *Tileset) Region(id TileID, w, h int) image.Rectangle {
+	r := ts.Rect(id)
+	if r.Empty() {
+		return r
+	}
+	return image.Rect(r.Min.X, r.Min.Y, r.Min.X+w*ts.TileWidth, r.Min.Y+h*ts.TileHeight)
+}
+
+//Animated returns whether the tile cycles through frames
+func (ts *Tileset) Animated(id TileID) bool {
+	t := ts.Tile(id)
+	return t != nil && len(t.Frames) > 0
+}
+
+//Frame returns the tile to draw in place of id at the time on a clock shared by every tile, id itself if it isn't animated
+func (ts *Tileset) Frame(id TileID, at time.Duration) TileID {
+	t := ts.Tile(id)
+	if t == nil || len(t.Frames) == 0 {
+		return id
+	}
+
+	at %= t.period
+	for _, f := range t.Frames {
+		if at < f.Duration {
+			return f.Tile
+		}
+		at -= f.Duration
+	}
+	return t.Frames[len(t.Frames)-1].Tile
+}
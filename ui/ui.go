@@ -0,0 +1,266 @@
+package ui
+
+import (
+	"image"
+	"image/color"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/tauraamui/berrybun/font"
+)
+
+const (
+	// smallest screen, in UI pixels, the UI is laid out to fit, bigger screens scaling it up by whole numbers
+	minWidth  = 320
+	minHeight = 240
+)
+
+//Scale returns the whole number UI pixels are scaled up by on a screen of the given size
+func Scale(sw, sh int) int {
+	scale := sw / minWidth
+	if s := sh / minHeight; s < scale {
+		scale = s
+	}
+	if scale < 1 {
+		return 1
+	}
+	return scale
+}
+
+//Input is what the player did this update, from whichever of the keyboard, gamepads or mouse they're using
+type Input struct {
+	// directions pressed this update, including any repeats from holding one down
+	Up, Down, Left, Right bool
+	// confirm or cancel pressed this update, such as enter and escape or a gamepad's A and B
+	Activate, Back bool
+	// where the mouse cursor is on the screen, whether it moved since the last update, and whether its button was
+	// clicked this update or is being held
+	Cursor      image.Point
+	CursorMoved bool
+	Click, Held bool
+	// notches the mouse wheel turned, down positive
+	Scroll int
+}
+
+//Theme is how widgets look
+type Theme struct {
+	Font *font.Font
+	// colour of text, of text on the focused widget and of text which can't be used
+	Text, FocusText, Disabled color.Color
+	// fill and edge of framed panels, the fill behind the focused widget, and a slider's track and the part filled
+	Panel, Border, Focus color.Color
+	Track, Fill          color.Color
+	// space kept between a widget's edge and its content, and between widgets in a panel, in UI pixels
+	Padding, Spacing int
+}
+
+//DefaultTheme returns the game's theme drawn in a font
+func DefaultTheme(f *font.Font) *Theme {
+	return &Theme{
+		Font:      f,
+		Text:      color.RGBA{0xf0, 0xe8, 0xd8, 0xff},
+		FocusText: color.RGBA{0x20, 0x18, 0x20, 0xff},
+		Disabled:  color.RGBA{0x80, 0x78, 0x80, 0xff},
+		Panel:     color.RGBA{0x28, 0x20, 0x30, 0xe8},
+		Border:    color.RGBA{0xb0, 0x90, 0x70, 0xff},
+		Focus:     color.RGBA{0xf0, 0xc0, 0x60, 0xff},
+		Track:     color.RGBA{0x50, 0x48, 0x58, 0xff},
+		Fill:      color.RGBA{0xb0, 0x30, 0x70, 0xff},
+		Padding:   3,
+		Spacing:   2,
+	}
+}
+
+//Widget is a part of a UI, laid out and drawn in UI pixels
+type Widget interface {
+	//Measure returns the size the widget would like to be when given at most width pixels across
+	Measure(t *Theme, width int) image.Point
+	//Layout places the widget within r
+	Layout(t *Theme, r image.Rectangle)
+	Bounds() image.Rectangle
+	//Draw draws the widget, focus being whichever widget of the UI has focus
+	Draw(dst *ebiten.Image, t *Theme, focus Widget) error
+}
+
+//Focusable is a widget which can be focused to be used, by moving to it or pointing at it
+type Focusable interface {
+	Widget
+	//Handle reacts to input while the widget has focus, returning whether it used it, in which case it isn't used to
+	//move the focus as well
+	Handle(in Input) bool
+}
+
+//Container is a widget holding others
+type Container interface {
+	Widget
+	Children() []Widget
+}
+
+//Anchor is where on the screen a UI's root widget is placed
+type Anchor int
+
+const (
+	Centre Anchor = iota
+	Top
+	Bottom
+	TopLeft
+	TopRight
+	BottomLeft
+	BottomRight
+)
+
+//UI is a tree of widgets drawn over the game at its pixel scale, the focus moved through them by direction presses
+//or pointing with the mouse
+type UI struct {
+	Root   Widget
+	Theme  *Theme
+	Anchor Anchor
+	// UI pixels kept between the root and the edges of the screen
+	Margin int
+	// called when back is pressed and the focused widget doesn't use it, such as to close a menu
+	OnBack func()
+
+	focus  Focusable
+	scale  int
+	canvas *ebiten.Image
+}
+
+//New creates a UI centred on the screen
+func New(t *Theme, root Widget) *UI {
+	return &UI{Root: root, Theme: t, Margin: 4}
+}
+
+//Focus gives a widget focus
+func (u *UI) Focus(w Focusable) {
+	u.focus = w
+}
+
+//Focused returns the widget with focus, nil if nothing can be focused
+func (u *UI) Focused() Focusable {
+	return u.focus
+}
+
+//Update lays the UI out for a screen of the given size in screen pixels and reacts to the player's input
+func (u *UI) Update(sw, sh int, in Input) {
+	u.layout(sw, sh)
+	in.Cursor = in.Cursor.Div(u.scale)
+
+	focusable := collect(u.Root, nil)
+	if len(focusable) == 0 {
+		u.focus = nil
+		return
+	}
+	current := indexOf(focusable, u.focus)
+	if current < 0 {
+		current = 0
+		u.focus = focusable[0]
+	}
+
+	if in.CursorMoved || in.Click {
+		for i, f := range focusable {
+			if in.Cursor.In(f.Bounds()) {
+				current = i
+				u.focus = f
+				break
+			}
+		}
+	}
+
+	if u.focus.Handle(in) {
+		return
+	}
+	switch {
+	case in.Up || in.Left:
+		u.focus = focusable[(current+len(focusable)-1)%len(focusable)]
+	case in.Down || in.Right:
+		u.focus = focusable[(current+1)%len(focusable)]
+	case in.Back && u.OnBack != nil:
+		u.OnBack()
+	}
+}
+
+//layout places the root at its anchor, measured for the screen's size in UI pixels
+func (u *UI) layout(sw, sh int) {
+	u.scale = Scale(sw, sh)
+	screen := image.Rect(0, 0, sw/u.scale, sh/u.scale).Inset(u.Margin)
+	size := u.Root.Measure(u.Theme, screen.Dx())
+	if size.X > screen.Dx() {
+		size.X = screen.Dx()
+	}
+
+	var at image.Point
+	switch u.Anchor {
+	case Centre, Top, Bottom:
+		at.X = screen.Min.X + (screen.Dx()-size.X)/2
+	case TopLeft, BottomLeft:
+		at.X = screen.Min.X
+	case TopRight, BottomRight:
+		at.X = screen.Max.X - size.X
+	}
+	switch u.Anchor {
+	case Centre:
+		at.Y = screen.Min.Y + (screen.Dy()-size.Y)/2
+	case Top, TopLeft, TopRight:
+		at.Y = screen.Min.Y
+	case Bottom, BottomLeft, BottomRight:
+		at.Y = screen.Max.Y - size.Y
+	}
+	u.Root.Layout(u.Theme, image.Rectangle{Min: at, Max: at.Add(size)})
+}
+
+//Draw draws the UI over the screen, scaled up to the game's pixel scale. It's laid out by Update, so it must have been
+//updated at least once.
+func (u *UI) Draw(screen *ebiten.Image) error {
+	if ebiten.IsDrawingSkipped() || u.scale == 0 {
+		return nil
+	}
+
+	sw, sh := screen.Size()
+	w, h := sw/u.scale, sh/u.scale
+	if u.canvas != nil {
+		if cw, ch := u.canvas.Size(); cw != w || ch != h {
+			u.canvas.Dispose()
+			u.canvas = nil
+		}
+	}
+	if u.canvas == nil {
+		canvas, err := ebiten.NewImage(w, h, ebiten.FilterNearest)
+		if err != nil {
+			return err
+		}
+		u.canvas = canvas
+	}
+
+	if err := u.canvas.Clear(); err != nil {
+		return err
+	}
+	if err := u.Root.Draw(u.canvas, u.Theme, u.focus); err != nil {
+		return err
+	}
+
+	op := &ebiten.DrawImageOptions{}
+	op.GeoM.Scale(float64(u.scale), float64(u.scale))
+	op.Filter = ebiten.FilterNearest
+	return screen.DrawImage(u.canvas, op)
+}
+
+//collect appends every focusable widget in the tree to list, in the order they're laid out
+func collect(w Widget, list []Focusable) []Focusable {
+	if f, ok := w.(Focusable); ok {
+		list = append(list, f)
+	}
+	if c, ok := w.(Container); ok {
+		for _, child := range c.Children() {
+			list = collect(child, list)
+		}
+	}
+	return list
+}
+
+func indexOf(list []Focusable, w Focusable) int {
+	for i, f := range list {
+		if f == w {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,488 @@
+package ui
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/hajimehoshi/ebiten"
+	"github.com/hajimehoshi/ebiten/ebitenutil"
+	"github.com/tauraamui/berrybun/font"
+)
+
+//Panel stacks widgets one under the other, or side by side if horizontal
+type Panel struct {
+	Widgets    []Widget
+	Horizontal bool
+	// whether the panel is drawn with the theme's fill and border, rather than just holding its widgets
+	Framed bool
+	// smallest width the panel is laid out at, in UI pixels
+	MinWidth int
+	bounds   image.Rectangle
+}
+
+//NewPanel creates a panel stacking widgets one under the other
+func NewPanel(widgets ...Widget) *Panel {
+	return &Panel{Widgets: widgets}
+}
+
+func (p *Panel) Children() []Widget {
+	return p.Widgets
+}
+
+func (p *Panel) Bounds() image.Rectangle {
+	return p.bounds
+}
+
+//padding returns the space kept around the panel's widgets, only framed panels keeping any
+func (p *Panel) padding(t *Theme) int {
+	if p.Framed {
+		return t.Padding + 1
+	}
+	return 0
+}
+
+func (p *Panel) Measure(t *Theme, width int) image.Point {
+	pad := p.padding(t)
+	inner := width - pad*2
+	var size image.Point
+	for i, w := range p.Widgets {
+		s := w.Measure(t, inner)
+		gap := 0
+		if i > 0 {
+			gap = t.Spacing
+		}
+		if p.Horizontal {
+			size.X += gap + s.X
+			size.Y = max(size.Y, s.Y)
+		} else {
+			size.X = max(size.X, s.X)
+		}
+	}
+	size.X = max(size.X, p.MinWidth-pad*2)
+
+	if !p.Horizontal {
+		// measured again as wide as the panel turned out, which wrapped text wraps to
+		for i, w := range p.Widgets {
+			if i > 0 {
+				size.Y += t.Spacing
+			}
+			size.Y += w.Measure(t, size.X).Y
+		}
+	}
+	return size.Add(image.Pt(pad*2, pad*2))
+}
+
+func (p *Panel) Layout(t *Theme, r image.Rectangle) {
+	p.bounds = r
+	inner := r.Inset(p.padding(t))
+	at := inner.Min
+	for _, w := range p.Widgets {
+		s := w.Measure(t, inner.Dx())
+		if p.Horizontal {
+			w.Layout(t, image.Rect(at.X, inner.Min.Y, at.X+s.X, inner.Max.Y))
+			at.X += s.X + t.Spacing
+		} else {
+			// stacked widgets are all as wide as the panel, so buttons and sliders line up
+			w.Layout(t, image.Rect(inner.Min.X, at.Y, inner.Max.X, at.Y+s.Y))
+			at.Y += s.Y + t.Spacing
+		}
+	}
+}
+
+func (p *Panel) Draw(dst *ebiten.Image, t *Theme, focus Widget) error {
+	if p.Framed {
+		fillRect(dst, p.bounds, t.Panel)
+		outline(dst, p.bounds, t.Border)
+	}
+	for _, w := range p.Widgets {
+		if err := w.Draw(dst, t, focus); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Label is text, which may hold colour tags
+type Label struct {
+	Text string
+	// colour of the text, the theme's if nil
+	Colour color.Color
+	// whether the text is wrapped to fit the width it's laid out in, rather than kept to the lines it's written in. Wrapped
+	// text takes no width of its own, so is as wide as whatever else is in its panel
+	Wrap bool
+	// whether each line is centred, rather than starting from the left
+	Centred bool
+	// whether only the first Shown characters are drawn, for text typed out a character at a time
+	Typewriter bool
+	Shown      int
+	bounds     image.Rectangle
+	// the text as drawn, wrapped if it wraps
+	lines string
+}
+
+//NewLabel creates a label of text on a single line
+func NewLabel(text string) *Label {
+	return &Label{Text: text}
+}
+
+func (l *Label) Bounds() image.Rectangle {
+	return l.bounds
+}
+
+func (l *Label) text(t *Theme, width int) string {
+	if l.Wrap {
+		return t.Font.Wrap(l.Text, width)
+	}
+	return l.Text
+}
+
+func (l *Label) Measure(t *Theme, width int) image.Point {
+	size := t.Font.Measure(l.text(t, width))
+	if l.Wrap {
+		size.X = 0
+	}
+	return size
+}
+
+func (l *Label) Layout(t *Theme, r image.Rectangle) {
+	l.bounds = r
+	l.lines = l.text(t, r.Dx())
+}
+
+func (l *Label) Draw(dst *ebiten.Image, t *Theme, focus Widget) error {
+	clr := l.Colour
+	if clr == nil {
+		clr = t.Text
+	}
+	// the text is wrapped in full before it's cut short, so words don't jump between lines as they're typed
+	lines := l.lines
+	if l.Typewriter {
+		lines = font.Truncate(lines, l.Shown)
+	}
+	if !l.Centred {
+		return t.Font.Draw(dst, lines, l.bounds.Min.X, l.bounds.Min.Y, clr)
+	}
+	return drawCentred(dst, t, lines, l.bounds, clr)
+}
+
+//Button is text which does something when clicked or activated
+type Button struct {
+	Text     string
+	OnClick  func()
+	Disabled bool
+	bounds   image.Rectangle
+}
+
+//NewButton creates a button calling onClick when used
+func NewButton(text string, onClick func()) *Button {
+	return &Button{Text: text, OnClick: onClick}
+}
+
+func (b *Button) Bounds() image.Rectangle {
+	return b.bounds
+}
+
+func (b *Button) Measure(t *Theme, width int) image.Point {
+	return t.Font.Measure(b.Text).Add(image.Pt(t.Padding*2, t.Padding*2))
+}
+
+func (b *Button) Layout(t *Theme, r image.Rectangle) {
+	b.bounds = r
+}
+
+func (b *Button) Handle(in Input) bool {
+	if b.Disabled {
+		return false
+	}
+	if in.Activate || in.Click && in.Cursor.In(b.bounds) {
+		if b.OnClick != nil {
+			b.OnClick()
+		}
+		return true
+	}
+	return false
+}
+
+func (b *Button) Draw(dst *ebiten.Image, t *Theme, focus Widget) error {
+	clr := t.Text
+	switch {
+	case b.Disabled:
+		clr = t.Disabled
+	case focus == b:
+		fillRect(dst, b.bounds, t.Focus)
+		clr = t.FocusText
+	}
+	outline(dst, b.bounds, t.Border)
+	return drawCentred(dst, t, b.Text, b.bounds, clr)
+}
+
+//List is a column of items to pick one of, scrolling once there are more than fit
+type List struct {
+	Items    []string
+	Selected int
+	// items shown at once
+	Rows int
+	// called when an item is picked, by activating it or clicking it
+	OnSelect func(i int)
+	// the first item shown
+	scroll int
+	bounds image.Rectangle
+	// where the first row starts and how tall each row is, as laid out
+	top, rowH int
+}
+
+//NewList creates a list showing rows items at once
+func NewList(items []string, rows int, onSelect func(i int)) *List {
+	return &List{Items: items, Rows: rows, OnSelect: onSelect}
+}
+
+func (l *List) Bounds() image.Rectangle {
+	return l.bounds
+}
+
+func (l *List) rowHeight(t *Theme) int {
+	return t.Font.LineHeight() + t.Spacing
+}
+
+func (l *List) Measure(t *Theme, width int) image.Point {
+	w := 0
+	for _, item := range l.Items {
+		w = max(w, t.Font.Measure(item).X)
+	}
+	return image.Pt(w+t.Padding*2, l.Rows*l.rowHeight(t)+t.Padding*2)
+}
+
+func (l *List) Layout(t *Theme, r image.Rectangle) {
+	l.bounds = r
+	l.top = r.Min.Y + t.Padding
+	l.rowH = l.rowHeight(t)
+}
+
+//Select selects an item, scrolling it into view
+func (l *List) Select(i int) {
+	if i < 0 || i >= len(l.Items) {
+		return
+	}
+	l.Selected = i
+	if i < l.scroll {
+		l.scroll = i
+	}
+	if i >= l.scroll+l.Rows {
+		l.scroll = i - l.Rows + 1
+	}
+}
+
+func (l *List) Handle(in Input) bool {
+	if len(l.Items) == 0 {
+		return false
+	}
+	switch {
+	// moving off either end of the list moves the focus on instead
+	case in.Up && l.Selected > 0:
+		l.Select(l.Selected - 1)
+	case in.Down && l.Selected < len(l.Items)-1:
+		l.Select(l.Selected + 1)
+	case in.Scroll != 0 && in.Cursor.In(l.bounds):
+		l.scroll = clampInt(l.scroll+in.Scroll, 0, max(0, len(l.Items)-l.Rows))
+	case in.Click && in.Cursor.In(l.bounds):
+		row := (in.Cursor.Y - l.top) / max(1, l.rowH)
+		if i := l.scroll + row; row >= 0 && row < l.Rows && i < len(l.Items) {
+			l.Select(i)
+			if l.OnSelect != nil {
+				l.OnSelect(i)
+			}
+		}
+	case in.Activate:
+		if l.OnSelect != nil {
+			l.OnSelect(l.Selected)
+		}
+	default:
+		return false
+	}
+	return true
+}
+
+func (l *List) Draw(dst *ebiten.Image, t *Theme, focus Widget) error {
+	outline(dst, l.bounds, t.Border)
+	for row := 0; row < l.Rows && l.scroll+row < len(l.Items); row++ {
+		i := l.scroll + row
+		r := image.Rect(l.bounds.Min.X+1, l.top+row*l.rowH, l.bounds.Max.X-1, l.top+(row+1)*l.rowH)
+		clr := t.Text
+		if i == l.Selected {
+			if focus == l {
+				fillRect(dst, r, t.Focus)
+				clr = t.FocusText
+			} else {
+				fillRect(dst, r, t.Track)
+			}
+		}
+		if err := t.Font.Draw(dst, l.Items[i], r.Min.X+t.Padding-1, r.Min.Y+t.Spacing/2, clr); err != nil {
+			return err
+		}
+	}
+
+	// a scroll bar down the right once there are more items than rows
+	if len(l.Items) > l.Rows {
+		track := image.Rect(l.bounds.Max.X-3, l.bounds.Min.Y+1, l.bounds.Max.X-1, l.bounds.Max.Y-1)
+		h := track.Dy() * l.Rows / len(l.Items)
+		y := track.Min.Y + track.Dy()*l.scroll/len(l.Items)
+		fillRect(dst, track, t.Track)
+		fillRect(dst, image.Rect(track.Min.X, y, track.Max.X, y+h), t.Border)
+	}
+	return nil
+}
+
+//Slider picks a number between a minimum and maximum
+type Slider struct {
+	Text     string
+	Value    float64
+	Min, Max float64
+	Step     float64
+	// formats the value shown beside the slider, as a percentage of the way from min to max if nil
+	Format   func(v float64) string
+	OnChange func(v float64)
+	bounds   image.Rectangle
+	// where the slider's track is drawn, right of its text
+	track image.Rectangle
+}
+
+// width of a slider's track, in UI pixels
+const sliderTrack = 60
+
+//NewSlider creates a slider from min to max, moving step at a time by direction presses
+func NewSlider(text string, value, min, max, step float64, onChange func(v float64)) *Slider {
+	return &Slider{Text: text, Value: value, Min: min, Max: max, Step: step, OnChange: onChange}
+}
+
+func (s *Slider) Bounds() image.Rectangle {
+	return s.bounds
+}
+
+func (s *Slider) format() string {
+	if s.Format != nil {
+		return s.Format(s.Value)
+	}
+	return strconv.Itoa(int(math.Round((s.Value-s.Min)/(s.Max-s.Min)*100))) + "%"
+}
+
+//valueWidth returns the room left for the value, enough for the widest it could be written
+func (s *Slider) valueWidth(t *Theme) int {
+	widest := "100%"
+	if s.Format != nil {
+		widest = s.Format(s.Max)
+		if w := s.Format(s.Min); len(w) > len(widest) {
+			widest = w
+		}
+	}
+	return t.Font.Measure(widest).X
+}
+
+func (s *Slider) Measure(t *Theme, width int) image.Point {
+	text := t.Font.Measure(s.Text)
+	return image.Pt(text.X+sliderTrack+s.valueWidth(t)+t.Padding*4, text.Y+t.Padding*2)
+}
+
+func (s *Slider) Layout(t *Theme, r image.Rectangle) {
+	s.bounds = r
+	right := r.Max.X - s.valueWidth(t) - t.Padding*2
+	mid := r.Min.Y + r.Dy()/2
+	s.track = image.Rect(right-sliderTrack, mid-1, right, mid+2)
+}
+
+//Set changes the value, kept between min and max and to a whole number of steps, calling OnChange if it changed
+func (s *Slider) Set(v float64) {
+	if s.Step > 0 {
+		v = s.Min + math.Round((v-s.Min)/s.Step)*s.Step
+	}
+	v = math.Max(s.Min, math.Min(s.Max, v))
+	if v == s.Value {
+		return
+	}
+	s.Value = v
+	if s.OnChange != nil {
+		s.OnChange(v)
+	}
+}
+
+func (s *Slider) Handle(in Input) bool {
+	switch {
+	case in.Left:
+		s.Set(s.Value - s.Step)
+	case in.Right:
+		s.Set(s.Value + s.Step)
+	case in.Held && in.Cursor.In(s.bounds):
+		s.Set(s.Min + float64(in.Cursor.X-s.track.Min.X)/float64(s.track.Dx())*(s.Max-s.Min))
+	default:
+		return false
+	}
+	return true
+}
+
+func (s *Slider) Draw(dst *ebiten.Image, t *Theme, focus Widget) error {
+	clr := t.Text
+	if focus == s {
+		fillRect(dst, s.bounds, t.Focus)
+		clr = t.FocusText
+	}
+	if err := t.Font.Draw(dst, s.Text, s.bounds.Min.X+t.Padding, s.bounds.Min.Y+t.Padding, clr); err != nil {
+		return err
+	}
+
+	fillRect(dst, s.track, t.Track)
+	filled := s.track
+	filled.Max.X = filled.Min.X + int(math.Round(float64(s.track.Dx())*(s.Value-s.Min)/(s.Max-s.Min)))
+	fillRect(dst, filled, t.Fill)
+	knob := image.Rect(filled.Max.X-1, s.track.Min.Y-2, filled.Max.X+1, s.track.Max.Y+2)
+	fillRect(dst, knob, t.Border)
+
+	return t.Font.Draw(dst, s.format(), s.track.Max.X+t.Padding*2, s.bounds.Min.Y+t.Padding, clr)
+}
+
+func fillRect(dst *ebiten.Image, r image.Rectangle, clr color.Color) {
+	if r.Empty() {
+		return
+	}
+	ebitenutil.DrawRect(dst, float64(r.Min.X), float64(r.Min.Y), float64(r.Dx()), float64(r.Dy()), clr)
+}
+
+//outline draws a one pixel border just inside r
+func outline(dst *ebiten.Image, r image.Rectangle, clr color.Color) {
+	fillRect(dst, image.Rect(r.Min.X, r.Min.Y, r.Max.X, r.Min.Y+1), clr)
+	fillRect(dst, image.Rect(r.Min.X, r.Max.Y-1, r.Max.X, r.Max.Y), clr)
+	fillRect(dst, image.Rect(r.Min.X, r.Min.Y+1, r.Min.X+1, r.Max.Y-1), clr)
+	fillRect(dst, image.Rect(r.Max.X-1, r.Min.Y+1, r.Max.X, r.Max.Y-1), clr)
+}
+
+//drawCentred draws each line of text centred within r
+func drawCentred(dst *ebiten.Image, t *Theme, text string, r image.Rectangle, clr color.Color) error {
+	size := t.Font.Measure(text)
+	y := r.Min.Y + (r.Dy()-size.Y)/2
+	for _, line := range strings.Split(text, "\n") {
+		x := r.Min.X + (r.Dx()-t.Font.Measure(line).X)/2
+		if err := t.Font.Draw(dst, line, x, y, clr); err != nil {
+			return err
+		}
+		y += t.Font.LineHeight()
+	}
+	return nil
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func clampInt(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
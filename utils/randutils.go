@@ -12,3 +12,16 @@ func (r *Rand) Next(max uint32) uint32 {
 	out := uint32((r.last + r.inc) % uint64(max))
 	return out
 }
+
+//NewRand creates a generator which produces the same sequence for the same seed
+func NewRand(seed uint64) *Rand {
+	// splitmix the seed so small or zero seeds still give a well mixed starting state
+	seed += 0x9e3779b97f4a7c15
+	seed = (seed ^ (seed >> 30)) * 0xbf58476d1ce4e5b9
+	seed = (seed ^ (seed >> 27)) * 0x94d049bb133111eb
+	seed ^= seed >> 31
+	if seed == 0 {
+		seed = 1
+	}
+	return &Rand{last: seed}
+}
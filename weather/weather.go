@@ -0,0 +1,303 @@
+package weather
+
+import (
+	"math"
+
+	"github.com/tauraamui/berrybun/utils"
+)
+
+//Kind is a type of weather
+type Kind int
+
+const (
+	//Clear is sunny with a light breeze
+	Clear Kind = iota
+	//Overcast is grey and a little darker
+	Overcast
+	//Rain is steady rain
+	Rain
+	//Storm is heavy rain, strong wind and lightning
+	Storm
+	//Fog is thick mist
+	Fog
+	//Snow is falling snow, only in winter
+	Snow
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Clear:
+		return "clear"
+	case Overcast:
+		return "overcast"
+	case Rain:
+		return "rain"
+	case Storm:
+		return "storm"
+	case Fog:
+		return "fog"
+	case Snow:
+		return "snow"
+	}
+	return "unknown"
+}
+
+//Season is the time of year
+type Season int
+
+const (
+	//Spring is when flowers bloom and plants grow fastest
+	Spring Season = iota
+	//Summer is warm and mostly dry
+	Summer
+	//Autumn turns the leaves and slows growth
+	Autumn
+	//Winter covers the ground in snow and stops growth
+	Winter
+)
+
+func (s Season) String() string {
+	switch s {
+	case Spring:
+		return "spring"
+	case Summer:
+		return "summer"
+	case Autumn:
+		return "autumn"
+	case Winter:
+		return "winter"
+	}
+	return "unknown"
+}
+
+//DefaultSeasonLength is how many ticks a season lasts unless told otherwise, 20 minutes at 60 ticks a second
+const DefaultSeasonLength = 60 * 60 * 20
+
+const (
+	// shortest and longest ticks a kind of weather lasts
+	minDuration = 60 * 60
+	maxDuration = 60 * 60 * 3
+	// ticks spent changing from one kind of weather to the next
+	changeTicks = 60 * 10
+	// ticks a lightning flash lasts, and the chance of one each tick of a storm out of 1000
+	flashTicks  = 8
+	flashChance = 3
+)
+
+//Conditions is what the weather is like at a moment, blended while it changes from one kind to another
+type Conditions struct {
+	// the weather being changed to, or which has settled
+	Kind   Kind
+	Season Season
+	// colour the world's light is multiplied by
+	LightR, LightG, LightB float64
+	// how heavily it's raining and snowing, and how thick the fog is, 0 to 1
+	Rain, Snow, Fog float64
+	// pixels per tick the wind blows, east positive
+	Wind float64
+	// brightness of a lightning flash, 0 when there isn't one
+	Flash float64
+	// how fast plants such as berry bushes grow compared to a clear summer day
+	Growth float64
+	// name of the background sound for the weather, empty for silence
+	Ambience string
+}
+
+// what each kind of weather is like once it has settled
+type profile struct {
+	light           [3]float64
+	rain, snow, fog float64
+	wind            float64
+	growth          float64
+	ambience        string
+}
+
+var profiles = map[Kind]profile{
+	Clear:    {light: [3]float64{1, 1, 1}, wind: 0.2, growth: 1, ambience: "birdsong"},
+	Overcast: {light: [3]float64{0.8, 0.8, 0.85}, wind: 0.4, growth: 1, ambience: "wind"},
+	Rain:     {light: [3]float64{0.65, 0.68, 0.75}, rain: 0.6, wind: 0.5, growth: 1.5, ambience: "rain"},
+	Storm:    {light: [3]float64{0.45, 0.47, 0.55}, rain: 1, wind: 1.5, growth: 1.2, ambience: "storm"},
+	Fog:      {light: [3]float64{0.8, 0.8, 0.8}, fog: 0.6, wind: 0.1, growth: 1, ambience: ""},
+	Snow:     {light: [3]float64{0.85, 0.88, 0.95}, snow: 0.7, wind: 0.4, growth: 0, ambience: "wind"},
+}
+
+// how likely each kind of weather is to follow another, out of the total of the row
+var transitions = map[Kind]map[Kind]int{
+	Clear:    {Clear: 4, Overcast: 4, Fog: 1},
+	Overcast: {Clear: 3, Overcast: 1, Rain: 4, Fog: 1},
+	Rain:     {Overcast: 4, Rain: 2, Storm: 2},
+	Storm:    {Rain: 3, Overcast: 1},
+	Fog:      {Clear: 2, Overcast: 2},
+	Snow:     {Overcast: 2, Snow: 2},
+}
+
+// how fast plants grow in each season
+var seasonGrowth = map[Season]float64{
+	Spring: 1.5,
+	Summer: 1,
+	Autumn: 0.5,
+	Winter: 0,
+}
+
+//Simulation changes the weather over time, the same seed always giving the same weather
+type Simulation struct {
+	// ticks each season lasts
+	SeasonLength int
+	season       Season
+	seasonTicks  int
+
+	previous, current Kind
+	// ticks left before the weather changes, and ticks since it started changing to the current kind
+	remaining int
+	changing  int
+
+	// direction the wind is gusting in, -1 to 1, and the direction it's heading towards
+	gust, gustTarget float64
+	flash            int
+	random           *utils.Rand
+}
+
+//New creates a simulation starting on a clear spring day
+func New(seed uint64) *Simulation {
+	s := &Simulation{
+		SeasonLength: DefaultSeasonLength,
+		random:       utils.NewRand(seed),
+		changing:     changeTicks,
+		gust:         1,
+		gustTarget:   1,
+	}
+	s.remaining = s.duration()
+	return s
+}
+
+func (s *Simulation) duration() int {
+	return minDuration + int(s.random.Next(maxDuration-minDuration))
+}
+
+//Kind returns the kind of weather settled on or being changed to
+func (s *Simulation) Kind() Kind {
+	return s.current
+}
+
+//Season returns the time of year
+func (s *Simulation) Season() Season {
+	return s.season
+}
+
+//Set starts changing the weather to the kind, snow turning to rain outside winter and rain to snow within it
+func (s *Simulation) Set(k Kind) {
+	k = s.seasonal(k)
+	if k == s.current {
+		return
+	}
+	s.previous = s.current
+	s.current = k
+	s.changing = 0
+	s.remaining = s.duration()
+}
+
+//SetSeason changes the time of year straight away, starting the season from its beginning
+func (s *Simulation) SetSeason(season Season) {
+	s.season = season
+	s.seasonTicks = 0
+	s.Set(s.current)
+}
+
+// seasonal swaps weather which can't happen in the current season for the nearest kind which can
+func (s *Simulation) seasonal(k Kind) Kind {
+	if s.season == Winter {
+		if k == Rain || k == Storm {
+			return Snow
+		}
+		return k
+	}
+	if k == Snow {
+		return Rain
+	}
+	return k
+}
+
+//Update advances the weather by a tick, returning whether the season changed
+func (s *Simulation) Update() bool {
+	seasonChanged := false
+	s.seasonTicks++
+	if s.SeasonLength > 0 && s.seasonTicks >= s.SeasonLength {
+		s.SetSeason((s.season + 1) % 4)
+		seasonChanged = true
+	}
+
+	if s.changing < changeTicks {
+		s.changing++
+	}
+
+	s.remaining--
+	if s.remaining <= 0 {
+		s.Set(s.next())
+		s.remaining = s.duration()
+	}
+
+	// the wind wanders between gusting east and west
+	if s.random.Next(600) == 0 {
+		s.gustTarget = float64(s.random.Next(2001))/1000 - 1
+	}
+	s.gust += (s.gustTarget - s.gust) * 0.005
+
+	if s.flash > 0 {
+		s.flash--
+	} else if s.current == Storm && s.changing == changeTicks && s.random.Next(1000) < flashChance {
+		s.flash = flashTicks
+	}
+
+	return seasonChanged
+}
+
+// next picks the kind of weather to follow the current one
+func (s *Simulation) next() Kind {
+	row := transitions[s.current]
+	total := 0
+	// go through the kinds in order rather than the map's, so the same seed always picks the same
+	for k := Clear; k <= Snow; k++ {
+		total += row[k]
+	}
+	pick := int(s.random.Next(uint32(total)))
+	for k := Clear; k <= Snow; k++ {
+		if pick < row[k] {
+			return k
+		}
+		pick -= row[k]
+	}
+	return Clear
+}
+
+//Conditions returns what the weather is like this tick
+func (s *Simulation) Conditions() Conditions {
+	from, to := profiles[s.previous], profiles[s.current]
+	f := float64(s.changing) / changeTicks
+	mix := func(a, b float64) float64 {
+		return a + (b-a)*f
+	}
+
+	c := Conditions{
+		Kind:   s.current,
+		Season: s.season,
+		LightR: mix(from.light[0], to.light[0]),
+		LightG: mix(from.light[1], to.light[1]),
+		LightB: mix(from.light[2], to.light[2]),
+		Rain:   mix(from.rain, to.rain),
+		Snow:   mix(from.snow, to.snow),
+		Fog:    mix(from.fog, to.fog),
+		Wind:   mix(from.wind, to.wind) * s.gust,
+		Growth: mix(from.growth, to.growth) * seasonGrowth[s.season],
+	}
+
+	c.Ambience = to.ambience
+	if f < 0.5 {
+		c.Ambience = from.ambience
+	}
+
+	if s.flash > 0 {
+		c.Flash = math.Sin(float64(s.flash) / flashTicks * math.Pi)
+	}
+
+	return c
+}